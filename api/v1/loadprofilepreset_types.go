@@ -0,0 +1,50 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LoadProfilePresetSpec captures a shareable set of load and churn parameters
+// Each section that is set replaces the same section of every ScaleLoadConfig referencing the preset
+type LoadProfilePresetSpec struct {
+	// Description of the load tier this preset represents
+	Description string `json:"description,omitempty"`
+
+	// LoadProfile replaces the referencing config's loadProfile
+	LoadProfile *LoadProfile `json:"loadProfile,omitempty"`
+
+	// ResourceChurn replaces the referencing config's resourceChurn
+	ResourceChurn *ResourceChurnConfig `json:"resourceChurn,omitempty"`
+
+	// AnnotationChurn replaces the referencing config's annotationChurn
+	AnnotationChurn *AnnotationChurnConfig `json:"annotationChurn,omitempty"`
+
+	// ReconcileInterval sets the reconcile cadence unless the config sets its own
+	ReconcileInterval *metav1.Duration `json:"reconcileInterval,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:resource:scope=Cluster
+//+kubebuilder:printcolumn:name="Description",type="string",JSONPath=".spec.description"
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// LoadProfilePreset is the Schema for the loadprofilepresets API
+type LoadProfilePreset struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec LoadProfilePresetSpec `json:"spec,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// LoadProfilePresetList contains a list of LoadProfilePreset
+type LoadProfilePresetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LoadProfilePreset `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&LoadProfilePreset{}, &LoadProfilePresetList{})
+}
@@ -1,12 +1,52 @@
 package v1
 
 import (
+	"encoding/json"
 	"fmt"
+	"math"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
+// ProtectedNamespacePrefixes are platform namespaces the operator never writes to, whatever the config says
+var ProtectedNamespacePrefixes = []string{"openshift-", "kube-"}
+
+// ReservedNamespacePrefix is the operator's own namespace family; it is exempt from the protected
+// openshift- prefix since no platform component uses it
+const ReservedNamespacePrefix = "openshift-fake-"
+
+// IsProtectedNamespace reports whether the operator must not write to a namespace, given the
+// excluded namespaces of the configs. Entries ending in * match by prefix
+func IsProtectedNamespace(namespace string, excluded []string) bool {
+	if namespace == "" {
+		return false
+	}
+	for _, pattern := range excluded {
+		if prefix, isPrefix := strings.CutSuffix(pattern, "*"); isPrefix {
+			if strings.HasPrefix(namespace, prefix) {
+				return true
+			}
+		} else if namespace == pattern {
+			return true
+		}
+	}
+	if strings.HasPrefix(namespace, ReservedNamespacePrefix) {
+		return false
+	}
+	for _, prefix := range ProtectedNamespacePrefixes {
+		if strings.HasPrefix(namespace, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
 // ScaleLoadConfigSpec defines the desired state of ScaleLoadConfig
 type ScaleLoadConfigSpec struct {
 	// Enabled controls whether load generation is active
@@ -17,24 +57,179 @@ type ScaleLoadConfigSpec struct {
 	// +kubebuilder:default={"type":"kwok"}
 	KwokNodeSelector map[string]string `json:"kwokNodeSelector,omitempty"`
 
+	// AllowRealNodes lets kwokNodeSelector match nodes that are not KWOK nodes. Matched real nodes
+	// are otherwise skipped, since annotation churn, topology and zone outages would rewrite them
+	// +kubebuilder:default=false
+	AllowRealNodes bool `json:"allowRealNodes,omitempty"`
+
+	// Scenario selects a built-in end-to-end scenario that configures load, churn, events and
+	// namespace personas together. Its settings replace the matching fields of this spec, and a
+	// preset and load profile are applied on top
+	// +kubebuilder:validation:Enum=upgrade-storm;ci-burst;diurnal-production;reschedule-wave
+	// +optional
+	Scenario string `json:"scenario,omitempty"`
+
+	// Preset names a cluster-scoped LoadProfilePreset whose sections replace the matching sections of this spec
+	Preset string `json:"preset,omitempty"`
+
+	// Imports loads supplementary generation data from ConfigMaps or Secrets, for event catalogs,
+	// annotation sets and archetypes too large to inline in the config
+	// +optional
+	Imports []ConfigImport `json:"imports,omitempty"`
+
 	// LoadProfile defines the intensity and pattern of load generation
 	LoadProfile LoadProfile `json:"loadProfile"`
 
+	// ReconcileInterval overrides the node-count based reconcile cadence, e.g. "10s"
+	// Churn happens once per reconcile, so this sets the churn cadence directly
+	ReconcileInterval *metav1.Duration `json:"reconcileInterval,omitempty"`
+
+	// ScenarioReconcileIntervals sets the reconcile cadence per scenario, keyed by scenario name.
+	// The entry for the selected scenario applies unless reconcileInterval is set
+	// +optional
+	ScenarioReconcileIntervals map[string]metav1.Duration `json:"scenarioReconcileIntervals,omitempty"`
+
+	// CycleBudgetSeconds bounds the time a reconcile cycle spends creating and deleting namespaces and
+	// managing their resources. A cycle past it stops, records its progress in status and continues
+	// right away, so spec changes and deletion are picked up between cycles of a large convergence
+	// +kubebuilder:default=120
+	// +kubebuilder:validation:Minimum=10
+	// +kubebuilder:validation:Maximum=240
+	CycleBudgetSeconds int32 `json:"cycleBudgetSeconds,omitempty"`
+
+	// MaxOperationsPerCycle caps the creates and deletes a reconcile cycle makes to bring namespaces
+	// and resources to their targets, so a large target jump converges over several cycles at a
+	// steady rate. Churn updates are not counted. 0 leaves it unlimited
+	// +kubebuilder:validation:Minimum=0
+	MaxOperationsPerCycle int32 `json:"maxOperationsPerCycle,omitempty"`
+
+	// Pacing spreads writes evenly over time instead of sending them in bursts
+	Pacing PacingConfig `json:"pacing,omitempty"`
+
+	// ChurnEngine selects whether resource churn runs inside the reconcile or in background workers
+	ChurnEngine ChurnEngineConfig `json:"churnEngine,omitempty"`
+
+	// StatusUpdates controls how often the operator writes this config's status
+	StatusUpdates StatusUpdateConfig `json:"statusUpdates,omitempty"`
+
+	// ConvergenceTolerancePercent is how far each achieved count may be from its target, in percent
+	// of the target, for the Converged condition to be True
+	// +kubebuilder:default=2
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	ConvergenceTolerancePercent *int32 `json:"convergenceTolerancePercent,omitempty"`
+
+	// CustomProfiles declares organization-specific load tiers that LoadProfile.Profile can reference
+	// +listType=map
+	// +listMapKey=name
+	CustomProfiles []ProfileDefinition `json:"customProfiles,omitempty"`
+
 	// NamespaceConfig controls simulated namespace creation and resource density
 	NamespaceConfig NamespaceConfig `json:"namespaceConfig"`
 
+	// ExcludedNamespaces lists namespaces the operator must never create, modify or delete anything in,
+	// in addition to the always protected openshift-* and kube-* namespaces. Entries ending in * match by prefix
+	ExcludedNamespaces []string `json:"excludedNamespaces,omitempty"`
+
 	// AnnotationChurn controls node annotation update patterns
 	AnnotationChurn AnnotationChurnConfig `json:"annotationChurn"`
 
+	// NamespaceAnnotationChurn controls annotation update patterns on generated namespaces
+	NamespaceAnnotationChurn NamespaceAnnotationChurnConfig `json:"namespaceAnnotationChurn,omitempty"`
+
 	// ResourceChurn controls resource creation/update/deletion patterns
 	ResourceChurn ResourceChurnConfig `json:"resourceChurn"`
 
+	// NamespaceArchetypes splits the namespaces into tenant personas, each with its own resource mix
+	// and churn rates layered over ResourceChurn
+	// +optional
+	NamespaceArchetypes []NamespaceArchetype `json:"namespaceArchetypes,omitempty"`
+
+	// Pools split the generated namespaces into groups with their own namespace count, prefix and
+	// resource churn, e.g. a small hot pool next to a large cold one. With pools, the namespace count
+	// is the sum of the pools' counts instead of following the KWOK node count
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=20
+	Pools []NamespacePool `json:"pools,omitempty"`
+
 	// CleanupConfig controls resource cleanup when KWOK nodes are removed
 	CleanupConfig CleanupConfig `json:"cleanupConfig"`
+
+	// NodeManagement lets the operator create and remove the KWOK Node objects it drives load from
+	NodeManagement NodeManagementConfig `json:"nodeManagement,omitempty"`
+
+	// Topology assigns KWOK nodes and namespaces to synthetic zones within a region
+	Topology TopologyConfig `json:"topology,omitempty"`
+
+	// SchedulingLabelChurn flips scheduling-relevant labels on a share of the KWOK nodes in waves,
+	// so DaemonSets and workloads selecting on them are rescheduled across the fleet
+	SchedulingLabelChurn SchedulingLabelChurnConfig `json:"schedulingLabelChurn,omitempty"`
+
+	// MirrorPods keeps a mirror pod for each static pod component on every KWOK node
+	MirrorPods MirrorPodsConfig `json:"mirrorPods,omitempty"`
+
+	// AlertSimulation controls synthetic firing alert generation
+	AlertSimulation AlertSimulationConfig `json:"alertSimulation,omitempty"`
+
+	// ACMSimulation controls open-cluster-management hub object churn
+	ACMSimulation ACMSimulationConfig `json:"acmSimulation,omitempty"`
+
+	// ArgoCDSimulation controls Argo CD Application churn
+	ArgoCDSimulation ArgoCDSimulationConfig `json:"argoCDSimulation,omitempty"`
+
+	// EtcdPressure generates delete/recreate churn of mid-size objects for etcd testing
+	EtcdPressure EtcdPressureConfig `json:"etcdPressure,omitempty"`
+
+	// LabelCardinality stamps generated objects with many distinct labels to stress label indexes
+	LabelCardinality LabelCardinalityConfig `json:"labelCardinality,omitempty"`
+
+	// ManagedFieldsBloat grows the managedFields of generated objects by applying them as many field managers
+	ManagedFieldsBloat ManagedFieldsBloatConfig `json:"managedFieldsBloat,omitempty"`
+
+	// ControllerLeases renews leader-election Leases for simulated controllers
+	ControllerLeases ControllerLeasesConfig `json:"controllerLeases,omitempty"`
+
+	// Janitor prunes generated objects that outlived their TTL, at its own delete rate
+	Janitor JanitorConfig `json:"janitor,omitempty"`
+
+	// Scope controls whether the operator manages its own namespaces or works inside existing ones
+	Scope ScopeConfig `json:"scope,omitempty"`
+
+	// SafetyLimits rejects configs whose estimated API call rate or object count is higher than the limits
+	// +optional
+	SafetyLimits *SafetyLimits `json:"safetyLimits,omitempty"`
+
+	// Notifications posts run lifecycle transitions to a webhook, so pipelines need not poll status
+	// +optional
+	Notifications *NotificationsConfig `json:"notifications,omitempty"`
+
+	// Summary writes a human-readable summary of the run to a ConfigMap in the operator namespace
+	Summary SummaryConfig `json:"summary,omitempty"`
+
+	// WarmUp primes caches and records baseline latencies before churn begins, so measurements
+	// exclude cold-start effects
+	WarmUp WarmUpConfig `json:"warmUp,omitempty"`
+
+	// Gates pauses load generation while any PromQL condition does not hold, so a test stops itself
+	// before it pushes the control plane past the limits under study
+	// +optional
+	Gates *GatesConfig `json:"gates,omitempty"`
+
+	// TargetClusters lists additional spoke clusters to drive equivalent load into
+	// Load is still generated in the cluster the operator runs in
+	// +listType=map
+	// +listMapKey=name
+	TargetClusters []TargetCluster `json:"targetClusters,omitempty"`
 }
 
 // LoadProfile defines the overall load characteristics
 type LoadProfile struct {
+	// Profile selects a built-in tier (light, medium, realistic, heavy) or a CustomProfiles entry
+	// Values set by the profile replace the matching fields below
+	Profile string `json:"profile,omitempty"`
+
 	// NamespacesPerNode controls namespace density
 	// Based on must-gather analysis: ~0.6 namespaces per node (72/126)
 	// +kubebuilder:default="0.6"
@@ -55,10 +250,199 @@ type LoadProfile struct {
 	APICallRatePerNode *int32 `json:"apiCallRatePerNode,omitempty"`
 }
 
+// ProfileDefinition is a named load tier; unset fields leave the spec values in place
+type ProfileDefinition struct {
+	// Name used by LoadProfile.Profile to select this profile
+	Name string `json:"name"`
+
+	// NamespacesPerNode controls namespace density
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	NamespacesPerNode *string `json:"namespacesPerNode,omitempty"`
+
+	// APICallRatePerNode sets API call rate per node (calls per minute per node)
+	// +kubebuilder:validation:Minimum=1
+	APICallRatePerNode *int32 `json:"apiCallRatePerNode,omitempty"`
+
+	// ChurnMultiplier scales churn intensity; 2 halves update intervals and doubles event rates
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	ChurnMultiplier *string `json:"churnMultiplier,omitempty"`
+
+	// ReconcileInterval sets the reconcile cadence unless Spec.ReconcileInterval is set
+	ReconcileInterval *metav1.Duration `json:"reconcileInterval,omitempty"`
+}
+
+// Built-in load profile names
+const (
+	LoadProfileLight     = "light"
+	LoadProfileMedium    = "medium"
+	LoadProfileRealistic = "realistic"
+	LoadProfileHeavy     = "heavy"
+)
+
+// Built-in scenario names
+const (
+	ScenarioUpgradeStorm      = "upgrade-storm"
+	ScenarioCIBurst           = "ci-burst"
+	ScenarioDiurnalProduction = "diurnal-production"
+	ScenarioRescheduleWave    = "reschedule-wave"
+)
+
+// ScenarioLoadProfile returns the built-in load profile a scenario runs with when loadProfile.profile is unset
+func ScenarioLoadProfile(scenario string) string {
+	switch scenario {
+	case ScenarioUpgradeStorm:
+		return LoadProfileHeavy
+	case ScenarioCIBurst:
+		return LoadProfileMedium
+	case ScenarioDiurnalProduction:
+		return LoadProfileRealistic
+	case ScenarioRescheduleWave:
+		return LoadProfileMedium
+	}
+	return ""
+}
+
+// builtinLoadProfile returns the built-in tier with the given name, matching the
+// light/medium/realistic/heavy guidance derived from must-gather analysis
+func builtinLoadProfile(name string) (ProfileDefinition, bool) {
+	profile := func(namespacesPerNode string, ratePerNode int32, churnMultiplier string) ProfileDefinition {
+		return ProfileDefinition{
+			Name:               name,
+			NamespacesPerNode:  &namespacesPerNode,
+			APICallRatePerNode: &ratePerNode,
+			ChurnMultiplier:    &churnMultiplier,
+		}
+	}
+
+	switch name {
+	case LoadProfileLight:
+		return profile("0.2", 20, "0.5"), true
+	case LoadProfileMedium:
+		return profile("0.4", 35, "1"), true
+	case LoadProfileRealistic:
+		return profile("0.6", 50, "1"), true
+	case LoadProfileHeavy:
+		return profile("1.0", 100, "2"), true
+	}
+	return ProfileDefinition{}, false
+}
+
+// ResolveLoadProfile returns the profile selected by LoadProfile.Profile, or nil when none is selected
+func (r *ScaleLoadConfig) ResolveLoadProfile() (*ProfileDefinition, error) {
+	return r.resolveProfile(r.Spec.LoadProfile.Profile)
+}
+
+// resolveProfile looks a profile name up in CustomProfiles and then in the built-in profiles
+func (r *ScaleLoadConfig) resolveProfile(name string) (*ProfileDefinition, error) {
+	if name == "" {
+		return nil, nil
+	}
+	for i := range r.Spec.CustomProfiles {
+		if r.Spec.CustomProfiles[i].Name == name {
+			return &r.Spec.CustomProfiles[i], nil
+		}
+	}
+	if profile, ok := builtinLoadProfile(name); ok {
+		return &profile, nil
+	}
+	return nil, fmt.Errorf("loadProfile.profile %q is neither a built-in profile nor defined in customProfiles", name)
+}
+
+// SafetyLimits caps the load a config may request. The webhook estimates the config's load and
+// rejects it when the estimate is over a limit
+type SafetyLimits struct {
+	// ExpectedNodes is the KWOK node count the estimate assumes. When unset, the largest node count
+	// nodeManagement keeps is used, or else the node count last reported in status
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ExpectedNodes *int32 `json:"expectedNodes,omitempty"`
+
+	// MaxAPICallsPerMinute is the highest estimated total API call rate admitted
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxAPICallsPerMinute *int32 `json:"maxAPICallsPerMinute,omitempty"`
+
+	// MaxObjects is the highest estimated number of generated objects admitted, events included
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxObjects *int64 `json:"maxObjects,omitempty"`
+}
+
+// LoadEstimateAnnotation holds the JSON LoadEstimate the webhook computed when the config was last admitted
+const LoadEstimateAnnotation = "scale.openshift.io/load-estimate"
+
+// LoadEstimate is the theoretical load of a config at a node count
+type LoadEstimate struct {
+	// Nodes is the KWOK node count the estimate assumes
+	Nodes int32 `json:"nodes"`
+
+	// APICallsPerMinute is the total target API call rate
+	APICallsPerMinute int64 `json:"apiCallsPerMinute"`
+
+	// Objects is the number of generated objects once every target is reached, events included
+	Objects int64 `json:"objects"`
+}
+
+// PacingConfig controls how writes are spaced within a reconcile
+type PacingConfig struct {
+	// Enabled paces creates, updates, patches and deletes to the effective API call rate
+	// +kubebuilder:default=true
+	Enabled bool `json:"enabled,omitempty"`
+
+	// JitterPercent randomizes each gap by up to this share of the average gap
+	// +kubebuilder:default=20
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	JitterPercent int32 `json:"jitterPercent,omitempty"`
+}
+
+// ChurnEngineConfig controls where resource churn runs
+type ChurnEngineConfig struct {
+	// Mode Reconcile churns every namespace during each reconcile; Background runs a long-lived
+	// worker per namespace so churn cadence is independent of the reconcile interval
+	// +kubebuilder:validation:Enum=Reconcile;Background
+	// +kubebuilder:default=Reconcile
+	Mode string `json:"mode,omitempty"`
+
+	// TickSeconds is how often each background worker checks its namespace for due churn
+	// +kubebuilder:default=5
+	// +kubebuilder:validation:Minimum=1
+	TickSeconds int32 `json:"tickSeconds,omitempty"`
+}
+
+// Churn engine modes
+const (
+	ChurnEngineReconcile  = "Reconcile"
+	ChurnEngineBackground = "Background"
+)
+
+// StatusUpdateConfig controls when the operator writes status
+type StatusUpdateConfig struct {
+	// Mode OnChange writes status when counts, targets or conditions change, and otherwise every
+	// ResyncMinutes; EveryReconcile writes it on each reconcile, adding the operator's own status
+	// writes to the generated load
+	// +kubebuilder:validation:Enum=OnChange;EveryReconcile
+	// +kubebuilder:default=OnChange
+	Mode string `json:"mode,omitempty"`
+
+	// ResyncMinutes is how often OnChange writes status anyway, refreshing rates and cumulative counters
+	// +kubebuilder:default=5
+	// +kubebuilder:validation:Minimum=1
+	ResyncMinutes int32 `json:"resyncMinutes,omitempty"`
+}
+
+// Status update modes
+const (
+	StatusUpdateOnChange       = "OnChange"
+	StatusUpdateEveryReconcile = "EveryReconcile"
+)
+
 // NamespaceConfig controls namespace patterns
 type NamespaceConfig struct {
 	// NamespacePrefix for generated namespaces
+	// Cannot be changed once set; delete and recreate the ScaleLoadConfig to use a new prefix
 	// +kubebuilder:default="openshift-fake-"
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="namespacePrefix is immutable; delete and recreate the ScaleLoadConfig to use a new prefix"
 	NamespacePrefix string `json:"namespacePrefix,omitempty"`
 
 	// Labels to apply to generated namespaces
@@ -67,10 +451,35 @@ type NamespaceConfig struct {
 	// Annotations to apply to generated namespaces
 	Annotations map[string]string `json:"annotations,omitempty"`
 
+	// NodeSelector pins the load of the namespaces to the KWOK nodes that also carry these labels,
+	// e.g. an infra or worker machine pool: namespaces are associated with matching nodes, and their
+	// pods and DaemonSets are scheduled onto them
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
 	// ResourceQuota settings for generated namespaces
 	ResourceQuota *NamespaceResourceQuota `json:"resourceQuota,omitempty"`
+
+	// ScaleDownPolicy selects which namespaces are removed when the target drops
+	// +kubebuilder:validation:Enum=OldestFirst;NewestFirst;Random;HighestIndexFirst
+	// +kubebuilder:default=OldestFirst
+	ScaleDownPolicy string `json:"scaleDownPolicy,omitempty"`
+
+	// SCCAnnotations stamps the openshift.io/sa.scc.uid-range, supplemental-groups and mcs annotations
+	// cluster-policy-controller sets on projects when namespaces are created. Each namespace index gets
+	// its own UID block and MCS pair; Annotations still override them
+	// +kubebuilder:default=false
+	SCCAnnotations bool `json:"sccAnnotations,omitempty"`
 }
 
+// Namespace scale-down policies
+const (
+	ScaleDownOldestFirst       = "OldestFirst"
+	ScaleDownNewestFirst       = "NewestFirst"
+	ScaleDownRandom            = "Random"
+	ScaleDownHighestIndexFirst = "HighestIndexFirst"
+)
+
 // NamespaceResourceQuota defines resource limits for generated namespaces
 type NamespaceResourceQuota struct {
 	// CPU limits (in millicores)
@@ -97,6 +506,49 @@ type AnnotationChurnConfig struct {
 	// +kubebuilder:default=true
 	MachineConfigAnnotations bool `json:"machineConfigAnnotations,omitempty"`
 
+	// Platform selects the cloud provider whose CSI, egress IP and Machine API annotations are simulated
+	// +kubebuilder:default=aws
+	// +kubebuilder:validation:Enum=aws;azure;gcp;baremetal
+	Platform string `json:"platform,omitempty"`
+
+	// NetworkPlugin selects the network plugin whose annotations are simulated; other writes no
+	// k8s.ovn.org annotations
+	// +kubebuilder:default=ovn
+	// +kubebuilder:validation:Enum=ovn;other
+	NetworkPlugin string `json:"networkPlugin,omitempty"`
+
+	// ClusterNetworkStack selects the IP families of generated node addresses, subnets and egress configs
+	// +kubebuilder:default=IPv4
+	// +kubebuilder:validation:Enum=IPv4;IPv6;DualStack
+	ClusterNetworkStack string `json:"clusterNetworkStack,omitempty"`
+
+	// ClusterNetwork allocates each node its addresses and pod subnet from a cluster network, so a
+	// node's networking annotations agree with each other and stay stable across updates. Without it
+	// every update writes independent random addresses
+	// +optional
+	ClusterNetwork *ClusterNetworkModel `json:"clusterNetwork,omitempty"`
+
+	// StableAnnotations are annotation keys that are set once and never rewritten, in addition to the
+	// built-in stable keys such as k8s.ovn.org/node-chassis-id
+	// +optional
+	StableAnnotations []string `json:"stableAnnotations,omitempty"`
+
+	// ChurningAnnotations are built-in stable annotation keys that are rewritten periodically anyway
+	// +optional
+	ChurningAnnotations []string `json:"churningAnnotations,omitempty"`
+
+	// RecordDeltas logs the keys and bytes each node annotation update changed and counts them in metrics
+	// +kubebuilder:default=false
+	RecordDeltas bool `json:"recordDeltas,omitempty"`
+
+	// TargetNodeObjectSizeKB pads each KWOK node with scale.openshift.io/size-padding-* annotations
+	// until its serialized size reaches this many KiB. Padding is bounded by the apiserver's 256KiB
+	// annotation limit. 0 disables padding
+	// +kubebuilder:default=0
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=256
+	TargetNodeObjectSizeKB int32 `json:"targetNodeObjectSizeKB,omitempty"`
+
 	// UpdateIntervalMin minimum interval between annotation updates (seconds)
 	// +kubebuilder:default=60
 	UpdateIntervalMin int32 `json:"updateIntervalMin,omitempty"`
@@ -104,6 +556,118 @@ type AnnotationChurnConfig struct {
 	// UpdateIntervalMax maximum interval between annotation updates (seconds)
 	// +kubebuilder:default=300
 	UpdateIntervalMax int32 `json:"updateIntervalMax,omitempty"`
+
+	// ConflictRetryBudget is the number of update attempts a node annotation update makes when it
+	// hits resource version conflicts. Nodes that keep conflicting get a single attempt
+	// +kubebuilder:default=5
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=20
+	ConflictRetryBudget int32 `json:"conflictRetryBudget,omitempty"`
+
+	// ConflictRateThreshold is the percentage of node update attempts that may conflict before the
+	// config is marked Degraded, since a higher rate means another controller writes the same nodes
+	// +kubebuilder:default=50
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	ConflictRateThreshold int32 `json:"conflictRateThreshold,omitempty"`
+
+	// ServerSideApply writes the churned annotations with Server-Side Apply under rotating field managers
+	ServerSideApply NodeServerSideApplyConfig `json:"serverSideApply,omitempty"`
+}
+
+// ClusterNetworkModel is the addressing plan node networking annotations are generated from
+type ClusterNetworkModel struct {
+	// ClusterCIDR is the IPv4 pod network that node subnets are allocated from
+	// +kubebuilder:default="10.128.0.0/14"
+	ClusterCIDR string `json:"clusterCIDR,omitempty"`
+
+	// HostPrefix is the prefix length of each node's IPv4 pod subnet
+	// +kubebuilder:default=23
+	// +kubebuilder:validation:Minimum=8
+	// +kubebuilder:validation:Maximum=28
+	HostPrefix int32 `json:"hostPrefix,omitempty"`
+
+	// MachineCIDR is the IPv4 network node primary addresses are allocated from
+	// +kubebuilder:default="10.0.0.0/16"
+	MachineCIDR string `json:"machineCIDR,omitempty"`
+
+	// ReIPChance is the probability that an annotation update moves the node to new addresses
+	// and a new subnet, as when a node is re-provisioned (0.0-1.0)
+	// +kubebuilder:default="0"
+	// +kubebuilder:validation:Pattern=`^(0(\.[0-9]+)?|1(\.0+)?)$`
+	ReIPChance string `json:"reIPChance,omitempty"`
+}
+
+// Node annotation platforms
+const (
+	NodePlatformAWS       = "aws"
+	NodePlatformAzure     = "azure"
+	NodePlatformGCP       = "gcp"
+	NodePlatformBareMetal = "baremetal"
+)
+
+// Node annotation network plugins
+const (
+	NetworkPluginOVN   = "ovn"
+	NetworkPluginOther = "other"
+)
+
+// Cluster network stacks
+const (
+	NetworkStackIPv4      = "IPv4"
+	NetworkStackIPv6      = "IPv6"
+	NetworkStackDualStack = "DualStack"
+)
+
+// NodeServerSideApplyConfig simulates several agents co-owning node annotations through Server-Side Apply,
+// growing the managedFields of every churned node
+type NodeServerSideApplyConfig struct {
+	// Enabled writes node annotations with Server-Side Apply instead of updates
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled,omitempty"`
+
+	// FieldManagers is the number of field manager names rotated through; each one keeps its own managedFields entry
+	// +kubebuilder:default=5
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=50
+	FieldManagers int32 `json:"fieldManagers,omitempty"`
+
+	// FieldManagerPrefix is followed by the manager index to form each field manager name
+	// +kubebuilder:default="sim-agent-"
+	// +kubebuilder:validation:MaxLength=40
+	// +kubebuilder:validation:Pattern=`^[a-z0-9][-a-z0-9]*$`
+	FieldManagerPrefix string `json:"fieldManagerPrefix,omitempty"`
+}
+
+// NamespaceAnnotationChurnConfig controls namespace annotation churn patterns
+type NamespaceAnnotationChurnConfig struct {
+	// Enabled controls whether namespace annotation churn is active
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled,omitempty"`
+
+	// SCCAnnotations simulates openshift.io/sa.scc.* range allocation updates
+	// +kubebuilder:default=true
+	SCCAnnotations bool `json:"sccAnnotations,omitempty"`
+
+	// SchedulerAnnotations simulates node selector and default toleration hints
+	// +kubebuilder:default=true
+	SchedulerAnnotations bool `json:"schedulerAnnotations,omitempty"`
+
+	// Keys are additional annotation keys set to random values on every update
+	Keys []string `json:"keys,omitempty"`
+
+	// NamespaceInterval controls how often namespaces are churned, e.g. 5 means every 5th namespace
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	NamespaceInterval int32 `json:"namespaceInterval,omitempty"`
+
+	// UpdateIntervalMin minimum interval between annotation updates of a namespace (seconds)
+	// +kubebuilder:default=120
+	UpdateIntervalMin int32 `json:"updateIntervalMin,omitempty"`
+
+	// UpdateIntervalMax maximum interval between annotation updates of a namespace (seconds)
+	// +kubebuilder:default=600
+	UpdateIntervalMax int32 `json:"updateIntervalMax,omitempty"`
 }
 
 // ResourceChurnConfig controls resource lifecycle patterns
@@ -123,12 +687,27 @@ type ResourceChurnConfig struct {
 	// BuildConfigs controls BuildConfig resource patterns
 	BuildConfigs ResourceTypeConfig `json:"buildConfigs,omitempty"`
 
+	// BuildPushes simulates builds completing and pushing their output image to an ImageStreamTag
+	BuildPushes BuildPushConfig `json:"buildPushes,omitempty"`
+
 	// Events controls Event generation patterns
 	Events EventsConfig `json:"events,omitempty"`
 
 	// Pods controls Pod resource patterns
 	Pods PodConfig `json:"pods,omitempty"`
 
+	// AppBundles controls generation of coherent per-application object sets
+	AppBundles AppBundleConfig `json:"appBundles,omitempty"`
+
+	// DaemonSets controls generation of DaemonSets whose pods fan out to every KWOK node
+	DaemonSets DaemonSetConfig `json:"daemonSets,omitempty"`
+
+	// CompletedPods leaves Succeeded and Failed pods behind in each selected namespace, the way finished Job pods pile up
+	CompletedPods CompletedPodsConfig `json:"completedPods,omitempty"`
+
+	// NetworkPolicies creates the default-deny and allow-same-namespace NetworkPolicy baseline of hardened clusters
+	NetworkPolicies NetworkPolicyBaselineConfig `json:"networkPolicies,omitempty"`
+
 	// Namespaces controls namespace churn patterns
 	Namespaces NamespaceChurnConfig `json:"namespaces,omitempty"`
 }
@@ -187,95 +766,601 @@ type ResourceTypeConfig struct {
 	// SafeDeletionEnabled enables enhanced safety controls for complex OpenShift resources
 	// +kubebuilder:default=false
 	SafeDeletionEnabled bool `json:"safeDeletionEnabled,omitempty"`
-}
 
-// EventsConfig controls Event resource generation
-type EventsConfig struct {
-	// Enabled controls whether events are generated
-	// +kubebuilder:default=true
-	Enabled bool `json:"enabled,omitempty"`
+	// TTLSeconds deletes objects once they are older than this and recreates them on the same pass,
+	// giving a steady mix of young and old objects; 0 keeps objects until churn replaces them
+	// +kubebuilder:validation:Minimum=0
+	TTLSeconds int32 `json:"ttlSeconds,omitempty"`
 
-	// EventsPerNodePerHour controls event generation rate
-	// +kubebuilder:default=50
-	EventsPerNodePerHour int32 `json:"eventsPerNodePerHour,omitempty"`
+	// NamespaceTargeting restricts this resource type to a subset of namespaces on top of NamespaceInterval
+	// +optional
+	NamespaceTargeting *NamespaceTargeting `json:"namespaceTargeting,omitempty"`
 
-	// EventTypes defines types of events to generate
-	EventTypes []EventTypeConfig `json:"eventTypes,omitempty"`
+	// DataProvider synthesizes the payload of created objects; configMaps and secrets only.
+	// Unset uses the synthetic provider
+	// +optional
+	DataProvider *DataProviderConfig `json:"dataProvider,omitempty"`
 }
 
-// EventTypeConfig defines configuration for specific event types
-type EventTypeConfig struct {
-	// Type of the event (e.g., "Normal", "Warning")
-	Type string `json:"type"`
+// DataProviderConfig selects the provider that synthesizes the payload of generated objects, so the
+// content of ConfigMaps and Secrets can be made to look like a real workload's
+type DataProviderConfig struct {
+	// Name is a registered provider: "synthetic" generates application configs and credentials,
+	// "sample-file" replays payloads from a file. Programs embedding the operator may register more
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
 
-	// Reason for the event
-	Reason string `json:"reason"`
+	// Options are passed to the provider. sample-file takes "path", a YAML or JSON file mounted into
+	// the operator pod, and "keepSecretValues": "true" to replay Secret values instead of random
+	// values of the same length
+	// +optional
+	Options map[string]string `json:"options,omitempty"`
+}
 
-	// Message template for the event
-	Message string `json:"message"`
+// Built-in data providers
+const (
+	DataProviderSynthetic  = "synthetic"
+	DataProviderSampleFile = "sample-file"
+)
 
-	// Weight for random selection (higher = more frequent)
-	Weight int32 `json:"weight"`
+// NamespaceTargeting selects the namespaces a resource type is placed in, modeling tenants that use
+// the cluster differently. A namespace must match every criterion that is set
+type NamespaceTargeting struct {
+	// Selector matches namespace labels, e.g. the zone label or labels of selected tenant namespaces
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// IndexRange matches namespaces whose scale.openshift.io/namespace-index label falls within the range;
+	// namespaces without an index never match
+	// +optional
+	IndexRange *NamespaceIndexRange `json:"indexRange,omitempty"`
 }
 
-// PodConfig controls Pod resource patterns and workload simulation
-type PodConfig struct {
-	// Enabled controls whether pod simulation is active
-	// +kubebuilder:default=true
-	Enabled bool `json:"enabled,omitempty"`
+// NamespaceIndexRange is an inclusive range of namespace indices
+type NamespaceIndexRange struct {
+	// From is the first index in the range
+	// +kubebuilder:validation:Minimum=0
+	From int32 `json:"from"`
 
-	// Count per namespace
-	// +kubebuilder:default=5
-	Count int32 `json:"count,omitempty"`
+	// To is the last index in the range
+	// +kubebuilder:validation:Minimum=0
+	To int32 `json:"to"`
+}
 
-	// Maximum total pods of this type across all namespaces
-	// 0 means no limit, >0 means stop creating when this count is reached
-	// Pods will still be churned/modified, just not created beyond this limit
-	// +kubebuilder:default=0
-	Maximum int32 `json:"maximum,omitempty"`
+// NamespaceArchetype is a tenant persona, e.g. "ci" or "data": a weighted share of the namespaces
+// whose resource types override the global ResourceChurn settings
+type NamespaceArchetype struct {
+	// Name is recorded in the scale.openshift.io/archetype label of the archetype's namespaces
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
 
-	// NamespaceInterval controls how often pods are created relative to namespaces
-	// For example, interval=2 means create pods in every 2nd namespace
+	// Weight is the archetype's share of namespaces relative to the other archetypes
 	// +kubebuilder:default=1
-	// +kubebuilder:validation:Minimum=1
-	NamespaceInterval int32 `json:"namespaceInterval,omitempty"`
+	// +kubebuilder:validation:Minimum=0
+	Weight int32 `json:"weight,omitempty"`
+
+	// +optional
+	ConfigMaps *ArchetypeResourceMix `json:"configMaps,omitempty"`
+	// +optional
+	Secrets *ArchetypeResourceMix `json:"secrets,omitempty"`
+	// +optional
+	Routes *ArchetypeResourceMix `json:"routes,omitempty"`
+	// +optional
+	ImageStreams *ArchetypeResourceMix `json:"imageStreams,omitempty"`
+	// +optional
+	BuildConfigs *ArchetypeResourceMix `json:"buildConfigs,omitempty"`
+	// +optional
+	Pods *ArchetypeResourceMix `json:"pods,omitempty"`
+	// +optional
+	AppBundles *ArchetypeResourceMix `json:"appBundles,omitempty"`
+}
 
-	// UpdateFrequencyMin minimum time between pod updates (seconds)
-	// +kubebuilder:default=120
-	UpdateFrequencyMin int32 `json:"updateFrequencyMin,omitempty"`
+// ArchetypeResourceMix overrides one resource type in an archetype's namespaces. Unset fields keep the
+// ResourceChurn value, and a type disabled in ResourceChurn stays disabled
+type ArchetypeResourceMix struct {
+	// Count per namespace; 0 keeps the type out of the archetype's namespaces
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Count *int32 `json:"count,omitempty"`
 
-	// UpdateFrequencyMax maximum time between pod updates (seconds)
-	// +kubebuilder:default=600
-	UpdateFrequencyMax int32 `json:"updateFrequencyMax,omitempty"`
+	// UpdateFrequencyMin minimum time between updates (seconds)
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	UpdateFrequencyMin *int32 `json:"updateFrequencyMin,omitempty"`
 
-	// DeleteRecreateChance probability of delete+recreate vs update (0.0-1.0)
-	// +kubebuilder:default="0.15"
-	// +kubebuilder:validation:Pattern=`^(0(\.[0-9]+)?|1(\.0+)?)$`
-	DeleteRecreateChance string `json:"deleteRecreateChance,omitempty"`
+	// UpdateFrequencyMax maximum time between updates (seconds)
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	UpdateFrequencyMax *int32 `json:"updateFrequencyMax,omitempty"`
+}
 
-	// WorkloadTypes defines types of workloads to simulate
-	WorkloadTypes []PodWorkloadType `json:"workloadTypes,omitempty"`
+// override replaces the settings the mix sets
+func (m *ArchetypeResourceMix) override(count, updateMin, updateMax *int32) {
+	if m == nil {
+		return
+	}
+	if m.Count != nil {
+		*count = *m.Count
+	}
+	if m.UpdateFrequencyMin != nil {
+		*updateMin = *m.UpdateFrequencyMin
+	}
+	if m.UpdateFrequencyMax != nil {
+		*updateMax = *m.UpdateFrequencyMax
+	}
+}
 
-	// NodeAffinityStrategy controls how pods are assigned to KWOK nodes
-	// +kubebuilder:default=round-robin
-	// +kubebuilder:validation:Enum=round-robin;random;sticky
-	NodeAffinityStrategy string `json:"nodeAffinityStrategy,omitempty"`
+// ApplyTo overrides the resource churn settings the archetype sets
+func (a *NamespaceArchetype) ApplyTo(churn *ResourceChurnConfig) {
+	for _, entry := range []struct {
+		mix    *ArchetypeResourceMix
+		target *ResourceTypeConfig
+	}{
+		{a.ConfigMaps, &churn.ConfigMaps},
+		{a.Secrets, &churn.Secrets},
+		{a.Routes, &churn.Routes},
+		{a.ImageStreams, &churn.ImageStreams},
+		{a.BuildConfigs, &churn.BuildConfigs},
+	} {
+		entry.mix.override(&entry.target.Count, &entry.target.UpdateFrequencyMin, &entry.target.UpdateFrequencyMax)
+	}
+	a.Pods.override(&churn.Pods.Count, &churn.Pods.UpdateFrequencyMin, &churn.Pods.UpdateFrequencyMax)
+	a.AppBundles.override(&churn.AppBundles.Count, &churn.AppBundles.UpdateFrequencyMin, &churn.AppBundles.UpdateFrequencyMax)
+}
 
-	// TolerateKwokTaint allows pods to be scheduled on KWOK nodes
-	// +kubebuilder:default=true
-	TolerateKwokTaint bool `json:"tolerateKwokTaint,omitempty"`
+// Archetype returns the archetype with the given name, or nil when there is none
+func (s *ScaleLoadConfigSpec) Archetype(name string) *NamespaceArchetype {
+	for i := range s.NamespaceArchetypes {
+		if s.NamespaceArchetypes[i].Name == name {
+			return &s.NamespaceArchetypes[i]
+		}
+	}
+	return nil
 }
 
-// PodWorkloadType defines different types of simulated workloads
-type PodWorkloadType struct {
-	// Name of the workload type
+// NamespacePool is a group of generated namespaces with a fixed count and its own resource churn
+type NamespacePool struct {
+	// Name is recorded in the scale.openshift.io/pool label of the pool's namespaces
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=63
 	Name string `json:"name"`
 
-	// Weight for random selection (higher = more frequent)
-	Weight int32 `json:"weight"`
+	// Namespaces is the number of namespaces in the pool, whatever the KWOK node count
+	// +kubebuilder:validation:Minimum=0
+	Namespaces int32 `json:"namespaces"`
 
-	// Resources defines resource requests and limits
-	Resources PodResourceRequirements `json:"resources"`
+	// NamespacePrefix for the pool's namespaces. It must start with namespaceConfig.namespacePrefix;
+	// empty uses that prefix followed by the pool's name and a dash
+	// +optional
+	NamespacePrefix string `json:"namespacePrefix,omitempty"`
+
+	// ResourceChurn replaces the config's resourceChurn in the pool's namespaces; namespace churn
+	// and events stay config-wide. Unset uses the config's resourceChurn
+	// +optional
+	ResourceChurn *ResourceChurnConfig `json:"resourceChurn,omitempty"`
+
+	// NodeSelector pins the pool's namespaces to the KWOK nodes carrying these labels. It is added to
+	// namespaceConfig.nodeSelector, whose values it overrides for the same keys
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+}
+
+// Pool returns the pool with the given name, or nil when there is none
+func (s *ScaleLoadConfigSpec) Pool(name string) *NamespacePool {
+	for i := range s.Pools {
+		if s.Pools[i].Name == name {
+			return &s.Pools[i]
+		}
+	}
+	return nil
+}
+
+// WithPoolChurn returns a copy of the config with the pool's resourceChurn in place of its own, as
+// the pool's namespaces see it. Namespace churn and events stay the config's
+func (r *ScaleLoadConfig) WithPoolChurn(pool *NamespacePool) *ScaleLoadConfig {
+	poolConfig := r.DeepCopy()
+	if pool.ResourceChurn != nil {
+		pool.ResourceChurn.DeepCopyInto(&poolConfig.Spec.ResourceChurn)
+		poolConfig.Spec.ResourceChurn.Namespaces = r.Spec.ResourceChurn.Namespaces
+		r.Spec.ResourceChurn.Events.DeepCopyInto(&poolConfig.Spec.ResourceChurn.Events)
+	}
+	return poolConfig
+}
+
+// NamespaceNodeSelector returns the labels pinning the load of a pool's namespaces, or of the
+// config's namespaces when pool is nil, to a subset of the KWOK nodes; nil when they are not pinned
+func (r *ScaleLoadConfig) NamespaceNodeSelector(pool *NamespacePool) map[string]string {
+	if pool == nil || len(pool.NodeSelector) == 0 {
+		return r.Spec.NamespaceConfig.NodeSelector
+	}
+	selector := make(map[string]string, len(r.Spec.NamespaceConfig.NodeSelector)+len(pool.NodeSelector))
+	for key, value := range r.Spec.NamespaceConfig.NodeSelector {
+		selector[key] = value
+	}
+	for key, value := range pool.NodeSelector {
+		selector[key] = value
+	}
+	return selector
+}
+
+// PoolPrefix returns the prefix of the pool's namespaces, applying the default
+func (r *ScaleLoadConfig) PoolPrefix(pool *NamespacePool) string {
+	if pool.NamespacePrefix != "" {
+		return pool.NamespacePrefix
+	}
+	return r.namespacePrefix() + pool.Name + "-"
+}
+
+// AppBundleConfig controls app bundles: per application a Deployment with its ServiceAccount,
+// ConfigMap, Secret, Service and Route or Ingress, all referencing each other the way real apps do
+type AppBundleConfig struct {
+	// Enabled controls whether app bundles are generated
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Count of bundles per namespace
+	// +kubebuilder:default=2
+	// +kubebuilder:validation:Minimum=0
+	Count int32 `json:"count,omitempty"`
+
+	// NamespaceInterval controls how often bundles are created relative to namespaces
+	// For example, interval=5 means create bundles in every 5th namespace
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	NamespaceInterval int32 `json:"namespaceInterval,omitempty"`
+
+	// NamespaceTargeting restricts bundles to a subset of namespaces on top of NamespaceInterval
+	// +optional
+	NamespaceTargeting *NamespaceTargeting `json:"namespaceTargeting,omitempty"`
+
+	// Replicas of each bundle's Deployment; its pods are scheduled onto KWOK nodes
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=0
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// Exposure selects how the bundle's Service is exposed
+	// +kubebuilder:default=Route
+	// +kubebuilder:validation:Enum=Route;Ingress;None
+	Exposure string `json:"exposure,omitempty"`
+
+	// Image for the Deployment's container
+	// +kubebuilder:default="registry.redhat.io/ubi8/ubi-minimal:latest"
+	Image string `json:"image,omitempty"`
+
+	// RolloutTrigger selects what rolls a bundle's Deployment. Revision stamps a new revision on the
+	// pod template with every configuration update; ConfigChecksum keeps a checksum/config annotation
+	// in step with the ConfigMap, rolling the Deployment whenever its data changes as config reloaders do
+	// +kubebuilder:default=Revision
+	// +kubebuilder:validation:Enum=Revision;ConfigChecksum
+	RolloutTrigger string `json:"rolloutTrigger,omitempty"`
+
+	// UpdateFrequencyMin minimum time between bundle updates (seconds)
+	// +kubebuilder:default=120
+	UpdateFrequencyMin int32 `json:"updateFrequencyMin,omitempty"`
+
+	// UpdateFrequencyMax maximum time between bundle updates (seconds)
+	// +kubebuilder:default=600
+	UpdateFrequencyMax int32 `json:"updateFrequencyMax,omitempty"`
+}
+
+// App bundle exposure modes
+const (
+	AppBundleExposureRoute   = "Route"
+	AppBundleExposureIngress = "Ingress"
+	AppBundleExposureNone    = "None"
+)
+
+// App bundle rollout triggers
+const (
+	AppBundleRolloutRevision       = "Revision"
+	AppBundleRolloutConfigChecksum = "ConfigChecksum"
+)
+
+// DaemonSetConfig controls generation of DaemonSets scheduled onto every KWOK node. The cluster's
+// DaemonSet controller creates one pod per node and KWOK reports them running, so pod count and the
+// controller's per-node tracking grow with the simulated fleet
+type DaemonSetConfig struct {
+	// Enabled controls whether DaemonSets are generated
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Count of DaemonSets per selected namespace; each one adds a pod to every KWOK node
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=0
+	Count int32 `json:"count,omitempty"`
+
+	// NamespaceInterval controls how often DaemonSets are created relative to namespaces
+	// For example, interval=10 means create DaemonSets in every 10th namespace
+	// +kubebuilder:default=10
+	// +kubebuilder:validation:Minimum=1
+	NamespaceInterval int32 `json:"namespaceInterval,omitempty"`
+
+	// NamespaceTargeting restricts DaemonSets to a subset of namespaces on top of NamespaceInterval
+	// +optional
+	NamespaceTargeting *NamespaceTargeting `json:"namespaceTargeting,omitempty"`
+
+	// Image for the DaemonSet's container
+	// +kubebuilder:default="registry.redhat.io/ubi8/ubi-minimal:latest"
+	Image string `json:"image,omitempty"`
+
+	// NodeSelector adds node labels the DaemonSets select on besides the KWOK node selector, e.g. a
+	// label flipped by scheduling label churn
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// MaxUnavailable is the number or percentage of nodes whose pods are replaced at once during a rollout
+	// +kubebuilder:default="10%"
+	// +kubebuilder:validation:Pattern=`^[0-9]+%?$`
+	MaxUnavailable string `json:"maxUnavailable,omitempty"`
+
+	// UpdateFrequencyMin minimum time between rollouts (seconds)
+	// +kubebuilder:default=600
+	UpdateFrequencyMin int32 `json:"updateFrequencyMin,omitempty"`
+
+	// UpdateFrequencyMax maximum time between rollouts (seconds)
+	// +kubebuilder:default=1800
+	UpdateFrequencyMax int32 `json:"updateFrequencyMax,omitempty"`
+}
+
+// NetworkPolicyBaselineConfig creates the pair of NetworkPolicies hardened clusters put in every
+// namespace: one denying all ingress and one allowing ingress from pods of the same namespace. The
+// policies never change, but each one adds to the network plugin's per-namespace work
+type NetworkPolicyBaselineConfig struct {
+	// Enabled controls whether the baseline policies are created
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled,omitempty"`
+
+	// NamespaceInterval controls how often the baseline is created relative to namespaces
+	// For example, interval=10 means create the baseline in every 10th namespace
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	NamespaceInterval int32 `json:"namespaceInterval,omitempty"`
+
+	// NamespaceTargeting restricts the baseline to a subset of namespaces on top of NamespaceInterval
+	// +optional
+	NamespaceTargeting *NamespaceTargeting `json:"namespaceTargeting,omitempty"`
+}
+
+// BuildPushConfig simulates the image pushes of completed builds. Each BuildConfig outputs to the
+// latest tag of the ImageStream with the same index; a simulated build pushes a new image there by
+// writing the ImageStream's status, as the registry does, so ImageStream and ImageStreamTag watchers
+// such as deployment image triggers see the churn that follows real builds
+type BuildPushConfig struct {
+	// Enabled pushes an image for each BuildConfig in every buildConfigs update window. It needs
+	// buildConfigs and imageStreams enabled
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled,omitempty"`
+
+	// HistoryLimit is how many pushed images each tag keeps in its history, newest first
+	// +kubebuilder:default=5
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=50
+	HistoryLimit int32 `json:"historyLimit,omitempty"`
+
+	// Registry is the registry host the pushed images are referenced from
+	// +kubebuilder:default="image-registry.openshift-image-registry.svc:5000"
+	Registry string `json:"registry,omitempty"`
+}
+
+// CompletedPodsConfig controls the accumulation of terminated pods. Completed pods are never garbage
+// collected until kube-controller-manager's terminated pod threshold is reached, so they grow etcd
+// much like the finished Job pods of busy clusters do
+type CompletedPodsConfig struct {
+	// Enabled controls whether completed pods are left behind
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Count of Succeeded and Failed pods kept per selected namespace
+	// +kubebuilder:default=100
+	// +kubebuilder:validation:Minimum=0
+	Count int32 `json:"count,omitempty"`
+
+	// CreatePerPass is how many completed pods each namespace gains per pass until it holds Count, so
+	// they accumulate over time rather than appearing at once
+	// +kubebuilder:default=10
+	// +kubebuilder:validation:Minimum=1
+	CreatePerPass int32 `json:"createPerPass,omitempty"`
+
+	// FailedPercent is the share of completed pods left in the Failed phase rather than Succeeded
+	// +kubebuilder:default=20
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	FailedPercent int32 `json:"failedPercent,omitempty"`
+
+	// CleanupIntervalSeconds deletes all of a namespace's completed pods once the oldest is this old,
+	// after which they accumulate again; 0 never deletes them, leaving them to the pod garbage collector
+	// +kubebuilder:default=3600
+	// +kubebuilder:validation:Minimum=0
+	CleanupIntervalSeconds int32 `json:"cleanupIntervalSeconds,omitempty"`
+
+	// NamespaceInterval controls how often completed pods are left relative to namespaces
+	// For example, interval=5 means leave completed pods in every 5th namespace
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	NamespaceInterval int32 `json:"namespaceInterval,omitempty"`
+
+	// NamespaceTargeting restricts completed pods to a subset of namespaces on top of NamespaceInterval
+	// +optional
+	NamespaceTargeting *NamespaceTargeting `json:"namespaceTargeting,omitempty"`
+
+	// Image recorded for the completed pods' container
+	// +kubebuilder:default="registry.redhat.io/ubi8/ubi-minimal:latest"
+	Image string `json:"image,omitempty"`
+}
+
+// EventsConfig controls Event resource generation
+type EventsConfig struct {
+	// Enabled controls whether events are generated
+	// +kubebuilder:default=true
+	Enabled bool `json:"enabled,omitempty"`
+
+	// EventsPerNodePerHour controls event generation rate
+	// +kubebuilder:default=50
+	EventsPerNodePerHour int32 `json:"eventsPerNodePerHour,omitempty"`
+
+	// Catalog selects a built-in event catalog with the reasons, messages and weights seen on a platform.
+	// When set, EventTypes are added to it; when unset, EventTypes replace the generic catalog
+	// +kubebuilder:validation:Enum=generic;aws;azure;baremetal;ovn;sdn
+	// +optional
+	Catalog string `json:"catalog,omitempty"`
+
+	// EventTypes defines types of events to generate
+	EventTypes []EventTypeConfig `json:"eventTypes,omitempty"`
+
+	// SourceIdentities is the number of fake controller identities per namespace that events are
+	// reported by, each running as its own ServiceAccount; 0 reports every event as sim-operator
+	// +kubebuilder:default=3
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=20
+	SourceIdentities int32 `json:"sourceIdentities,omitempty"`
+
+	// TimestampSpreadSeconds backdates each event's firstTimestamp by up to this many seconds;
+	// 0 stamps every event with the current time
+	// +kubebuilder:default=0
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=86400
+	TimestampSpreadSeconds int32 `json:"timestampSpreadSeconds,omitempty"`
+
+	// SeriesChance probability that an event is a repeating series with count > 1 (0.0-1.0)
+	// +kubebuilder:default="0"
+	// +kubebuilder:validation:Pattern=`^(0(\.[0-9]+)?|1(\.0+)?)$`
+	SeriesChance string `json:"seriesChance,omitempty"`
+
+	// MaxSeriesCount is the highest count a series reaches
+	// +kubebuilder:default=20
+	// +kubebuilder:validation:Minimum=2
+	MaxSeriesCount int32 `json:"maxSeriesCount,omitempty"`
+
+	// SystemEvents adds node events as kubelet and node-problem-detector report them
+	SystemEvents SystemEventsConfig `json:"systemEvents,omitempty"`
+}
+
+// Built-in event catalogs
+const (
+	EventCatalogGeneric   = "generic"
+	EventCatalogAWS       = "aws"
+	EventCatalogAzure     = "azure"
+	EventCatalogBareMetal = "baremetal"
+	EventCatalogOVN       = "ovn"
+	EventCatalogSDN       = "sdn"
+)
+
+// SystemEventsConfig controls node events written to a shared namespace. Real clusters are dominated
+// by these rather than by events in tenant namespaces
+type SystemEventsConfig struct {
+	// Enabled controls whether system events are generated
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Fraction of all generated events that are system events (0.0 up to, but not including, 1.0)
+	// +kubebuilder:default="0.5"
+	// +kubebuilder:validation:Pattern=`^0(\.[0-9]+)?$`
+	Fraction string `json:"fraction,omitempty"`
+
+	// Namespace the system events are written to
+	// +kubebuilder:default="default"
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// EventTypeConfig defines configuration for specific event types
+type EventTypeConfig struct {
+	// Type of the event (e.g., "Normal", "Warning")
+	Type string `json:"type"`
+
+	// Reason for the event
+	Reason string `json:"reason"`
+
+	// Message template for the event
+	Message string `json:"message"`
+
+	// Weight for random selection (higher = more frequent)
+	Weight int32 `json:"weight"`
+}
+
+// PodConfig controls Pod resource patterns and workload simulation
+type PodConfig struct {
+	// Enabled controls whether pod simulation is active
+	// +kubebuilder:default=true
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Count per namespace
+	// +kubebuilder:default=5
+	Count int32 `json:"count,omitempty"`
+
+	// PodsPerNode sets pod density in pods per KWOK node, the unit node sizing is expressed in. When set
+	// it replaces Count: PodsPerNode times the KWOK node count is spread evenly over the namespaces that
+	// get pods, capped at the nodes' allocatable pods and at Maximum
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=500
+	// +optional
+	PodsPerNode int32 `json:"podsPerNode,omitempty"`
+
+	// Maximum total pods of this type across all namespaces
+	// 0 means no limit, >0 means stop creating when this count is reached
+	// Pods will still be churned/modified, just not created beyond this limit
+	// +kubebuilder:default=0
+	Maximum int32 `json:"maximum,omitempty"`
+
+	// NamespaceInterval controls how often pods are created relative to namespaces
+	// For example, interval=2 means create pods in every 2nd namespace
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	NamespaceInterval int32 `json:"namespaceInterval,omitempty"`
+
+	// NamespaceTargeting restricts pods to a subset of namespaces on top of NamespaceInterval
+	// +optional
+	NamespaceTargeting *NamespaceTargeting `json:"namespaceTargeting,omitempty"`
+
+	// UpdateFrequencyMin minimum time between pod updates (seconds)
+	// +kubebuilder:default=120
+	UpdateFrequencyMin int32 `json:"updateFrequencyMin,omitempty"`
+
+	// UpdateFrequencyMax maximum time between pod updates (seconds)
+	// +kubebuilder:default=600
+	UpdateFrequencyMax int32 `json:"updateFrequencyMax,omitempty"`
+
+	// DeleteRecreateChance probability of delete+recreate vs update (0.0-1.0)
+	// +kubebuilder:default="0.15"
+	// +kubebuilder:validation:Pattern=`^(0(\.[0-9]+)?|1(\.0+)?)$`
+	DeleteRecreateChance string `json:"deleteRecreateChance,omitempty"`
+
+	// WorkloadTypes defines types of workloads to simulate
+	WorkloadTypes []PodWorkloadType `json:"workloadTypes,omitempty"`
+
+	// NodeAffinityStrategy controls how pods are assigned to KWOK nodes
+	// +kubebuilder:default=round-robin
+	// +kubebuilder:validation:Enum=round-robin;random;sticky
+	NodeAffinityStrategy string `json:"nodeAffinityStrategy,omitempty"`
+
+	// TolerateKwokTaint allows pods to be scheduled on KWOK nodes
+	// +kubebuilder:default=true
+	TolerateKwokTaint bool `json:"tolerateKwokTaint,omitempty"`
+
+	// TTLSeconds deletes objects once they are older than this and recreates them on the same pass,
+	// giving a steady mix of young and old objects; 0 keeps objects until churn replaces them
+	// +kubebuilder:validation:Minimum=0
+	TTLSeconds int32 `json:"ttlSeconds,omitempty"`
+}
+
+// Pod density limits
+const (
+	// MaxPodsPerNode is the highest pods-per-node density OpenShift supports
+	MaxPodsPerNode = 500
+	// DefaultNodeAllocatablePods is the kubelet's default maxPods, assumed for nodes that report none
+	DefaultNodeAllocatablePods = 110
+)
+
+// PodWorkloadType defines different types of simulated workloads
+type PodWorkloadType struct {
+	// Name of the workload type
+	Name string `json:"name"`
+
+	// Weight for random selection (higher = more frequent)
+	Weight int32 `json:"weight"`
+
+	// Resources defines resource requests and limits
+	Resources PodResourceRequirements `json:"resources"`
 
 	// Image to use for the pod
 	// +kubebuilder:default="registry.redhat.io/ubi8/ubi-minimal:latest"
@@ -340,50 +1425,1089 @@ type NamespaceChurnConfig struct {
 	Maximum int32 `json:"maximum,omitempty"`
 }
 
-// CleanupConfig controls cleanup behavior
-type CleanupConfig struct {
-	// Enabled controls whether cleanup is performed
-	// +kubebuilder:default=true
-	Enabled bool `json:"enabled,omitempty"`
+// CleanupConfig controls cleanup behavior
+type CleanupConfig struct {
+	// Enabled controls whether cleanup is performed
+	// +kubebuilder:default=true
+	Enabled bool `json:"enabled,omitempty"`
+
+	// GracefulDeletes uses graceful deletion for resources
+	// +kubebuilder:default=true
+	GracefulDeletes bool `json:"gracefulDeletes,omitempty"`
+
+	// GracePeriodSeconds grace period passed on namespace deletes when GracefulDeletes is set;
+	// non-graceful deletes always use 0
+	// +kubebuilder:default=30
+	// +kubebuilder:validation:Minimum=0
+	GracePeriodSeconds int32 `json:"gracePeriodSeconds,omitempty"`
+
+	// PropagationPolicy controls how dependents are removed when a namespace is deleted
+	// +kubebuilder:validation:Enum=Background;Foreground
+	// +kubebuilder:default=Background
+	PropagationPolicy string `json:"propagationPolicy,omitempty"`
+
+	// CleanupDelaySeconds delay before starting cleanup after node removal; namespace scale down
+	// and orphan pod cleanup wait until the node count has stayed low for this long
+	// +kubebuilder:default=60
+	CleanupDelaySeconds int32 `json:"cleanupDelaySeconds,omitempty"`
+
+	// OrphanCleanup removes resources for nodes that no longer exist
+	// +kubebuilder:default=true
+	OrphanCleanup bool `json:"orphanCleanup,omitempty"`
+
+	// OnDisable controls what happens to generated load when Enabled is set to false:
+	// Retain leaves it in place, Cleanup removes it as if the config were deleted
+	// +kubebuilder:validation:Enum=Retain;Cleanup
+	// +kubebuilder:default=Retain
+	OnDisable string `json:"onDisable,omitempty"`
+
+	// RemoveChurnArtifacts also strips the simulated OVN, machine-config, CSI and machine API
+	// annotations from KWOK nodes on deletion; scale.openshift.io annotations are always removed
+	// +kubebuilder:default=false
+	RemoveChurnArtifacts bool `json:"removeChurnArtifacts,omitempty"`
+
+	// FastTeardown deletes everything in a single pass on deletion or disable, without pacing.
+	// By default cleanup deletes are paced at DeletesPerSecond and progress is reported in status
+	// +kubebuilder:default=false
+	FastTeardown bool `json:"fastTeardown,omitempty"`
+
+	// DeletesPerSecond paces cleanup deletes; 0 uses the config's effective API call rate
+	// +kubebuilder:validation:Minimum=0
+	DeletesPerSecond int32 `json:"deletesPerSecond,omitempty"`
+
+	// DrainNamespaces deletes the config's objects inside a namespace one at a time, at the paced
+	// write rate, before deleting the namespace itself, so deletion traffic is paced and measurable
+	// instead of left to the namespace controller
+	// +kubebuilder:default=false
+	DrainNamespaces bool `json:"drainNamespaces,omitempty"`
+
+	// VerifyCleanup searches for objects still carrying the config's or its run's labels once
+	// cleanup on deletion or disable finishes, and reports leftovers in an Event and in status
+	// +kubebuilder:default=false
+	VerifyCleanup bool `json:"verifyCleanup,omitempty"`
+
+	// VerifyTimeoutSeconds is how long verification waits for objects still being deleted, such as
+	// terminating namespaces and their contents, before counting them as leftovers
+	// +kubebuilder:default=300
+	// +kubebuilder:validation:Minimum=0
+	VerifyTimeoutSeconds int32 `json:"verifyTimeoutSeconds,omitempty"`
+}
+
+// Behaviors for CleanupConfig.OnDisable
+const (
+	OnDisableRetain  = "Retain"
+	OnDisableCleanup = "Cleanup"
+)
+
+// NodeManagementConfig controls a pool of operator-owned KWOK nodes
+type NodeManagementConfig struct {
+	// Enabled controls whether the operator manages KWOK Node objects
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Count of KWOK nodes to keep when no schedule step applies
+	// +kubebuilder:default=10
+	// +kubebuilder:validation:Minimum=0
+	Count int32 `json:"count,omitempty"`
+
+	// NodePrefix for generated node names
+	// +kubebuilder:default="sim-kwok-node-"
+	NodePrefix string `json:"nodePrefix,omitempty"`
+
+	// CPU allocatable reported for each node
+	// +kubebuilder:default="8"
+	CPU string `json:"cpu,omitempty"`
+
+	// Memory allocatable reported for each node
+	// +kubebuilder:default="32Gi"
+	Memory string `json:"memory,omitempty"`
+
+	// Pods allocatable reported for each node
+	// +kubebuilder:default=110
+	Pods int32 `json:"pods,omitempty"`
+
+	// ScaleSchedule grows and shrinks the pool on a timeline, simulating cluster autoscaler activity
+	ScaleSchedule *NodeScaleSchedule `json:"scaleSchedule,omitempty"`
+}
+
+// NodeScaleSchedule is a timeline of node pool sizes measured from the config's creation
+type NodeScaleSchedule struct {
+	// Steps set the node count from AtSeconds onwards, until the next step begins
+	Steps []NodeScaleStep `json:"steps"`
+
+	// PeriodSeconds restarts the timeline every period when greater than zero
+	// +kubebuilder:default=0
+	// +kubebuilder:validation:Minimum=0
+	PeriodSeconds int32 `json:"periodSeconds,omitempty"`
+}
+
+// NodeScaleStep sets the node pool size at a point on the schedule
+type NodeScaleStep struct {
+	// AtSeconds offset from the start of the timeline
+	// +kubebuilder:validation:Minimum=0
+	AtSeconds int32 `json:"atSeconds"`
+
+	// Count of KWOK nodes from this step onwards
+	// +kubebuilder:validation:Minimum=0
+	Count int32 `json:"count"`
+}
+
+// TopologyConfig models a synthetic region with weighted zones
+type TopologyConfig struct {
+	// Enabled controls whether nodes, namespaces and pods are spread across zones
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Region label value applied to every KWOK node
+	// +kubebuilder:default="sim-region-1"
+	Region string `json:"region,omitempty"`
+
+	// Zones to spread across; defaults to three equally weighted zones
+	// +listType=map
+	// +listMapKey=name
+	Zones []ZoneConfig `json:"zones,omitempty"`
+
+	// ZoneOutage periodically takes down every KWOK node in one zone
+	ZoneOutage ZoneOutageConfig `json:"zoneOutage,omitempty"`
+}
+
+// ZoneOutageConfig simulates a repeatable zone failure on a fixed timeline from the config's creation
+type ZoneOutageConfig struct {
+	// Enabled controls whether zone outages are injected
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Zone to take down; defaults to the first configured zone
+	Zone string `json:"zone,omitempty"`
+
+	// IntervalSeconds between the start of consecutive outages; the first starts one interval in
+	// +kubebuilder:default=3600
+	// +kubebuilder:validation:Minimum=60
+	IntervalSeconds int32 `json:"intervalSeconds,omitempty"`
+
+	// DurationSeconds each outage lasts before the nodes recover
+	// +kubebuilder:default=300
+	// +kubebuilder:validation:Minimum=1
+	DurationSeconds int32 `json:"durationSeconds,omitempty"`
+}
+
+// ZoneConfig is a synthetic zone and its share of nodes and namespaces
+type ZoneConfig struct {
+	// Name used as the topology.kubernetes.io/zone label value
+	Name string `json:"name"`
+
+	// Weight relative to other zones, used to skew the distribution
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=0
+	Weight int32 `json:"weight,omitempty"`
+}
+
+// SchedulingLabelChurnConfig flips node labels that scheduling constraints select on, in waves on a
+// fixed timeline from the config's creation
+type SchedulingLabelChurnConfig struct {
+	// Enabled controls whether scheduling labels are churned
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Labels to flip; defaults to toggling node-role.kubernetes.io/infra and rotating
+	// scale.openshift.io/pool between pool-a and pool-b
+	// +listType=map
+	// +listMapKey=key
+	// +optional
+	Labels []SchedulingLabel `json:"labels,omitempty"`
+
+	// NodePercent is the share of KWOK nodes flipped in each wave
+	// +kubebuilder:default=10
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	NodePercent int32 `json:"nodePercent,omitempty"`
+
+	// IntervalSeconds between the start of consecutive waves; the first starts one interval in
+	// +kubebuilder:default=900
+	// +kubebuilder:validation:Minimum=60
+	IntervalSeconds int32 `json:"intervalSeconds,omitempty"`
+}
+
+// MirrorPodsConfig creates mirror-pod-shaped Pods bound to the KWOK nodes, standing in for the
+// static pods the kubelet runs from manifests on every real node
+type MirrorPodsConfig struct {
+	// Enabled controls whether mirror pods are kept on the KWOK nodes
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Namespace holds the mirror pods and is created when missing. It plays the role of the
+	// openshift-* namespaces real static pods live in, which the operator never writes to
+	// +kubebuilder:default=sim-static-pods
+	Namespace string `json:"namespace,omitempty"`
+
+	// Components are the static pods every node runs; each becomes a pod named <component>-<node>.
+	// Defaults to kube-rbac-proxy-crio, the static pod OpenShift runs on every node
+	// +kubebuilder:validation:MaxItems=20
+	// +optional
+	Components []string `json:"components,omitempty"`
+
+	// Image for the mirror pods' container
+	// +kubebuilder:default="registry.redhat.io/ubi8/ubi-minimal:latest"
+	Image string `json:"image,omitempty"`
+}
+
+// SchedulingLabel is a node label flipped by scheduling label churn
+type SchedulingLabel struct {
+	// Key of the label
+	Key string `json:"key"`
+
+	// Values the label rotates through. Without values the label is toggled between present with an
+	// empty value and absent, the way node-role labels are set
+	// +optional
+	Values []string `json:"values,omitempty"`
+}
+
+// AlertSimulationConfig controls creation of always-firing alerts alongside object churn
+type AlertSimulationConfig struct {
+	// Enabled controls whether alert simulation is active
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Mode selects how alerts are produced: PrometheusRule objects with always-firing
+	// rules, or direct posts to the Alertmanager v2 API
+	// +kubebuilder:default=prometheusRule
+	// +kubebuilder:validation:Enum=prometheusRule;alertmanager
+	Mode string `json:"mode,omitempty"`
+
+	// AlertsPerNamespace number of firing alerts per selected namespace
+	// +kubebuilder:default=2
+	// +kubebuilder:validation:Minimum=1
+	AlertsPerNamespace int32 `json:"alertsPerNamespace,omitempty"`
+
+	// NamespaceInterval controls how often alerts are created relative to namespaces
+	// For example, interval=10 means create alerts in every 10th namespace
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	NamespaceInterval int32 `json:"namespaceInterval,omitempty"`
+
+	// Severity label applied to generated alerts
+	// +kubebuilder:default=warning
+	// +kubebuilder:validation:Enum=info;warning;critical
+	Severity string `json:"severity,omitempty"`
+
+	// AlertmanagerURL base URL of the Alertmanager API (alertmanager mode only)
+	// e.g. https://alertmanager-main.openshift-monitoring.svc:9094
+	AlertmanagerURL string `json:"alertmanagerURL,omitempty"`
+
+	// AuthSecretRef references a secret key holding a token sent to Alertmanager as
+	// "Authorization: Bearer <token>" (alertmanager mode only). The secret must be in the operator's
+	// namespace; without it alerts are posted unauthenticated
+	// +optional
+	AuthSecretRef *SecretKeyReference `json:"authSecretRef,omitempty"`
+
+	// RefreshIntervalSeconds time between alert posts to Alertmanager (alertmanager mode only)
+	// Posted alerts expire after twice this interval unless refreshed
+	// +kubebuilder:default=300
+	// +kubebuilder:validation:Minimum=30
+	RefreshIntervalSeconds int32 `json:"refreshIntervalSeconds,omitempty"`
+}
+
+// ACMSimulationConfig controls churn of open-cluster-management hub objects
+// Only active when the ManagedCluster, ManifestWork and Placement CRDs are installed
+type ACMSimulationConfig struct {
+	// Enabled controls whether ACM objects are generated
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled,omitempty"`
+
+	// ManagedClusters number of simulated ManagedCluster objects, each with its own namespace
+	// +kubebuilder:default=10
+	// +kubebuilder:validation:Minimum=0
+	ManagedClusters int32 `json:"managedClusters,omitempty"`
+
+	// ManifestWorksPerCluster number of ManifestWorks in each managed cluster namespace
+	// +kubebuilder:default=3
+	// +kubebuilder:validation:Minimum=0
+	ManifestWorksPerCluster int32 `json:"manifestWorksPerCluster,omitempty"`
+
+	// PlacementsPerCluster number of Placements in each managed cluster namespace
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=0
+	PlacementsPerCluster int32 `json:"placementsPerCluster,omitempty"`
+
+	// UpdateFrequencyMin minimum time between updates of an ACM object (seconds)
+	// +kubebuilder:default=120
+	UpdateFrequencyMin int32 `json:"updateFrequencyMin,omitempty"`
+
+	// UpdateFrequencyMax maximum time between updates of an ACM object (seconds)
+	// +kubebuilder:default=600
+	UpdateFrequencyMax int32 `json:"updateFrequencyMax,omitempty"`
+}
+
+// ArgoCDSimulationConfig controls generation of Argo CD Applications in managed namespaces
+// Only active when the argoproj.io Application CRD is installed
+type ArgoCDSimulationConfig struct {
+	// Enabled controls whether Applications are generated
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Count of Applications per selected namespace
+	// +kubebuilder:default=2
+	// +kubebuilder:validation:Minimum=0
+	Count int32 `json:"count,omitempty"`
+
+	// NamespaceInterval controls how often Applications are created relative to namespaces
+	// For example, interval=10 means create Applications in every 10th namespace
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	NamespaceInterval int32 `json:"namespaceInterval,omitempty"`
+
+	// RepoURL of the dummy Git repository the Applications point at
+	// +kubebuilder:default="https://git.example.invalid/sim-operator/manifests.git"
+	RepoURL string `json:"repoURL,omitempty"`
+
+	// UpdateFrequencyMin minimum time between Application updates (seconds)
+	// +kubebuilder:default=120
+	UpdateFrequencyMin int32 `json:"updateFrequencyMin,omitempty"`
+
+	// UpdateFrequencyMax maximum time between Application updates (seconds)
+	// +kubebuilder:default=600
+	UpdateFrequencyMax int32 `json:"updateFrequencyMax,omitempty"`
+}
+
+// LabelCardinalityConfig adds stress labels to every object the operator creates, exercising
+// apiserver label indexing and tools that build per-label indexes, such as policy engines and cost tools
+type LabelCardinalityConfig struct {
+	// Enabled controls whether stress labels are added
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled,omitempty"`
+
+	// LabelsPerObject is how many stress label keys each object carries
+	// +kubebuilder:default=5
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=50
+	LabelsPerObject int32 `json:"labelsPerObject,omitempty"`
+
+	// UniqueValues is how many distinct values each key draws from; 0 gives every object its own values
+	// +kubebuilder:default=1000
+	// +kubebuilder:validation:Minimum=0
+	UniqueValues int32 `json:"uniqueValues,omitempty"`
+
+	// KeyPrefix starts every stress label key; keys end in the label's index
+	// +kubebuilder:default="stress.scale.openshift.io/label-"
+	// +kubebuilder:validation:Pattern=`^([a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*/)?[A-Za-z0-9][-A-Za-z0-9_.]*$`
+	KeyPrefix string `json:"keyPrefix,omitempty"`
+}
+
+// EtcdPressureConfig tunes load for etcd testing: mid-size objects deleted and recreated at a high
+// rate leave tombstones behind, driving compaction and defragmentation
+type EtcdPressureConfig struct {
+	// Enabled controls whether etcd pressure objects are generated
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled,omitempty"`
+
+	// ObjectsPerNamespace is how many pressure objects each selected namespace holds
+	// +kubebuilder:default=10
+	// +kubebuilder:validation:Minimum=0
+	ObjectsPerNamespace int32 `json:"objectsPerNamespace,omitempty"`
+
+	// ObjectSizeKB is the payload size of each object; ConfigMaps are limited to 1MiB
+	// +kubebuilder:default=64
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1000
+	ObjectSizeKB int32 `json:"objectSizeKB,omitempty"`
+
+	// RecreatesPerMinute is how many objects per namespace are deleted and recreated each minute
+	// +kubebuilder:default=30
+	// +kubebuilder:validation:Minimum=0
+	RecreatesPerMinute int32 `json:"recreatesPerMinute,omitempty"`
+
+	// NamespaceInterval selects every Nth namespace for pressure objects
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	NamespaceInterval int32 `json:"namespaceInterval,omitempty"`
+}
+
+// ManagedFieldsBloatConfig applies generated ConfigMaps and Secrets as many distinct field managers
+// over time, so their managedFields grow to the multi-KB sizes seen on long-lived objects and
+// inflate list and watch responses
+type ManagedFieldsBloatConfig struct {
+	// Enabled controls whether generated objects are applied by bloat field managers
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled,omitempty"`
+
+	// FieldManagers is the number of distinct field managers that eventually own fields of each object
+	// +kubebuilder:default=20
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	FieldManagers int32 `json:"fieldManagers,omitempty"`
+
+	// FieldsPerManager is how many annotations each field manager owns, growing its managedFields entry
+	// +kubebuilder:default=3
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=20
+	FieldsPerManager int32 `json:"fieldsPerManager,omitempty"`
+
+	// ObjectsPerNamespace is how many generated objects per namespace are applied each pass
+	// +kubebuilder:default=10
+	// +kubebuilder:validation:Minimum=1
+	ObjectsPerNamespace int32 `json:"objectsPerNamespace,omitempty"`
+
+	// NamespaceInterval selects every Nth namespace for managedFields bloat
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	NamespaceInterval int32 `json:"namespaceInterval,omitempty"`
+}
+
+// ControllerLeasesConfig simulates the leader-election Leases every controller on a real cluster
+// renews every few seconds, a steady write load independent of node count
+type ControllerLeasesConfig struct {
+	// Enabled controls whether simulated controller Leases are renewed
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Controllers is the number of simulated controllers, each holding one Lease
+	// +kubebuilder:default=20
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1000
+	Controllers int32 `json:"controllers,omitempty"`
+
+	// Namespace holds the Leases and is created when missing. It plays the role kube-system plays
+	// for control plane controllers, which the operator never writes to
+	// +kubebuilder:default=sim-controller-leases
+	Namespace string `json:"namespace,omitempty"`
+
+	// LeaseDurationSeconds is written to each Lease as its leaseDurationSeconds
+	// +kubebuilder:default=15
+	// +kubebuilder:validation:Minimum=1
+	LeaseDurationSeconds int32 `json:"leaseDurationSeconds,omitempty"`
+
+	// RenewIntervalSeconds is how often each Lease is renewed, the retry period of client-go leader election
+	// +kubebuilder:default=2
+	// +kubebuilder:validation:Minimum=1
+	RenewIntervalSeconds int32 `json:"renewIntervalSeconds,omitempty"`
+}
+
+// JanitorConfig prunes objects that pile up as a side effect of load generation: events, terminated
+// pods and the old revisions Deployments and DaemonSets keep. Without it, long soak tests grow
+// these objects without bound and never reach a steady state. A TTL of 0 leaves that kind alone
+type JanitorConfig struct {
+	// Enabled controls whether the janitor runs
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled,omitempty"`
+
+	// IntervalSeconds is the time between the end of one sweep and the start of the next
+	// +kubebuilder:default=300
+	// +kubebuilder:validation:Minimum=30
+	IntervalSeconds int32 `json:"intervalSeconds,omitempty"`
+
+	// EventTTLSeconds deletes generated events created and last seen longer ago than this. Events
+	// backdated by resourceChurn.events.timestampSpreadSeconds are aged from their creation
+	// +kubebuilder:default=3600
+	// +kubebuilder:validation:Minimum=0
+	EventTTLSeconds int32 `json:"eventTTLSeconds,omitempty"`
+
+	// CompletedPodTTLSeconds deletes generated pods that Succeeded or Failed longer ago than this.
+	// Pods of resourceChurn.completedPods are left to its own cleanup interval
+	// +kubebuilder:default=3600
+	// +kubebuilder:validation:Minimum=0
+	CompletedPodTTLSeconds int32 `json:"completedPodTTLSeconds,omitempty"`
+
+	// RevisionTTLSeconds deletes scaled-down ReplicaSets of generated Deployments and old
+	// ControllerRevisions of generated DaemonSets once they are older than this. The current
+	// revision of every workload is always kept
+	// +kubebuilder:default=3600
+	// +kubebuilder:validation:Minimum=0
+	RevisionTTLSeconds int32 `json:"revisionTTLSeconds,omitempty"`
+
+	// DeletesPerSecond caps the janitor's delete rate, separately from pacing, so pruning a backlog
+	// does not show up as a delete burst on the API server
+	// +kubebuilder:default=5
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	DeletesPerSecond int32 `json:"deletesPerSecond,omitempty"`
+}
+
+// ScopeConfig controls the operating scope of load generation
+type ScopeConfig struct {
+	// Mode selects Cluster (operator creates namespaces and churns node annotations) or
+	// Namespaced (load is generated only inside pre-existing namespaces, requiring only
+	// Role-based permissions in those namespaces)
+	// +kubebuilder:default=Cluster
+	// +kubebuilder:validation:Enum=Cluster;Namespaced
+	Mode string `json:"mode,omitempty"`
+
+	// NamespaceSelector labels selecting the pre-existing namespaces to use in Namespaced mode
+	// Namespaces must also match NamespaceConfig.NamespacePrefix
+	NamespaceSelector map[string]string `json:"namespaceSelector,omitempty"`
+}
+
+// TargetCluster identifies a remote cluster to generate load in
+type TargetCluster struct {
+	// Name identifies the cluster in status
+	Name string `json:"name"`
+
+	// KubeconfigSecretRef references a secret holding a kubeconfig for the cluster, in the operator's
+	// namespace or one listed in its --kubeconfig-namespaces flag
+	// Exactly one of KubeconfigSecretRef or HostedClusterRef must be set
+	KubeconfigSecretRef *KubeconfigSecretReference `json:"kubeconfigSecretRef,omitempty"`
+
+	// HostedClusterRef targets a HyperShift hosted cluster, using the admin kubeconfig
+	// published in the HostedCluster status. The HostedCluster's namespace must be the operator's or
+	// one listed in its --kubeconfig-namespaces flag
+	HostedClusterRef *HostedClusterReference `json:"hostedClusterRef,omitempty"`
+}
+
+// HostedClusterReference locates a HyperShift HostedCluster on the management cluster
+type HostedClusterReference struct {
+	// Namespace of the HostedCluster
+	Namespace string `json:"namespace"`
+
+	// Name of the HostedCluster
+	Name string `json:"name"`
+}
+
+// KubeconfigSecretReference locates a kubeconfig stored in a secret
+type KubeconfigSecretReference struct {
+	// Namespace of the secret
+	Namespace string `json:"namespace"`
+
+	// Name of the secret
+	Name string `json:"name"`
+
+	// Key within the secret data holding the kubeconfig
+	// +kubebuilder:default=kubeconfig
+	Key string `json:"key,omitempty"`
+}
+
+// NotificationsConfig configures the webhook that receives run lifecycle transitions
+type NotificationsConfig struct {
+	// WebhookURL receives a JSON POST for each transition, e.g. https://ci.example.com/hooks/scale-run
+	WebhookURL string `json:"webhookURL"`
+
+	// AuthSecretRef references a secret key holding a token sent as "Authorization: Bearer <token>".
+	// The secret must be in the operator's namespace
+	// +optional
+	AuthSecretRef *SecretKeyReference `json:"authSecretRef,omitempty"`
+
+	// Events limits notifications to these transitions; empty sends all of them
+	// +optional
+	Events []NotificationEvent `json:"events,omitempty"`
+}
+
+// NotificationEvent is a run lifecycle transition
+// +kubebuilder:validation:Enum=started;converged;degraded;completed
+type NotificationEvent string
+
+// Run lifecycle transitions posted to the notifications webhook
+const (
+	// NotificationStarted is sent when a spec generation is accepted and load generation begins
+	NotificationStarted NotificationEvent = "started"
+	// NotificationConverged is sent when Converged becomes True
+	NotificationConverged NotificationEvent = "converged"
+	// NotificationDegraded is sent when Degraded becomes True
+	NotificationDegraded NotificationEvent = "degraded"
+	// NotificationCompleted is sent when load generation is disabled or the config is deleted
+	NotificationCompleted NotificationEvent = "completed"
+)
+
+// SummaryConfig controls the summary ConfigMap read by people and chatops bots without Prometheus access
+type SummaryConfig struct {
+	// Enabled writes the summary to the sim-summary-<config name> ConfigMap as markdown and JSON
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled,omitempty"`
+
+	// IntervalSeconds is the minimum time between summary updates
+	// +kubebuilder:default=60
+	// +kubebuilder:validation:Minimum=10
+	IntervalSeconds int32 `json:"intervalSeconds,omitempty"`
+}
+
+// WarmUpConfig controls the pre-phase that runs once each time the operator starts, before churn
+type WarmUpConfig struct {
+	// Enabled lists every managed object through the operator's informers, then measures live list
+	// latencies against the apiserver as a baseline
+	// +kubebuilder:default=false
+	Enabled bool `json:"enabled,omitempty"`
+
+	// BaselineSamples is the number of live lists per resource type the baseline latency is the median of
+	// +kubebuilder:default=5
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=50
+	BaselineSamples int32 `json:"baselineSamples,omitempty"`
+}
+
+// GatesConfig lists PromQL conditions that must hold for load generation to continue
+type GatesConfig struct {
+	// PrometheusURL base URL of the Prometheus query API
+	// e.g. https://thanos-querier.openshift-monitoring.svc:9091
+	PrometheusURL string `json:"prometheusURL"`
+
+	// AuthSecretRef references a secret key holding a token sent to Prometheus as
+	// "Authorization: Bearer <token>". The secret must be in the operator's namespace; without it
+	// queries are sent unauthenticated
+	// +optional
+	AuthSecretRef *SecretKeyReference `json:"authSecretRef,omitempty"`
+
+	// IntervalSeconds is how often the gates are evaluated
+	// +kubebuilder:default=30
+	// +kubebuilder:validation:Minimum=10
+	IntervalSeconds int32 `json:"intervalSeconds,omitempty"`
+
+	// PauseOnError pauses load generation when a gate cannot be evaluated, e.g. Prometheus is
+	// unreachable or the query returns no data; by default such gates are skipped
+	// +kubebuilder:default=false
+	PauseOnError bool `json:"pauseOnError,omitempty"`
+
+	// Rules must all hold for load generation to continue
+	// +kubebuilder:validation:MinItems=1
+	// +listType=map
+	// +listMapKey=name
+	Rules []GateRule `json:"rules"`
+}
+
+// GateRule is a PromQL condition that must hold for load generation to continue
+type GateRule struct {
+	// Name identifies the gate in the Gated condition, e.g. apiserver-p99
+	Name string `json:"name"`
+
+	// Query is an instant PromQL query; every returned sample must satisfy the comparison
+	// e.g. histogram_quantile(0.99, sum(rate(apiserver_request_duration_seconds_bucket{verb!="WATCH"}[5m])) by (le))
+	Query string `json:"query"`
+
+	// Operator compares each sample with Threshold
+	// +kubebuilder:validation:Enum="<";"<=";">";">="
+	// +kubebuilder:default="<"
+	Operator string `json:"operator,omitempty"`
+
+	// Threshold the samples are compared with, as a decimal string, e.g. "1" or "0.01"
+	// +kubebuilder:validation:Pattern=`^-?[0-9]+(\.[0-9]+)?$`
+	Threshold string `json:"threshold"`
+}
+
+// SecretKeyReference locates a value stored in a secret
+type SecretKeyReference struct {
+	// Namespace of the secret
+	Namespace string `json:"namespace"`
+
+	// Name of the secret
+	Name string `json:"name"`
+
+	// Key within the secret data holding the value
+	// +kubebuilder:default=token
+	Key string `json:"key,omitempty"`
+}
+
+// ConfigImport references a ConfigMap or Secret holding supplementary generation data. Each key
+// holds one or more YAML or JSON documents separated by "---", each an ImportedGenerationData
+type ConfigImport struct {
+	// Kind of the referenced object
+	// +kubebuilder:validation:Enum=ConfigMap;Secret
+	// +kubebuilder:default=ConfigMap
+	Kind string `json:"kind,omitempty"`
+
+	// Namespace of the referenced object; the operator's namespace or one the operator allows imports from
+	Namespace string `json:"namespace"`
+
+	// Name of the referenced object
+	Name string `json:"name"`
+
+	// Keys to read, in order; empty reads every key in name order
+	// +optional
+	Keys []string `json:"keys,omitempty"`
+
+	// Optional skips the import while the object or a listed key does not exist, instead of
+	// failing to resolve the spec
+	// +optional
+	Optional bool `json:"optional,omitempty"`
+}
+
+// ImportedGenerationData is the data one imported document can add to a config. Lists are appended
+// to the config's own and maps are merged; entries the config sets itself win over imported ones
+type ImportedGenerationData struct {
+	// EventTypes are added to the event catalog in resourceChurn.events.eventTypes
+	EventTypes []EventTypeConfig `json:"eventTypes,omitempty"`
+
+	// NamespaceAnnotations are added to the annotations of generated namespaces
+	NamespaceAnnotations map[string]string `json:"namespaceAnnotations,omitempty"`
+
+	// NamespaceAnnotationKeys are added to namespaceAnnotationChurn.keys
+	NamespaceAnnotationKeys []string `json:"namespaceAnnotationKeys,omitempty"`
+
+	// NamespaceArchetypes are added to namespaceArchetypes
+	NamespaceArchetypes []NamespaceArchetype `json:"namespaceArchetypes,omitempty"`
+
+	// CustomProfiles are added to customProfiles
+	CustomProfiles []ProfileDefinition `json:"customProfiles,omitempty"`
+}
+
+// Validate applies the schema checks the CRD applies to the same fields written inline, since
+// imported documents bypass admission
+func (d *ImportedGenerationData) Validate() error {
+	for _, eventType := range d.EventTypes {
+		if eventType.Type == "" || eventType.Reason == "" {
+			return fmt.Errorf("imported event type %q must set type and reason", eventType.Reason)
+		}
+		if eventType.Weight < 0 {
+			return fmt.Errorf("imported event type %q has a negative weight", eventType.Reason)
+		}
+	}
+	for _, archetype := range d.NamespaceArchetypes {
+		if errs := validation.IsDNS1123Label(archetype.Name); len(errs) > 0 {
+			return fmt.Errorf("imported namespace archetype name %q is invalid: %s", archetype.Name, strings.Join(errs, "; "))
+		}
+		if archetype.Weight < 0 {
+			return fmt.Errorf("imported namespace archetype %q has a negative weight", archetype.Name)
+		}
+		mixes := []*ArchetypeResourceMix{archetype.ConfigMaps, archetype.Secrets, archetype.Routes,
+			archetype.ImageStreams, archetype.BuildConfigs, archetype.Pods, archetype.AppBundles}
+		for _, mix := range mixes {
+			if mix == nil {
+				continue
+			}
+			if (mix.Count != nil && *mix.Count < 0) || (mix.UpdateFrequencyMin != nil && *mix.UpdateFrequencyMin < 1) ||
+				(mix.UpdateFrequencyMax != nil && *mix.UpdateFrequencyMax < 1) {
+				return fmt.Errorf("imported namespace archetype %q has a negative count or an update frequency below 1s", archetype.Name)
+			}
+		}
+	}
+	for _, profile := range d.CustomProfiles {
+		if profile.Name == "" {
+			return fmt.Errorf("imported custom profiles must be named")
+		}
+		for _, value := range []*string{profile.NamespacesPerNode, profile.ChurnMultiplier} {
+			if value == nil {
+				continue
+			}
+			if parsed, err := strconv.ParseFloat(*value, 64); err != nil || parsed < 0 {
+				return fmt.Errorf("imported custom profile %q has invalid number %q", profile.Name, *value)
+			}
+		}
+		if profile.APICallRatePerNode != nil && *profile.APICallRatePerNode < 1 {
+			return fmt.Errorf("imported custom profile %q apiCallRatePerNode must be at least 1", profile.Name)
+		}
+	}
+	return nil
+}
+
+// ValidateGenerationData runs the admission checks of the custom profiles and namespace archetypes
+// again, for specs that imports extended after admission
+func (r *ScaleLoadConfig) ValidateGenerationData() error {
+	if err := r.validateLoadProfile(); err != nil {
+		return err
+	}
+	return r.validateNamespaceArchetypes()
+}
+
+// ScaleLoadConfigStatus defines the observed state of ScaleLoadConfig
+type ScaleLoadConfigStatus struct {
+	// ObservedGeneration reflects the generation of the most recently observed spec
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// RunID identifies the config's test run. Objects created for it carry it in the
+	// scale.openshift.io/run-id label and every request carries it in its user agent
+	RunID string `json:"runID,omitempty"`
+
+	// RunStartTime is when RunID was assigned
+	RunStartTime *metav1.Time `json:"runStartTime,omitempty"`
+
+	// KwokNodeCount is the current count of KWOK nodes being managed
+	KwokNodeCount int32 `json:"kwokNodeCount"`
+
+	// NodeVerification reports how many nodes kwokNodeSelector matched and how many of them were
+	// rejected for lacking KWOK markers
+	NodeVerification NodeVerificationStatus `json:"nodeVerification,omitempty"`
+
+	// GeneratedNamespaces is the current count of generated namespaces
+	GeneratedNamespaces int32 `json:"generatedNamespaces"`
+
+	// TotalResources tracks counts of generated resources by type
+	TotalResources ResourceCounts `json:"totalResources"`
+
+	// EffectiveProfile records the load parameters in use after presets, profiles and defaults are applied
+	EffectiveProfile EffectiveProfile `json:"effectiveProfile,omitempty"`
+
+	// Targets are the values the spec calls for at the current node count, to compare with
+	// GeneratedNamespaces, TotalResources and Metrics.APICallsPerMinute
+	Targets LoadTargets `json:"targets,omitempty"`
+
+	// LastReconcileTime is the timestamp of the last successful reconcile
+	LastReconcileTime *metav1.Time `json:"lastReconcileTime,omitempty"`
+
+	// Conditions represent the latest available observations of the load config state
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Metrics contains performance metrics for the load generation
+	Metrics LoadGenerationMetrics `json:"metrics,omitempty"`
+
+	// Clusters reports load generated in each target cluster
+	Clusters []ClusterStatus `json:"clusters,omitempty"`
+
+	// EtcdPressure reports the cumulative writes made by etcd pressure
+	EtcdPressure *EtcdPressureStatus `json:"etcdPressure,omitempty"`
+
+	// Events compares the requested event rate with the rate achieved
+	Events *EventRateStatus `json:"events,omitempty"`
+
+	// PodDensity reports the pods-per-node target and how many generated pods are scheduled, when
+	// resourceChurn.pods.podsPerNode is set
+	PodDensity *PodDensityStatus `json:"podDensity,omitempty"`
+
+	// WriteVolume estimates the bytes written to etcd for this config
+	WriteVolume WriteVolume `json:"writeVolume,omitempty"`
+
+	// WarmUp reports the latest warm-up, when enabled
+	WarmUp *WarmUpStatus `json:"warmUp,omitempty"`
+
+	// Convergence reports how far the load is from its targets, when it is expected to reach them and
+	// the work left when reconcile cycles run out of cycleBudgetSeconds or maxOperationsPerCycle. It is
+	// cleared once every count is within convergenceTolerancePercent and a cycle finishes all of its work
+	Convergence *ConvergenceStatus `json:"convergence,omitempty"`
+
+	// Cleanup reports the progress of the latest cleanup on deletion or disable
+	Cleanup *CleanupProgressStatus `json:"cleanup,omitempty"`
+
+	// CleanupVerification reports the latest search for objects left behind by cleanup, when
+	// cleanupConfig.verifyCleanup is set
+	CleanupVerification *CleanupVerificationStatus `json:"cleanupVerification,omitempty"`
+
+	// DeletionStatus tracks ongoing deletion operations for complex resources
+	DeletionStatus ResourceDeletionStatus `json:"deletionStatus,omitempty"`
+}
+
+// NodeVerificationStatus reports the result of checking selected nodes for KWOK markers: a kwok://
+// provider ID, the kwok.x-k8s.io/node annotation or the kwok.x-k8s.io/node taint
+type NodeVerificationStatus struct {
+	// MatchedNodes is the number of nodes kwokNodeSelector matched
+	MatchedNodes int32 `json:"matchedNodes"`
+
+	// KwokNodes is the number of matched nodes carrying a KWOK marker
+	KwokNodes int32 `json:"kwokNodes"`
+
+	// RejectedNodes is the number of matched nodes without KWOK markers that were left out;
+	// zero when allowRealNodes is set
+	RejectedNodes int32 `json:"rejectedNodes"`
+
+	// RejectedExamples names up to five rejected nodes
+	RejectedExamples []string `json:"rejectedExamples,omitempty"`
+}
+
+// EtcdPressureStatus reports cumulative etcd pressure writes in the cluster the operator runs in
+type EtcdPressureStatus struct {
+	// ObjectsWritten counts pressure objects created
+	ObjectsWritten int64 `json:"objectsWritten"`
+
+	// ObjectsDeleted counts pressure objects deleted, each leaving a tombstone until compaction
+	ObjectsDeleted int64 `json:"objectsDeleted"`
+
+	// BytesWritten estimates the bytes written to etcd, from the size of each created object
+	BytesWritten int64 `json:"bytesWritten"`
+}
+
+// EventRateStatus compares the event rate the spec asks for with the rate actually generated, so a
+// shortfall from the per-pass caps or failed creates is visible
+type EventRateStatus struct {
+	// RequestedPerHour is the event rate the spec asks for at the current node count, system events included
+	RequestedPerHour int64 `json:"requestedPerHour"`
+
+	// AchievedPerHour is the rate of events created over the last measurement window
+	AchievedPerHour int64 `json:"achievedPerHour"`
+
+	// Dropped counts the events owed but not created over the last measurement window, by reason:
+	// window_cap, system_cap, create_failed or no_namespaces
+	Dropped map[string]int64 `json:"dropped,omitempty"`
+}
+
+// PodDensityStatus compares the requested pods-per-node density with the capacity of the KWOK nodes
+// and with the pods the scheduler has placed
+type PodDensityStatus struct {
+	// RequestedPodsPerNode is the density the spec asks for; with pools, the highest any pool asks for
+	RequestedPodsPerNode int32 `json:"requestedPodsPerNode"`
+
+	// AllocatablePods is the sum of the allocatable pods of the KWOK nodes the load is pinned to
+	AllocatablePods int64 `json:"allocatablePods"`
+
+	// TargetPods is the pod count the density calls for at the current node count, after the caps,
+	// summed over the pools
+	TargetPods int32 `json:"targetPods"`
+
+	// CappedBy names the limit that lowered TargetPods, allocatable or maximum; empty when neither did
+	CappedBy string `json:"cappedBy,omitempty"`
+
+	// ScheduledPods counts generated pods bound to a node
+	ScheduledPods int32 `json:"scheduledPods"`
+
+	// PendingPods counts generated pods the scheduler has not bound to a node yet
+	PendingPods int32 `json:"pendingPods"`
+}
+
+// WarmUpStatus reports the cache priming that ran before churn began
+type WarmUpStatus struct {
+	// CompletedTime is when the warm-up finished and churn began
+	CompletedTime *metav1.Time `json:"completedTime,omitempty"`
+
+	// ObjectsListed is the number of managed objects listed to prime the caches
+	ObjectsListed int64 `json:"objectsListed"`
+
+	// BaselineListLatencyMs is the median latency of a live list of each resource type, in milliseconds,
+	// measured after priming and before any churn
+	BaselineListLatencyMs map[string]int64 `json:"baselineListLatencyMs,omitempty"`
+}
+
+// ConvergenceStatus reports what the latest reconcile cycle left undone
+type ConvergenceStatus struct {
+	// YieldedCycles counts the consecutive cycles that ran out of cycleBudgetSeconds
+	YieldedCycles int32 `json:"yieldedCycles"`
+
+	// LastYieldTime is when the latest cycle yielded
+	LastYieldTime *metav1.Time `json:"lastYieldTime,omitempty"`
+
+	// NamespacesToCreate is the number of namespaces still to create
+	NamespacesToCreate int32 `json:"namespacesToCreate"`
+
+	// NamespacesToDelete is the number of namespaces still to delete
+	NamespacesToDelete int32 `json:"namespacesToDelete"`
+
+	// NamespacesDeferred is the number of namespaces whose resources the cycle did not get to; the next
+	// cycle starts with them
+	NamespacesDeferred int32 `json:"namespacesDeferred"`
+
+	// OperationsDeferred is the number of creates and deletes the cycle held back for
+	// maxOperationsPerCycle
+	OperationsDeferred int32 `json:"operationsDeferred"`
+
+	// RemainingOperations estimates the creates and deletes left to bring every count outside the
+	// convergence tolerance to its target
+	RemainingOperations int32 `json:"remainingOperations,omitempty"`
+
+	// OperationsPerMinute is the pace the estimate assumes: the effective API call rate, lowered to
+	// maxOperationsPerCycle per reconcile interval when that is smaller
+	OperationsPerMinute int32 `json:"operationsPerMinute,omitempty"`
+
+	// EstimatedCompletionTime is when the targets are expected to be reached at OperationsPerMinute;
+	// empty when there is no rate to estimate from
+	EstimatedCompletionTime *metav1.Time `json:"estimatedCompletionTime,omitempty"`
+}
+
+// CleanupProgressStatus reports how far a paced cleanup has come
+type CleanupProgressStatus struct {
+	// Phase is InProgress until every namespace is deleted and the rest of the load is removed, then Completed
+	// +kubebuilder:validation:Enum=InProgress;Completed
+	Phase string `json:"phase"`
+
+	// Generation is the config generation being cleaned up
+	Generation int64 `json:"generation,omitempty"`
+
+	// StartTime is when the cleanup began
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is when the cleanup finished
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// DeletesPerSecond is the pace of the cleanup deletes; 0 for a fast teardown
+	DeletesPerSecond int32 `json:"deletesPerSecond"`
+
+	// NamespacesRemaining counts the namespaces not deleted yet
+	NamespacesRemaining int32 `json:"namespacesRemaining"`
+
+	// NamespacesTerminating counts the deleted namespaces the namespace controller is still emptying
+	NamespacesTerminating int32 `json:"namespacesTerminating"`
+}
+
+// Phases of CleanupProgressStatus
+const (
+	CleanupInProgress = "InProgress"
+	CleanupCompleted  = "Completed"
+)
+
+// CleanupVerificationStatus reports the objects found with the config's or its run's labels after cleanup
+type CleanupVerificationStatus struct {
+	// Phase is Verifying while deleted objects are still going away, then Clean or Leftovers
+	// +kubebuilder:validation:Enum=Verifying;Clean;Leftovers
+	Phase string `json:"phase"`
+
+	// Generation is the config generation whose cleanup was verified
+	Generation int64 `json:"generation,omitempty"`
+
+	// StartTime is when verification began
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is when the result became final
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Leftovers counts the remaining objects by kind; while Verifying it includes objects being deleted
+	Leftovers map[string]int32 `json:"leftovers,omitempty"`
+
+	// Examples names up to ten remaining objects as Kind namespace/name
+	Examples []string `json:"examples,omitempty"`
+}
+
+// Phases of CleanupVerificationStatus
+const (
+	CleanupVerificationVerifying = "Verifying"
+	CleanupVerificationClean     = "Clean"
+	CleanupVerificationLeftovers = "Leftovers"
+)
+
+// WriteVolume estimates etcd write volume from the serialized size of every object the operator
+// creates, updates or patches, including target clusters
+type WriteVolume struct {
+	// TotalBytes is the cumulative size of all writes
+	TotalBytes int64 `json:"totalBytes"`
+
+	// LastCycleBytes is the size of the writes since the previous status update
+	LastCycleBytes int64 `json:"lastCycleBytes"`
+
+	// ByResourceType breaks TotalBytes down by resource type
+	ByResourceType map[string]int64 `json:"byResourceType,omitempty"`
+}
+
+// EffectiveProfile is the resolved load configuration used by the last reconcile
+type EffectiveProfile struct {
+	// Scenario is the built-in scenario applied, if any
+	Scenario string `json:"scenario,omitempty"`
 
-	// GracefulDeletes uses graceful deletion for resources
-	// +kubebuilder:default=true
-	GracefulDeletes bool `json:"gracefulDeletes,omitempty"`
+	// Preset is the LoadProfilePreset applied, if any
+	Preset string `json:"preset,omitempty"`
 
-	// CleanupDelaySeconds delay before starting cleanup after node removal
-	// +kubebuilder:default=60
-	CleanupDelaySeconds int32 `json:"cleanupDelaySeconds,omitempty"`
+	// Profile is the built-in or custom load profile applied, if any
+	Profile string `json:"profile,omitempty"`
 
-	// OrphanCleanup removes resources for nodes that no longer exist
-	// +kubebuilder:default=true
-	OrphanCleanup bool `json:"orphanCleanup,omitempty"`
-}
+	// NamespacesPerNode is the namespace density in use
+	NamespacesPerNode string `json:"namespacesPerNode"`
 
-// ScaleLoadConfigStatus defines the observed state of ScaleLoadConfig
-type ScaleLoadConfigStatus struct {
-	// ObservedGeneration reflects the generation of the most recently observed spec
-	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// NamespacesPerNodeSource is where the density came from: profile, spec or default
+	NamespacesPerNodeSource string `json:"namespacesPerNodeSource"`
 
-	// KwokNodeCount is the current count of KWOK nodes being managed
-	KwokNodeCount int32 `json:"kwokNodeCount"`
+	// APICallRateSource is which rate setting won: static, per-node or default-per-node
+	APICallRateSource string `json:"apiCallRateSource"`
 
-	// GeneratedNamespaces is the current count of generated namespaces
-	GeneratedNamespaces int32 `json:"generatedNamespaces"`
+	// APICallRatePerNode is the per-node rate in use, unset for a static rate
+	APICallRatePerNode *int32 `json:"apiCallRatePerNode,omitempty"`
 
-	// TotalResources tracks counts of generated resources by type
-	TotalResources ResourceCounts `json:"totalResources"`
+	// APICallsPerMinute is the resulting total target rate at the current node count
+	APICallsPerMinute int32 `json:"apiCallsPerMinute"`
 
-	// LastReconcileTime is the timestamp of the last successful reconcile
-	LastReconcileTime *metav1.Time `json:"lastReconcileTime,omitempty"`
+	// ChurnMultiplier is the profile churn multiplier applied to update intervals and event rates
+	ChurnMultiplier string `json:"churnMultiplier,omitempty"`
 
-	// Conditions represent the latest available observations of the load config state
-	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// ReconcileInterval is the reconcile cadence in use
+	ReconcileInterval string `json:"reconcileInterval"`
+}
 
-	// Metrics contains performance metrics for the load generation
-	Metrics LoadGenerationMetrics `json:"metrics,omitempty"`
+// LoadTargets reports the target values for the current reconcile alongside how much of them was reached
+type LoadTargets struct {
+	// Namespaces is the target number of generated namespaces
+	Namespaces int32 `json:"namespaces"`
 
-	// DeletionStatus tracks ongoing deletion operations for complex resources
-	DeletionStatus ResourceDeletionStatus `json:"deletionStatus,omitempty"`
+	// Resources is the target count of each resource type across all generated namespaces.
+	// Events are omitted since Kubernetes expires them on its own
+	Resources ResourceCounts `json:"resources"`
+
+	// APICallsPerMinute is the effective target API call rate
+	APICallsPerMinute int32 `json:"apiCallsPerMinute"`
+
+	// AchievedPercent is the share of targeted namespaces and resources that currently exist
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	AchievedPercent string `json:"achievedPercent,omitempty"`
+
+	// APIRateAchievedPercent is the measured API call rate as a share of the target rate
+	// +kubebuilder:validation:Pattern=`^[0-9]+(\.[0-9]+)?$`
+	APIRateAchievedPercent string `json:"apiRateAchievedPercent,omitempty"`
 }
 
 // ResourceCounts tracks counts of different resource types
@@ -411,6 +2535,42 @@ type ResourceCounts struct {
 
 	// Namespaces count (generated namespaces being managed)
 	Namespaces int32 `json:"namespaces"`
+
+	// Alerts count of simulated firing alerts
+	Alerts int32 `json:"alerts,omitempty"`
+
+	// ManagedClusters count of simulated ACM ManagedClusters
+	ManagedClusters int32 `json:"managedClusters,omitempty"`
+
+	// ManifestWorks count of simulated ACM ManifestWorks
+	ManifestWorks int32 `json:"manifestWorks,omitempty"`
+
+	// Placements count of simulated ACM Placements
+	Placements int32 `json:"placements,omitempty"`
+
+	// Applications count of simulated Argo CD Applications
+	Applications int32 `json:"applications,omitempty"`
+
+	// AppBundles count of generated application bundles
+	AppBundles int32 `json:"appBundles,omitempty"`
+
+	// DaemonSets count of generated DaemonSets
+	DaemonSets int32 `json:"daemonSets,omitempty"`
+
+	// CompletedPods count of Succeeded and Failed pods left behind
+	CompletedPods int32 `json:"completedPods,omitempty"`
+
+	// MirrorPods count of simulated static pod mirror pods
+	MirrorPods int32 `json:"mirrorPods,omitempty"`
+
+	// NetworkPolicies count of baseline NetworkPolicies
+	NetworkPolicies int32 `json:"networkPolicies,omitempty"`
+
+	// EtcdPressure count of etcd pressure objects
+	EtcdPressure int32 `json:"etcdPressure,omitempty"`
+
+	// ControllerLeases count of simulated controller Leases being renewed
+	ControllerLeases int32 `json:"controllerLeases,omitempty"`
 }
 
 // LoadGenerationMetrics contains performance metrics
@@ -440,6 +2600,30 @@ type LoadGenerationMetrics struct {
 	ResourceDeletionRate string `json:"resourceDeletionRate"`
 }
 
+// ClusterStatus reports load generated in a single target cluster
+type ClusterStatus struct {
+	// Name of the target cluster
+	Name string `json:"name"`
+
+	// HostedCluster namespace/name when the target is a HyperShift hosted cluster
+	HostedCluster string `json:"hostedCluster,omitempty"`
+
+	// KwokNodeCount current number of KWOK nodes in the cluster
+	KwokNodeCount int32 `json:"kwokNodeCount"`
+
+	// GeneratedNamespaces count of namespaces created in the cluster
+	GeneratedNamespaces int32 `json:"generatedNamespaces"`
+
+	// TotalResources count of resources managed in the cluster
+	TotalResources ResourceCounts `json:"totalResources"`
+
+	// LastSyncTime timestamp of the last successful load cycle against the cluster
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// Error from the most recent load cycle, empty when it succeeded
+	Error string `json:"error,omitempty"`
+}
+
 // ResourceDeletionStatus tracks ongoing deletion operations for complex resources
 type ResourceDeletionStatus struct {
 	// PendingDeletions resources marked for deletion but not yet removed
@@ -460,10 +2644,12 @@ type ResourceDeletionStatus struct {
 //+kubebuilder:resource:scope=Cluster
 //+kubebuilder:printcolumn:name="KWOK Nodes",type="integer",JSONPath=".status.kwokNodeCount"
 //+kubebuilder:printcolumn:name="Namespaces",type="integer",JSONPath=".status.generatedNamespaces"
+//+kubebuilder:printcolumn:name="Target Namespaces",type="integer",JSONPath=".status.targets.namespaces",priority=1
+//+kubebuilder:printcolumn:name="Achieved",type="string",JSONPath=".status.targets.achievedPercent",priority=1
+//+kubebuilder:printcolumn:name="Converged",type="string",JSONPath=".status.conditions[?(@.type==\"Converged\")].status"
 //+kubebuilder:printcolumn:name="Namespaces/Node",type="string",JSONPath=".spec.loadProfile.namespacesPerNode"
 //+kubebuilder:printcolumn:name="Enabled",type="boolean",JSONPath=".spec.enabled"
 //+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
-//+kubebuilder:webhook:path=/validate-scale-openshift-io-v1-scaleloadconfig,mutating=false,failurePolicy=fail,sideEffects=None,groups=scale.openshift.io,resources=scaleloadconfigs,verbs=create;update,versions=v1,name=vscaleloadconfig.kb.io,admissionReviewVersions=v1
 
 // ScaleLoadConfig is the Schema for the scaleloadconfigs API
 type ScaleLoadConfig struct {
@@ -483,22 +2669,153 @@ type ScaleLoadConfigList struct {
 	Items           []ScaleLoadConfig `json:"items"`
 }
 
-// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
-func (r *ScaleLoadConfig) ValidateCreate() error {
-	return r.validateAPIRateConfiguration()
+// recordLoadEstimate stores the load estimate in an annotation so users see the load a config asks
+// for before it runs
+func (r *ScaleLoadConfig) recordLoadEstimate() {
+	estimate, err := json.Marshal(r.EstimateLoad())
+	if err != nil {
+		return
+	}
+	if r.Annotations == nil {
+		r.Annotations = make(map[string]string)
+	}
+	r.Annotations[LoadEstimateAnnotation] = string(estimate)
+}
+
+// validateSpec runs the checks that need nothing but the config itself
+func (r *ScaleLoadConfig) validateSpec() error {
+	if err := r.validateAPIRateConfiguration(); err != nil {
+		return err
+	}
+	if err := r.validateZoneOutage(); err != nil {
+		return err
+	}
+	if err := r.validateClusterNetwork(); err != nil {
+		return err
+	}
+	if err := r.validateSchedulingLabelChurn(); err != nil {
+		return err
+	}
+	if err := r.validateMirrorPods(); err != nil {
+		return err
+	}
+	if err := r.validatePodDensity(); err != nil {
+		return err
+	}
+	if err := r.validateJanitor(); err != nil {
+		return err
+	}
+	if err := r.validateReconcileInterval(); err != nil {
+		return err
+	}
+	if err := r.validateLoadProfile(); err != nil {
+		return err
+	}
+	if err := r.validateTargetClusters(); err != nil {
+		return err
+	}
+	if err := r.ValidateProtectedNamespaces(); err != nil {
+		return err
+	}
+	if err := r.validateNamespaceTargeting(); err != nil {
+		return err
+	}
+	if err := r.validateDataProviders(); err != nil {
+		return err
+	}
+	if err := r.validateNamespaceArchetypes(); err != nil {
+		return err
+	}
+	if err := r.validatePools(); err != nil {
+		return err
+	}
+	if err := r.validateNodeSelectors(); err != nil {
+		return err
+	}
+	if err := r.ValidateSafetyLimits(); err != nil {
+		return err
+	}
+	if err := r.validateNotifications(); err != nil {
+		return err
+	}
+	if err := r.validateGates(); err != nil {
+		return err
+	}
+	return r.validateImports()
+}
+
+// validateNamespacePrefixUnchanged rejects prefix changes, since the generated namespaces would no
+// longer match the names the operator expects. It does not look at status: a config disabled with
+// cleanup reports no namespaces, yet may still hold some or get them back when re-enabled. The CRD
+// enforces the same rule, so it holds without the webhook too
+func (r *ScaleLoadConfig) validateNamespacePrefixUnchanged(old *ScaleLoadConfig) error {
+	oldPrefix := old.namespacePrefix()
+	newPrefix := r.namespacePrefix()
+
+	if oldPrefix != newPrefix {
+		return fmt.Errorf("namespaceConfig.namespacePrefix cannot be changed from %q to %q; "+
+			"delete and recreate the ScaleLoadConfig to use a new prefix", oldPrefix, newPrefix)
+	}
+	return nil
+}
+
+// namespacePrefix returns the prefix of the namespaces the config uses, applying the default
+func (r *ScaleLoadConfig) namespacePrefix() string {
+	if r.Spec.NamespaceConfig.NamespacePrefix == "" {
+		return "openshift-fake-"
+	}
+	return r.Spec.NamespaceConfig.NamespacePrefix
 }
 
-// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
-func (r *ScaleLoadConfig) ValidateUpdate(old runtime.Object) error {
-	return r.validateAPIRateConfiguration()
+// namespaceSelectionUnchanged reports whether the fields that decide which namespaces the config uses are the same as in old
+func (r *ScaleLoadConfig) namespaceSelectionUnchanged(old *ScaleLoadConfig) bool {
+	if r.namespacePrefix() != old.namespacePrefix() || r.Spec.Scope.Mode != old.Spec.Scope.Mode ||
+		len(r.Spec.Scope.NamespaceSelector) != len(old.Spec.Scope.NamespaceSelector) {
+		return false
+	}
+	for key, value := range r.Spec.Scope.NamespaceSelector {
+		if oldValue, ok := old.Spec.Scope.NamespaceSelector[key]; !ok || oldValue != value {
+			return false
+		}
+	}
+	return true
 }
 
-// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
-func (r *ScaleLoadConfig) ValidateDelete() error {
-	// No validation needed for deletion
+// ValidateNamespaceOverlap rejects the config when its namespace prefix and selector could match
+// namespaces used by another config, since both would then churn the same objects
+func (r *ScaleLoadConfig) ValidateNamespaceOverlap(others []ScaleLoadConfig) error {
+	for i := range others {
+		other := &others[i]
+		if other.Name == r.Name || other.DeletionTimestamp != nil {
+			continue
+		}
+		if r.namespacesOverlap(other) {
+			return fmt.Errorf("namespace prefix %q overlaps with ScaleLoadConfig %q (prefix %q); "+
+				"use a prefix that is not a prefix of the other, or disjoint namespaceSelectors in Namespaced mode",
+				r.namespacePrefix(), other.Name, other.namespacePrefix())
+		}
+	}
 	return nil
 }
 
+// namespacesOverlap reports whether two configs could select the same namespace: their prefixes
+// overlap and, when both run in Namespaced mode, no selector key requires different values
+func (r *ScaleLoadConfig) namespacesOverlap(other *ScaleLoadConfig) bool {
+	prefix, otherPrefix := r.namespacePrefix(), other.namespacePrefix()
+	if !strings.HasPrefix(prefix, otherPrefix) && !strings.HasPrefix(otherPrefix, prefix) {
+		return false
+	}
+	if r.Spec.Scope.Mode != "Namespaced" || other.Spec.Scope.Mode != "Namespaced" {
+		return true
+	}
+	for key, value := range r.Spec.Scope.NamespaceSelector {
+		if otherValue, ok := other.Spec.Scope.NamespaceSelector[key]; ok && otherValue != value {
+			return false
+		}
+	}
+	return true
+}
+
 // validateAPIRateConfiguration ensures only one API rate limiting approach is specified
 func (r *ScaleLoadConfig) validateAPIRateConfiguration() error {
 	loadProfile := r.Spec.LoadProfile
@@ -534,6 +2851,677 @@ func (r *ScaleLoadConfig) validateAPIRateConfiguration() error {
 	return nil
 }
 
+// validateReconcileInterval ensures explicit reconcile intervals are at least one second and
+// scenario intervals name a built-in scenario
+func (r *ScaleLoadConfig) validateReconcileInterval() error {
+	if r.Spec.ReconcileInterval != nil && r.Spec.ReconcileInterval.Duration < time.Second {
+		return fmt.Errorf("reconcileInterval must be at least 1s, got %s", r.Spec.ReconcileInterval.Duration)
+	}
+	for scenario, interval := range r.Spec.ScenarioReconcileIntervals {
+		if ScenarioLoadProfile(scenario) == "" {
+			return fmt.Errorf("scenarioReconcileIntervals has unknown scenario %q", scenario)
+		}
+		if interval.Duration < time.Second {
+			return fmt.Errorf("scenarioReconcileIntervals[%s] must be at least 1s, got %s", scenario, interval.Duration)
+		}
+	}
+	return nil
+}
+
+// validateLoadProfile ensures the selected profile exists and custom profiles do not shadow built-ins
+func (r *ScaleLoadConfig) validateLoadProfile() error {
+	for _, profile := range r.Spec.CustomProfiles {
+		if _, builtin := builtinLoadProfile(profile.Name); builtin {
+			return fmt.Errorf("custom profile %q conflicts with a built-in profile name", profile.Name)
+		}
+		if profile.ReconcileInterval != nil && profile.ReconcileInterval.Duration < time.Second {
+			return fmt.Errorf("custom profile %q reconcileInterval must be at least 1s, got %s", profile.Name, profile.ReconcileInterval.Duration)
+		}
+	}
+	_, err := r.ResolveLoadProfile()
+	return err
+}
+
+// ValidateSafetyLimits rejects configs whose estimated load exceeds the declared safety limits. The
+// webhook runs it at admission and the reconciler before generating load, so a config admitted
+// while the webhook was unavailable is held back too
+func (r *ScaleLoadConfig) ValidateSafetyLimits() error {
+	limits := r.Spec.SafetyLimits
+	if limits == nil {
+		return nil
+	}
+	estimate := r.EstimateLoad()
+	if limits.MaxAPICallsPerMinute != nil && estimate.APICallsPerMinute > int64(*limits.MaxAPICallsPerMinute) {
+		return fmt.Errorf("estimated API call rate of %d per minute at %d nodes exceeds safetyLimits.maxAPICallsPerMinute (%d)",
+			estimate.APICallsPerMinute, estimate.Nodes, *limits.MaxAPICallsPerMinute)
+	}
+	if limits.MaxObjects != nil && estimate.Objects > *limits.MaxObjects {
+		return fmt.Errorf("estimated %d generated objects at %d nodes exceeds safetyLimits.maxObjects (%d)",
+			estimate.Objects, estimate.Nodes, *limits.MaxObjects)
+	}
+	return nil
+}
+
+// EstimateLoad computes the config's target API call rate and object count at the expected node count.
+// It applies the density and rate of the load profile, or of the scenario's profile when none is selected.
+// Preset sections are not resolved, since admission does not read LoadProfilePresets
+func (r *ScaleLoadConfig) EstimateLoad() LoadEstimate {
+	nodes := r.estimatedNodes()
+	estimate := LoadEstimate{Nodes: nodes}
+
+	loadProfile := r.Spec.LoadProfile
+	namespacesPerNode := "0.6"
+	if loadProfile.NamespacesPerNode != nil {
+		namespacesPerNode = *loadProfile.NamespacesPerNode
+	}
+	ratePerNode := int32(20)
+	if loadProfile.APICallRatePerNode != nil {
+		ratePerNode = *loadProfile.APICallRatePerNode
+	}
+	churnMultiplier := 1.0
+	profileName := loadProfile.Profile
+	if profileName == "" {
+		profileName = ScenarioLoadProfile(r.Spec.Scenario)
+	}
+	if profile, err := r.resolveProfile(profileName); err == nil && profile != nil {
+		if profile.NamespacesPerNode != nil {
+			namespacesPerNode = *profile.NamespacesPerNode
+		}
+		if profile.APICallRatePerNode != nil {
+			ratePerNode = *profile.APICallRatePerNode
+		}
+		if profile.ChurnMultiplier != nil {
+			if multiplier, err := strconv.ParseFloat(*profile.ChurnMultiplier, 64); err == nil && multiplier > 0 {
+				churnMultiplier = multiplier
+			}
+		}
+	}
+
+	if loadProfile.APICallRateStatic != nil {
+		estimate.APICallsPerMinute = int64(*loadProfile.APICallRateStatic)
+	} else {
+		estimate.APICallsPerMinute = int64(ratePerNode) * int64(nodes)
+	}
+
+	density, err := strconv.ParseFloat(namespacesPerNode, 64)
+	if err != nil {
+		density = 0.6
+	}
+	namespaces := int64(math.Ceil(float64(nodes) * density))
+	churn := r.Spec.ResourceChurn
+	if churn.Namespaces.Enabled && churn.Namespaces.Maximum > 0 && namespaces > int64(churn.Namespaces.Maximum) {
+		namespaces = int64(churn.Namespaces.Maximum)
+	}
+	if len(r.Spec.Pools) == 0 {
+		estimate.Objects = namespaces + r.estimateNamespaceObjects(namespaces)
+	}
+	// Pools have fixed namespace counts, each with the objects of its own churn
+	for i := range r.Spec.Pools {
+		pool := &r.Spec.Pools[i]
+		poolNamespaces := int64(pool.Namespaces)
+		estimate.Objects += poolNamespaces + r.WithPoolChurn(pool).estimateNamespaceObjects(poolNamespaces)
+	}
+
+	if r.Spec.ControllerLeases.Enabled {
+		estimate.Objects += int64(r.Spec.ControllerLeases.Controllers)
+	}
+	if churn.Events.Enabled {
+		// Events expire after an hour by default, so an hour's worth are stored at any time
+		eventsPerHour := churn.Events.EventsPerNodePerHour
+		if eventsPerHour <= 0 {
+			eventsPerHour = 50
+		}
+		events := float64(eventsPerHour) * churnMultiplier * float64(nodes)
+		if fraction, err := strconv.ParseFloat(churn.Events.SystemEvents.Fraction, 64); err == nil &&
+			churn.Events.SystemEvents.Enabled && fraction > 0 && fraction < 1 {
+			events /= 1 - fraction
+		}
+		estimate.Objects += int64(events)
+	}
+	return estimate
+}
+
+// estimatedNodes returns the node count the load estimate assumes
+func (r *ScaleLoadConfig) estimatedNodes() int32 {
+	if limits := r.Spec.SafetyLimits; limits != nil && limits.ExpectedNodes != nil {
+		return *limits.ExpectedNodes
+	}
+	if nodeManagement := r.Spec.NodeManagement; nodeManagement.Enabled {
+		nodes := nodeManagement.Count
+		if nodeManagement.ScaleSchedule != nil {
+			for _, step := range nodeManagement.ScaleSchedule.Steps {
+				nodes = max(nodes, step.Count)
+			}
+		}
+		return nodes
+	}
+	return r.Status.KwokNodeCount
+}
+
+// estimateNamespaceObjects returns the number of objects generated inside the namespaces. With
+// archetypes, each type's count per namespace is the weighted average over the archetypes
+func (r *ScaleLoadConfig) estimateNamespaceObjects(namespaces int64) int64 {
+	if namespaces == 0 {
+		return 0
+	}
+	churn := r.Spec.ResourceChurn
+
+	type weightedChurn struct {
+		churn  ResourceChurnConfig
+		weight int32
+	}
+	var mixes []weightedChurn
+	var totalWeight int32
+	for i := range r.Spec.NamespaceArchetypes {
+		archetype := &r.Spec.NamespaceArchetypes[i]
+		if archetype.Weight <= 0 {
+			continue
+		}
+		mix := churn
+		archetype.ApplyTo(&mix)
+		mixes = append(mixes, weightedChurn{churn: mix, weight: archetype.Weight})
+		totalWeight += archetype.Weight
+	}
+
+	perType := func(enabled bool, maximum, interval int32, count func(ResourceChurnConfig) float64) int64 {
+		if !enabled {
+			return 0
+		}
+		perNamespace := count(churn)
+		if totalWeight > 0 {
+			perNamespace = 0
+			for _, mix := range mixes {
+				perNamespace += count(mix.churn) * float64(mix.weight) / float64(totalWeight)
+			}
+		}
+		interval = max(interval, 1)
+		selected := (namespaces + int64(interval) - 1) / int64(interval)
+		total := int64(math.Ceil(float64(selected) * perNamespace))
+		if maximum > 0 && total > int64(maximum) {
+			total = int64(maximum)
+		}
+		return total
+	}
+
+	// A bundle is a Deployment with its pods, a Service, a ConfigMap, a Secret, a ServiceAccount and its exposure
+	bundleObjects := 5 + float64(max(churn.AppBundles.Replicas, 0))
+	if churn.AppBundles.Exposure != AppBundleExposureNone {
+		bundleObjects++
+	}
+
+	var objects int64
+	for _, resource := range []struct {
+		config ResourceTypeConfig
+		count  func(ResourceChurnConfig) float64
+	}{
+		{churn.ConfigMaps, func(c ResourceChurnConfig) float64 { return float64(c.ConfigMaps.Count) }},
+		{churn.Secrets, func(c ResourceChurnConfig) float64 { return float64(c.Secrets.Count) }},
+		{churn.Routes, func(c ResourceChurnConfig) float64 { return float64(c.Routes.Count) }},
+		{churn.ImageStreams, func(c ResourceChurnConfig) float64 { return float64(c.ImageStreams.Count) }},
+		{churn.BuildConfigs, func(c ResourceChurnConfig) float64 { return float64(c.BuildConfigs.Count) }},
+	} {
+		objects += perType(resource.config.Enabled, resource.config.Maximum, resource.config.NamespaceInterval, resource.count)
+	}
+	if churn.Pods.Enabled && churn.Pods.PodsPerNode > 0 {
+		// Density pods scale with nodes, not namespaces
+		pods := int64(churn.Pods.PodsPerNode) * int64(r.estimatedNodes())
+		if churn.Pods.Maximum > 0 {
+			pods = min(pods, int64(churn.Pods.Maximum))
+		}
+		objects += pods
+	} else {
+		objects += perType(churn.Pods.Enabled, churn.Pods.Maximum, churn.Pods.NamespaceInterval,
+			func(c ResourceChurnConfig) float64 { return float64(c.Pods.Count) })
+	}
+	objects += perType(churn.AppBundles.Enabled, 0, churn.AppBundles.NamespaceInterval,
+		func(c ResourceChurnConfig) float64 { return float64(c.AppBundles.Count) * bundleObjects })
+
+	etcd := r.Spec.EtcdPressure
+	objects += perType(etcd.Enabled, 0, etcd.NamespaceInterval,
+		func(ResourceChurnConfig) float64 { return float64(etcd.ObjectsPerNamespace) })
+	return objects
+}
+
+// validateZoneOutage ensures a zone outage targets a configured zone and ends before the next one starts
+func (r *ScaleLoadConfig) validateZoneOutage() error {
+	topology := r.Spec.Topology
+	outage := topology.ZoneOutage
+	if !outage.Enabled {
+		return nil
+	}
+	if !topology.Enabled {
+		return fmt.Errorf("topology.zoneOutage requires topology.enabled")
+	}
+	if outage.DurationSeconds >= outage.IntervalSeconds {
+		return fmt.Errorf("topology.zoneOutage.durationSeconds (%d) must be less than intervalSeconds (%d)",
+			outage.DurationSeconds, outage.IntervalSeconds)
+	}
+	if outage.Zone == "" || len(topology.Zones) == 0 {
+		return nil
+	}
+	for _, zone := range topology.Zones {
+		if zone.Name == outage.Zone {
+			return nil
+		}
+	}
+	return fmt.Errorf("topology.zoneOutage.zone %q is not one of the configured zones", outage.Zone)
+}
+
+// validateSchedulingLabelChurn ensures churned labels are valid and leave alone the labels that
+// select KWOK nodes and the topology labels, which the operator relies on
+func (r *ScaleLoadConfig) validateSchedulingLabelChurn() error {
+	churn := r.Spec.SchedulingLabelChurn
+	if !churn.Enabled {
+		return nil
+	}
+	reserved := map[string]bool{"topology.kubernetes.io/zone": true, "topology.kubernetes.io/region": true}
+	if len(r.Spec.KwokNodeSelector) == 0 {
+		reserved["type"] = true
+	}
+	for key := range r.Spec.KwokNodeSelector {
+		reserved[key] = true
+	}
+
+	for _, label := range churn.Labels {
+		if errs := validation.IsQualifiedName(label.Key); len(errs) > 0 {
+			return fmt.Errorf("schedulingLabelChurn label key %q is invalid: %s", label.Key, strings.Join(errs, "; "))
+		}
+		if reserved[label.Key] {
+			return fmt.Errorf("schedulingLabelChurn cannot churn label %q, which selects KWOK nodes or their topology", label.Key)
+		}
+		for _, value := range label.Values {
+			if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+				return fmt.Errorf("schedulingLabelChurn label %q value %q is invalid: %s", label.Key, value, strings.Join(errs, "; "))
+			}
+		}
+	}
+	return nil
+}
+
+// validateMirrorPods ensures every component can prefix a pod name
+func (r *ScaleLoadConfig) validateMirrorPods() error {
+	if !r.Spec.MirrorPods.Enabled {
+		return nil
+	}
+	for _, component := range r.Spec.MirrorPods.Components {
+		if errs := validation.IsDNS1123Label(component); len(errs) > 0 {
+			return fmt.Errorf("mirrorPods component %q is invalid: %s", component, strings.Join(errs, "; "))
+		}
+	}
+	return nil
+}
+
+// validatePodDensity ensures the pods-per-node density fits a node: under the supported maximum and,
+// when the operator manages the KWOK nodes, under the allocatable pods it gives them
+func (r *ScaleLoadConfig) validatePodDensity() error {
+	pods := r.Spec.ResourceChurn.Pods
+	if !pods.Enabled || pods.PodsPerNode == 0 {
+		return nil
+	}
+	if pods.PodsPerNode > MaxPodsPerNode {
+		return fmt.Errorf("resourceChurn.pods.podsPerNode %d exceeds the supported maximum of %d pods per node",
+			pods.PodsPerNode, MaxPodsPerNode)
+	}
+	if r.Spec.NodeManagement.Enabled {
+		allocatable := r.Spec.NodeManagement.Pods
+		if allocatable == 0 {
+			allocatable = DefaultNodeAllocatablePods
+		}
+		if pods.PodsPerNode > allocatable {
+			return fmt.Errorf("resourceChurn.pods.podsPerNode %d exceeds the %d allocatable pods of managed KWOK nodes (nodeManagement.pods)",
+				pods.PodsPerNode, allocatable)
+		}
+	}
+	return nil
+}
+
+// validateJanitor ensures an enabled janitor has something to prune
+func (r *ScaleLoadConfig) validateJanitor() error {
+	janitor := r.Spec.Janitor
+	if !janitor.Enabled {
+		return nil
+	}
+	if janitor.EventTTLSeconds == 0 && janitor.CompletedPodTTLSeconds == 0 && janitor.RevisionTTLSeconds == 0 {
+		return fmt.Errorf("janitor is enabled but eventTTLSeconds, completedPodTTLSeconds and revisionTTLSeconds are all 0")
+	}
+	return nil
+}
+
+// validateClusterNetwork ensures the cluster network model's CIDRs are IPv4 networks and that the
+// host prefix splits the cluster CIDR into node subnets
+func (r *ScaleLoadConfig) validateClusterNetwork() error {
+	model := r.Spec.AnnotationChurn.ClusterNetwork
+	if model == nil {
+		return nil
+	}
+	clusterCIDR := model.ClusterCIDR
+	if clusterCIDR == "" {
+		clusterCIDR = "10.128.0.0/14"
+	}
+	_, clusterNet, err := net.ParseCIDR(clusterCIDR)
+	if err != nil || clusterNet.IP.To4() == nil {
+		return fmt.Errorf("annotationChurn.clusterNetwork.clusterCIDR %q must be an IPv4 CIDR", model.ClusterCIDR)
+	}
+	if model.MachineCIDR != "" {
+		if _, machineNet, err := net.ParseCIDR(model.MachineCIDR); err != nil || machineNet.IP.To4() == nil {
+			return fmt.Errorf("annotationChurn.clusterNetwork.machineCIDR %q must be an IPv4 CIDR", model.MachineCIDR)
+		}
+	}
+	clusterPrefix, _ := clusterNet.Mask.Size()
+	if model.HostPrefix != 0 && int(model.HostPrefix) <= clusterPrefix {
+		return fmt.Errorf("annotationChurn.clusterNetwork.hostPrefix %d must be longer than the clusterCIDR prefix /%d",
+			model.HostPrefix, clusterPrefix)
+	}
+	return nil
+}
+
+// validateTargetClusters ensures every target cluster has exactly one connection source
+func (r *ScaleLoadConfig) validateTargetClusters() error {
+	for _, target := range r.Spec.TargetClusters {
+		if target.KubeconfigSecretRef != nil && target.HostedClusterRef != nil {
+			return fmt.Errorf("target cluster %q must set only one of kubeconfigSecretRef or hostedClusterRef", target.Name)
+		}
+		if target.KubeconfigSecretRef == nil && target.HostedClusterRef == nil {
+			return fmt.Errorf("target cluster %q must set one of kubeconfigSecretRef or hostedClusterRef", target.Name)
+		}
+	}
+	return nil
+}
+
+// validateNotifications rejects webhook URLs the operator could not post to
+func (r *ScaleLoadConfig) validateNotifications() error {
+	if r.Spec.Notifications == nil {
+		return nil
+	}
+	webhook, err := url.Parse(r.Spec.Notifications.WebhookURL)
+	if err != nil {
+		return fmt.Errorf("invalid notifications webhookURL: %w", err)
+	}
+	if (webhook.Scheme != "http" && webhook.Scheme != "https") || webhook.Host == "" {
+		return fmt.Errorf("notifications webhookURL %q must be an absolute http or https URL", r.Spec.Notifications.WebhookURL)
+	}
+	if ref := r.Spec.Notifications.AuthSecretRef; ref != nil && (ref.Namespace == "" || ref.Name == "") {
+		return fmt.Errorf("notifications authSecretRef must set namespace and name")
+	}
+	return nil
+}
+
+// validateGates rejects gates the operator could not evaluate
+func (r *ScaleLoadConfig) validateGates() error {
+	if r.Spec.Gates == nil {
+		return nil
+	}
+	endpoint, err := url.Parse(r.Spec.Gates.PrometheusURL)
+	if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+		return fmt.Errorf("gates prometheusURL %q must be an absolute http or https URL", r.Spec.Gates.PrometheusURL)
+	}
+	if ref := r.Spec.Gates.AuthSecretRef; ref != nil && (ref.Namespace == "" || ref.Name == "") {
+		return fmt.Errorf("gates authSecretRef must set namespace and name")
+	}
+	for _, rule := range r.Spec.Gates.Rules {
+		if strings.TrimSpace(rule.Query) == "" {
+			return fmt.Errorf("gate %q has an empty query", rule.Name)
+		}
+		if _, err := strconv.ParseFloat(rule.Threshold, 64); err != nil {
+			return fmt.Errorf("gate %q has an invalid threshold %q", rule.Name, rule.Threshold)
+		}
+	}
+	return nil
+}
+
+// validateImports rejects imports without a complete reference and the same object imported twice
+func (r *ScaleLoadConfig) validateImports() error {
+	seen := make(map[string]bool, len(r.Spec.Imports))
+	for _, imp := range r.Spec.Imports {
+		if imp.Namespace == "" || imp.Name == "" {
+			return fmt.Errorf("imports entry %q must set both namespace and name", imp.Namespace+"/"+imp.Name)
+		}
+		kind := imp.Kind
+		if kind == "" {
+			kind = "ConfigMap"
+		}
+		ref := kind + " " + imp.Namespace + "/" + imp.Name
+		if seen[ref] {
+			return fmt.Errorf("imports lists %s more than once", ref)
+		}
+		seen[ref] = true
+	}
+	return nil
+}
+
+// ValidateProtectedNamespaces rejects namespace settings that would have the operator write to
+// protected or excluded namespaces, so a misconfigured prefix fails before anything is created
+func (r *ScaleLoadConfig) ValidateProtectedNamespaces() error {
+	for _, pattern := range r.Spec.ExcludedNamespaces {
+		if strings.TrimSuffix(pattern, "*") == "" {
+			return fmt.Errorf("excludedNamespaces entry %q must name a namespace or a namespace prefix", pattern)
+		}
+	}
+
+	prefix := r.namespacePrefix()
+	if !strings.HasPrefix(prefix, ReservedNamespacePrefix) {
+		for _, protected := range ProtectedNamespacePrefixes {
+			if strings.HasPrefix(prefix, protected) || strings.HasPrefix(protected, prefix) {
+				return fmt.Errorf("namespaceConfig.namespacePrefix %q can match the protected %s* namespaces; "+
+					"use a prefix outside them, such as %q", prefix, protected, ReservedNamespacePrefix)
+			}
+		}
+	}
+	for _, pattern := range r.Spec.ExcludedNamespaces {
+		if excludedPrefix, isPrefix := strings.CutSuffix(pattern, "*"); isPrefix && strings.HasPrefix(prefix, excludedPrefix) {
+			return fmt.Errorf("namespaceConfig.namespacePrefix %q is excluded by excludedNamespaces entry %q", prefix, pattern)
+		}
+	}
+
+	if r.Spec.ControllerLeases.Enabled && IsProtectedNamespace(r.Spec.ControllerLeases.Namespace, r.Spec.ExcludedNamespaces) {
+		return fmt.Errorf("controllerLeases.namespace %q is protected; choose a namespace outside openshift-*, kube-* and excludedNamespaces",
+			r.Spec.ControllerLeases.Namespace)
+	}
+	if r.Spec.MirrorPods.Enabled && IsProtectedNamespace(r.Spec.MirrorPods.Namespace, r.Spec.ExcludedNamespaces) {
+		return fmt.Errorf("mirrorPods.namespace %q is protected; choose a namespace outside openshift-*, kube-* and excludedNamespaces",
+			r.Spec.MirrorPods.Namespace)
+	}
+	systemEvents := r.Spec.ResourceChurn.Events.SystemEvents
+	if systemEvents.Enabled && IsProtectedNamespace(systemEvents.Namespace, r.Spec.ExcludedNamespaces) {
+		return fmt.Errorf("resourceChurn.events.systemEvents.namespace %q is protected; choose a namespace outside openshift-*, kube-* and excludedNamespaces",
+			systemEvents.Namespace)
+	}
+	return nil
+}
+
+// validateNamespaceTargeting ensures every per-type namespace selector parses and every index range is ordered
+func (r *ScaleLoadConfig) validateNamespaceTargeting() error {
+	churn := r.Spec.ResourceChurn
+	targeting := []struct {
+		name   string
+		target *NamespaceTargeting
+	}{
+		{"configMaps", churn.ConfigMaps.NamespaceTargeting},
+		{"secrets", churn.Secrets.NamespaceTargeting},
+		{"routes", churn.Routes.NamespaceTargeting},
+		{"imageStreams", churn.ImageStreams.NamespaceTargeting},
+		{"buildConfigs", churn.BuildConfigs.NamespaceTargeting},
+		{"pods", churn.Pods.NamespaceTargeting},
+		{"appBundles", churn.AppBundles.NamespaceTargeting},
+	}
+	for _, entry := range targeting {
+		name, target := entry.name, entry.target
+		if target == nil {
+			continue
+		}
+		if target.Selector != nil {
+			if _, err := metav1.LabelSelectorAsSelector(target.Selector); err != nil {
+				return fmt.Errorf("resourceChurn.%s.namespaceTargeting.selector is invalid: %w", name, err)
+			}
+		}
+		if target.IndexRange != nil && target.IndexRange.From > target.IndexRange.To {
+			return fmt.Errorf("resourceChurn.%s.namespaceTargeting.indexRange.from (%d) must not exceed to (%d)",
+				name, target.IndexRange.From, target.IndexRange.To)
+		}
+	}
+	return nil
+}
+
+// validateDataProviders ensures data providers are only set on the resource types whose payload is
+// synthesized, and that the built-in providers have the options they need. Other names may be
+// providers registered by a program embedding the operator, so they are checked when the provider is built
+func (r *ScaleLoadConfig) validateDataProviders() error {
+	churn := r.Spec.ResourceChurn
+	providers := []struct {
+		name      string
+		provider  *DataProviderConfig
+		supported bool
+	}{
+		{"configMaps", churn.ConfigMaps.DataProvider, true},
+		{"secrets", churn.Secrets.DataProvider, true},
+		{"routes", churn.Routes.DataProvider, false},
+		{"imageStreams", churn.ImageStreams.DataProvider, false},
+		{"buildConfigs", churn.BuildConfigs.DataProvider, false},
+	}
+	for _, entry := range providers {
+		name, provider := entry.name, entry.provider
+		if provider == nil {
+			continue
+		}
+		if !entry.supported {
+			return fmt.Errorf("resourceChurn.%s.dataProvider is not supported, only configMaps and secrets have a synthesized payload", name)
+		}
+		if provider.Name != DataProviderSampleFile {
+			continue
+		}
+		if provider.Options["path"] == "" {
+			return fmt.Errorf("resourceChurn.%s.dataProvider %q requires the path option", name, DataProviderSampleFile)
+		}
+		if keep, ok := provider.Options["keepSecretValues"]; ok {
+			if _, err := strconv.ParseBool(keep); err != nil {
+				return fmt.Errorf("resourceChurn.%s.dataProvider option keepSecretValues %q is not a boolean", name, keep)
+			}
+		}
+	}
+	return nil
+}
+
+// validatePools ensures pool names are unique and pool prefixes stay inside the config's prefix, so
+// prefix overlap and protection checks on the config cover the pools too. Namespaced mode creates
+// no namespaces, so it has no pools
+func (r *ScaleLoadConfig) validatePools() error {
+	if len(r.Spec.Pools) == 0 {
+		return nil
+	}
+	if r.Spec.Scope.Mode == "Namespaced" {
+		return fmt.Errorf("pools cannot be used with scope.mode Namespaced, which uses existing namespaces")
+	}
+	seen := make(map[string]bool)
+	for i := range r.Spec.Pools {
+		pool := &r.Spec.Pools[i]
+		if seen[pool.Name] {
+			return fmt.Errorf("pools has more than one pool named %q", pool.Name)
+		}
+		seen[pool.Name] = true
+
+		prefix := r.PoolPrefix(pool)
+		if !strings.HasPrefix(prefix, r.namespacePrefix()) {
+			return fmt.Errorf("pools %q namespacePrefix %q must start with namespaceConfig.namespacePrefix %q",
+				pool.Name, prefix, r.namespacePrefix())
+		}
+		for _, pattern := range r.Spec.ExcludedNamespaces {
+			if excludedPrefix, isPrefix := strings.CutSuffix(pattern, "*"); isPrefix && strings.HasPrefix(prefix, excludedPrefix) {
+				return fmt.Errorf("pools %q namespacePrefix %q is excluded by excludedNamespaces entry %q", pool.Name, prefix, pattern)
+			}
+		}
+
+		if pool.ResourceChurn == nil {
+			continue
+		}
+		poolConfig := r.WithPoolChurn(pool)
+		for _, validate := range []func() error{poolConfig.validatePodDensity, poolConfig.validateNamespaceTargeting,
+			poolConfig.validateDataProviders, poolConfig.validateNamespaceArchetypes} {
+			if err := validate(); err != nil {
+				return fmt.Errorf("pools %q: %w", pool.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateNodeSelectors ensures the labels pinning namespaces to KWOK nodes are valid and do not
+// contradict kwokNodeSelector, which would leave the namespaces no node to run on
+func (r *ScaleLoadConfig) validateNodeSelectors() error {
+	kwokSelector := r.Spec.KwokNodeSelector
+	if len(kwokSelector) == 0 {
+		kwokSelector = map[string]string{"type": "kwok"}
+	}
+	check := func(field string, selector map[string]string) error {
+		for key, value := range selector {
+			if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+				return fmt.Errorf("%s key %q is invalid: %s", field, key, strings.Join(errs, "; "))
+			}
+			if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+				return fmt.Errorf("%s label %q value %q is invalid: %s", field, key, value, strings.Join(errs, "; "))
+			}
+			if kwokValue, ok := kwokSelector[key]; ok && kwokValue != value {
+				return fmt.Errorf("%s label %q=%q contradicts kwokNodeSelector %q=%q, so no KWOK node can match",
+					field, key, value, key, kwokValue)
+			}
+		}
+		return nil
+	}
+
+	if err := check("namespaceConfig.nodeSelector", r.Spec.NamespaceConfig.NodeSelector); err != nil {
+		return err
+	}
+	for i := range r.Spec.Pools {
+		pool := &r.Spec.Pools[i]
+		if err := check(fmt.Sprintf("pools %q nodeSelector", pool.Name), pool.NodeSelector); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateNamespaceArchetypes ensures archetype names are unique, at least one archetype receives
+// namespaces, and no override leaves a type's update window inverted
+func (r *ScaleLoadConfig) validateNamespaceArchetypes() error {
+	if len(r.Spec.NamespaceArchetypes) == 0 {
+		return nil
+	}
+	seen := make(map[string]bool)
+	totalWeight := int32(0)
+	for i := range r.Spec.NamespaceArchetypes {
+		archetype := &r.Spec.NamespaceArchetypes[i]
+		if seen[archetype.Name] {
+			return fmt.Errorf("namespaceArchetypes has more than one archetype named %q", archetype.Name)
+		}
+		seen[archetype.Name] = true
+		totalWeight += archetype.Weight
+
+		churn := r.Spec.ResourceChurn
+		archetype.ApplyTo(&churn)
+		windows := []struct {
+			name     string
+			min, max int32
+		}{
+			{"configMaps", churn.ConfigMaps.UpdateFrequencyMin, churn.ConfigMaps.UpdateFrequencyMax},
+			{"secrets", churn.Secrets.UpdateFrequencyMin, churn.Secrets.UpdateFrequencyMax},
+			{"routes", churn.Routes.UpdateFrequencyMin, churn.Routes.UpdateFrequencyMax},
+			{"imageStreams", churn.ImageStreams.UpdateFrequencyMin, churn.ImageStreams.UpdateFrequencyMax},
+			{"buildConfigs", churn.BuildConfigs.UpdateFrequencyMin, churn.BuildConfigs.UpdateFrequencyMax},
+			{"pods", churn.Pods.UpdateFrequencyMin, churn.Pods.UpdateFrequencyMax},
+			{"appBundles", churn.AppBundles.UpdateFrequencyMin, churn.AppBundles.UpdateFrequencyMax},
+		}
+		for _, window := range windows {
+			if window.min > window.max {
+				return fmt.Errorf("namespaceArchetypes %q leaves %s updateFrequencyMin (%d) above updateFrequencyMax (%d)",
+					archetype.Name, window.name, window.min, window.max)
+			}
+		}
+	}
+	if totalWeight == 0 {
+		return fmt.Errorf("namespaceArchetypes must give at least one archetype a positive weight")
+	}
+	return nil
+}
+
 func init() {
 	SchemeBuilder.Register(&ScaleLoadConfig{}, &ScaleLoadConfigList{})
 }
@@ -1,7 +1,9 @@
 package v1
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -147,12 +149,23 @@ func TestScaleLoadConfig_ValidateCreate(t *testing.T) {
 		},
 	}
 
-	err := config.ValidateCreate()
+	_, err := (&ScaleLoadConfigWebhook{}).ValidateCreate(context.Background(), &config)
 	if err == nil {
 		t.Error("Expected ValidateCreate to fail with both rate fields set")
 	}
 }
 
+func TestScaleLoadConfigWebhook_Default(t *testing.T) {
+	config := ScaleLoadConfig{ObjectMeta: metav1.ObjectMeta{Name: "test"}}
+
+	if err := (&ScaleLoadConfigWebhook{}).Default(context.Background(), &config); err != nil {
+		t.Fatalf("Expected Default to succeed, got: %v", err)
+	}
+	if config.Annotations[LoadEstimateAnnotation] == "" {
+		t.Errorf("Expected the %s annotation to be set", LoadEstimateAnnotation)
+	}
+}
+
 func TestScaleLoadConfig_ValidateUpdate(t *testing.T) {
 	config := ScaleLoadConfig{
 		ObjectMeta: metav1.ObjectMeta{Name: "test"},
@@ -172,28 +185,1474 @@ func TestScaleLoadConfig_ValidateUpdate(t *testing.T) {
 		},
 	}
 
-	err := config.ValidateUpdate(&oldConfig)
+	_, err := (&ScaleLoadConfigWebhook{}).ValidateUpdate(context.Background(), &oldConfig, &config)
 	if err != nil {
 		t.Errorf("Expected ValidateUpdate to pass with valid config, got: %v", err)
 	}
 }
 
-// Helper functions
-func int32Ptr(i int32) *int32 {
-	return &i
+func TestScaleLoadConfig_ValidateUpdateSkipsUnchangedSpec(t *testing.T) {
+	// The default per-node rate estimate exceeds this limit, so the spec fails validation
+	overLimit := ScaleLoadConfigSpec{
+		SafetyLimits: &SafetyLimits{ExpectedNodes: int32Ptr(100), MaxAPICallsPerMinute: int32Ptr(1000)},
+	}
+	deleted := metav1.Now()
+
+	tests := []struct {
+		name      string
+		oldConfig ScaleLoadConfig
+		config    ScaleLoadConfig
+		wantError bool
+	}{
+		{
+			name:      "finalizer removal with unchanged spec",
+			oldConfig: ScaleLoadConfig{ObjectMeta: metav1.ObjectMeta{Name: "test", Finalizers: []string{"cleanup"}}, Spec: overLimit},
+			config:    ScaleLoadConfig{ObjectMeta: metav1.ObjectMeta{Name: "test"}, Spec: overLimit},
+			wantError: false,
+		},
+		{
+			name:      "spec change on deleting config",
+			oldConfig: ScaleLoadConfig{ObjectMeta: metav1.ObjectMeta{Name: "test", DeletionTimestamp: &deleted}},
+			config:    ScaleLoadConfig{ObjectMeta: metav1.ObjectMeta{Name: "test", DeletionTimestamp: &deleted}, Spec: overLimit},
+			wantError: false,
+		},
+		{
+			name:      "spec change on live config",
+			oldConfig: ScaleLoadConfig{ObjectMeta: metav1.ObjectMeta{Name: "test"}},
+			config:    ScaleLoadConfig{ObjectMeta: metav1.ObjectMeta{Name: "test"}, Spec: overLimit},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := (&ScaleLoadConfigWebhook{}).ValidateUpdate(context.Background(), &tt.oldConfig, &tt.config)
+			if tt.wantError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.wantError && err != nil {
+				t.Errorf("Expected no error but got: %v", err)
+			}
+		})
+	}
 }
 
-func contains(s, substr string) bool {
-	return len(s) >= len(substr) && (s == substr || (len(s) > len(substr) &&
-		(s[:len(substr)] == substr || s[len(s)-len(substr):] == substr ||
-			containsSubstring(s, substr))))
+func TestScaleLoadConfig_ValidateTargetClusters(t *testing.T) {
+	tests := []struct {
+		name        string
+		targets     []TargetCluster
+		wantError   bool
+		errorString string
+	}{
+		{
+			name:      "no target clusters",
+			wantError: false,
+		},
+		{
+			name: "valid kubeconfig secret target",
+			targets: []TargetCluster{{
+				Name:                "spoke-1",
+				KubeconfigSecretRef: &KubeconfigSecretReference{Namespace: "ns", Name: "spoke-1"},
+			}},
+			wantError: false,
+		},
+		{
+			name: "valid hosted cluster target",
+			targets: []TargetCluster{{
+				Name:             "hosted-1",
+				HostedClusterRef: &HostedClusterReference{Namespace: "clusters", Name: "hosted-1"},
+			}},
+			wantError: false,
+		},
+		{
+			name: "invalid both references set",
+			targets: []TargetCluster{{
+				Name:                "spoke-1",
+				KubeconfigSecretRef: &KubeconfigSecretReference{Namespace: "ns", Name: "spoke-1"},
+				HostedClusterRef:    &HostedClusterReference{Namespace: "clusters", Name: "hosted-1"},
+			}},
+			wantError:   true,
+			errorString: "must set only one of",
+		},
+		{
+			name:        "invalid no reference set",
+			targets:     []TargetCluster{{Name: "spoke-1"}},
+			wantError:   true,
+			errorString: "must set one of",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := ScaleLoadConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec:       ScaleLoadConfigSpec{TargetClusters: tt.targets},
+			}
+			err := config.validateTargetClusters()
+
+			if tt.wantError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+					return
+				}
+				if tt.errorString != "" && !contains(err.Error(), tt.errorString) {
+					t.Errorf("Expected error containing '%s', got '%s'", tt.errorString, err.Error())
+				}
+			} else {
+				if err != nil {
+					t.Errorf("Expected no error but got: %v", err)
+				}
+			}
+		})
+	}
 }
 
-func containsSubstring(s, substr string) bool {
-	for i := 0; i <= len(s)-len(substr); i++ {
-		if s[i:i+len(substr)] == substr {
-			return true
-		}
+func TestScaleLoadConfig_ValidateReconcileInterval(t *testing.T) {
+	tests := []struct {
+		name      string
+		interval  *metav1.Duration
+		scenarios map[string]metav1.Duration
+		wantError bool
+	}{
+		{name: "unset", interval: nil, wantError: false},
+		{name: "ten seconds", interval: &metav1.Duration{Duration: 10 * time.Second}, wantError: false},
+		{name: "one second", interval: &metav1.Duration{Duration: time.Second}, wantError: false},
+		{name: "sub-second", interval: &metav1.Duration{Duration: 500 * time.Millisecond}, wantError: true},
+		{name: "zero", interval: &metav1.Duration{}, wantError: true},
+		{
+			name:      "scenario interval",
+			scenarios: map[string]metav1.Duration{ScenarioCIBurst: {Duration: 10 * time.Second}},
+			wantError: false,
+		},
+		{
+			name:      "scenario interval sub-second",
+			scenarios: map[string]metav1.Duration{ScenarioUpgradeStorm: {Duration: 500 * time.Millisecond}},
+			wantError: true,
+		},
+		{
+			name:      "unknown scenario",
+			scenarios: map[string]metav1.Duration{"black-friday": {Duration: 10 * time.Second}},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := ScaleLoadConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec:       ScaleLoadConfigSpec{ReconcileInterval: tt.interval, ScenarioReconcileIntervals: tt.scenarios},
+			}
+			err := config.validateReconcileInterval()
+
+			if tt.wantError && err == nil {
+				t.Errorf("Expected error but got none")
+			}
+			if !tt.wantError && err != nil {
+				t.Errorf("Expected no error but got: %v", err)
+			}
+		})
+	}
+}
+
+func TestScaleLoadConfig_ValidateLoadProfile(t *testing.T) {
+	tests := []struct {
+		name           string
+		profile        string
+		customProfiles []ProfileDefinition
+		wantError      bool
+		errorString    string
+	}{
+		{
+			name:      "no profile",
+			wantError: false,
+		},
+		{
+			name:      "built-in profile",
+			profile:   LoadProfileHeavy,
+			wantError: false,
+		},
+		{
+			name:           "custom profile",
+			profile:        "team-standard",
+			customProfiles: []ProfileDefinition{{Name: "team-standard"}},
+			wantError:      false,
+		},
+		{
+			name:        "unknown profile",
+			profile:     "extreme",
+			wantError:   true,
+			errorString: "neither a built-in profile",
+		},
+		{
+			name:           "custom profile shadows built-in",
+			customProfiles: []ProfileDefinition{{Name: LoadProfileLight}},
+			wantError:      true,
+			errorString:    "conflicts with a built-in",
+		},
+		{
+			name:    "custom profile reconcile interval too short",
+			profile: "burst",
+			customProfiles: []ProfileDefinition{{
+				Name:              "burst",
+				ReconcileInterval: &metav1.Duration{Duration: 100 * time.Millisecond},
+			}},
+			wantError:   true,
+			errorString: "must be at least 1s",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := ScaleLoadConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: ScaleLoadConfigSpec{
+					LoadProfile:    LoadProfile{Profile: tt.profile},
+					CustomProfiles: tt.customProfiles,
+				},
+			}
+			err := config.validateLoadProfile()
+
+			if tt.wantError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+					return
+				}
+				if tt.errorString != "" && !contains(err.Error(), tt.errorString) {
+					t.Errorf("Expected error containing '%s', got '%s'", tt.errorString, err.Error())
+				}
+			} else {
+				if err != nil {
+					t.Errorf("Expected no error but got: %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestScaleLoadConfig_ValidateZoneOutage(t *testing.T) {
+	zones := []ZoneConfig{{Name: "zone-a", Weight: 1}, {Name: "zone-b", Weight: 1}}
+
+	tests := []struct {
+		name        string
+		topology    TopologyConfig
+		wantError   bool
+		errorString string
+	}{
+		{
+			name:      "outage disabled",
+			topology:  TopologyConfig{ZoneOutage: ZoneOutageConfig{Enabled: false}},
+			wantError: false,
+		},
+		{
+			name: "valid outage",
+			topology: TopologyConfig{Enabled: true, Zones: zones,
+				ZoneOutage: ZoneOutageConfig{Enabled: true, Zone: "zone-b", IntervalSeconds: 600, DurationSeconds: 120}},
+			wantError: false,
+		},
+		{
+			name: "valid outage with default zone",
+			topology: TopologyConfig{Enabled: true,
+				ZoneOutage: ZoneOutageConfig{Enabled: true, IntervalSeconds: 600, DurationSeconds: 120}},
+			wantError: false,
+		},
+		{
+			name: "invalid topology disabled",
+			topology: TopologyConfig{Enabled: false,
+				ZoneOutage: ZoneOutageConfig{Enabled: true, IntervalSeconds: 600, DurationSeconds: 120}},
+			wantError:   true,
+			errorString: "requires topology.enabled",
+		},
+		{
+			name: "invalid duration not shorter than interval",
+			topology: TopologyConfig{Enabled: true,
+				ZoneOutage: ZoneOutageConfig{Enabled: true, IntervalSeconds: 600, DurationSeconds: 600}},
+			wantError:   true,
+			errorString: "must be less than intervalSeconds",
+		},
+		{
+			name: "invalid unknown zone",
+			topology: TopologyConfig{Enabled: true, Zones: zones,
+				ZoneOutage: ZoneOutageConfig{Enabled: true, Zone: "zone-z", IntervalSeconds: 600, DurationSeconds: 120}},
+			wantError:   true,
+			errorString: "is not one of the configured zones",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := ScaleLoadConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec:       ScaleLoadConfigSpec{Topology: tt.topology},
+			}
+			err := config.validateZoneOutage()
+
+			if tt.wantError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+					return
+				}
+				if tt.errorString != "" && !contains(err.Error(), tt.errorString) {
+					t.Errorf("Expected error containing '%s', got '%s'", tt.errorString, err.Error())
+				}
+			} else {
+				if err != nil {
+					t.Errorf("Expected no error but got: %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestScaleLoadConfig_ValidateClusterNetwork(t *testing.T) {
+	tests := []struct {
+		name        string
+		model       *ClusterNetworkModel
+		wantError   bool
+		errorString string
+	}{
+		{name: "no model", model: nil, wantError: false},
+		{name: "defaults", model: &ClusterNetworkModel{}, wantError: false},
+		{
+			name:      "valid model",
+			model:     &ClusterNetworkModel{ClusterCIDR: "10.128.0.0/14", HostPrefix: 23, MachineCIDR: "10.0.0.0/16"},
+			wantError: false,
+		},
+		{
+			name:        "invalid cluster CIDR",
+			model:       &ClusterNetworkModel{ClusterCIDR: "10.128.0.0"},
+			wantError:   true,
+			errorString: "clusterCIDR",
+		},
+		{
+			name:        "IPv6 cluster CIDR",
+			model:       &ClusterNetworkModel{ClusterCIDR: "fd01::/48"},
+			wantError:   true,
+			errorString: "must be an IPv4 CIDR",
+		},
+		{
+			name:        "invalid machine CIDR",
+			model:       &ClusterNetworkModel{MachineCIDR: "10.0.0.0/33"},
+			wantError:   true,
+			errorString: "machineCIDR",
+		},
+		{
+			name:        "host prefix not longer than cluster prefix",
+			model:       &ClusterNetworkModel{ClusterCIDR: "10.128.0.0/14", HostPrefix: 14},
+			wantError:   true,
+			errorString: "must be longer than the clusterCIDR prefix",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := ScaleLoadConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec:       ScaleLoadConfigSpec{AnnotationChurn: AnnotationChurnConfig{ClusterNetwork: tt.model}},
+			}
+			err := config.validateClusterNetwork()
+
+			if tt.wantError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+					return
+				}
+				if tt.errorString != "" && !contains(err.Error(), tt.errorString) {
+					t.Errorf("Expected error containing '%s', got '%s'", tt.errorString, err.Error())
+				}
+			} else {
+				if err != nil {
+					t.Errorf("Expected no error but got: %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestScaleLoadConfig_ValidateSchedulingLabelChurn(t *testing.T) {
+	tests := []struct {
+		name         string
+		churn        SchedulingLabelChurnConfig
+		nodeSelector map[string]string
+		wantError    bool
+		errorString  string
+	}{
+		{name: "disabled", churn: SchedulingLabelChurnConfig{Labels: []SchedulingLabel{{Key: "type"}}}, wantError: false},
+		{name: "default labels", churn: SchedulingLabelChurnConfig{Enabled: true}, wantError: false},
+		{
+			name: "valid labels",
+			churn: SchedulingLabelChurnConfig{Enabled: true, Labels: []SchedulingLabel{
+				{Key: "node-role.kubernetes.io/worker"},
+				{Key: "pool", Values: []string{"a", "b"}},
+			}},
+			wantError: false,
+		},
+		{
+			name:        "invalid key",
+			churn:       SchedulingLabelChurnConfig{Enabled: true, Labels: []SchedulingLabel{{Key: "bad key"}}},
+			wantError:   true,
+			errorString: "label key",
+		},
+		{
+			name:        "invalid value",
+			churn:       SchedulingLabelChurnConfig{Enabled: true, Labels: []SchedulingLabel{{Key: "pool", Values: []string{"a b"}}}},
+			wantError:   true,
+			errorString: "value",
+		},
+		{
+			name:        "default KWOK selector label",
+			churn:       SchedulingLabelChurnConfig{Enabled: true, Labels: []SchedulingLabel{{Key: "type"}}},
+			wantError:   true,
+			errorString: "selects KWOK nodes",
+		},
+		{
+			name:         "custom KWOK selector label",
+			churn:        SchedulingLabelChurnConfig{Enabled: true, Labels: []SchedulingLabel{{Key: "sim/kwok"}}},
+			nodeSelector: map[string]string{"sim/kwok": "true"},
+			wantError:    true,
+			errorString:  "selects KWOK nodes",
+		},
+		{
+			name:         "type label with custom selector",
+			churn:        SchedulingLabelChurnConfig{Enabled: true, Labels: []SchedulingLabel{{Key: "type"}}},
+			nodeSelector: map[string]string{"sim/kwok": "true"},
+			wantError:    false,
+		},
+		{
+			name:        "topology label",
+			churn:       SchedulingLabelChurnConfig{Enabled: true, Labels: []SchedulingLabel{{Key: "topology.kubernetes.io/zone"}}},
+			wantError:   true,
+			errorString: "topology",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := ScaleLoadConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec:       ScaleLoadConfigSpec{SchedulingLabelChurn: tt.churn, KwokNodeSelector: tt.nodeSelector},
+			}
+			err := config.validateSchedulingLabelChurn()
+
+			if tt.wantError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+					return
+				}
+				if tt.errorString != "" && !contains(err.Error(), tt.errorString) {
+					t.Errorf("Expected error containing '%s', got '%s'", tt.errorString, err.Error())
+				}
+			} else {
+				if err != nil {
+					t.Errorf("Expected no error but got: %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestScaleLoadConfig_ValidateMirrorPods(t *testing.T) {
+	tests := []struct {
+		name        string
+		mirrorPods  MirrorPodsConfig
+		wantError   bool
+		errorString string
+	}{
+		{name: "disabled", mirrorPods: MirrorPodsConfig{Components: []string{"Bad_Name"}}, wantError: false},
+		{name: "default components", mirrorPods: MirrorPodsConfig{Enabled: true}, wantError: false},
+		{
+			name:       "valid components",
+			mirrorPods: MirrorPodsConfig{Enabled: true, Components: []string{"etcd", "kube-apiserver"}},
+			wantError:  false,
+		},
+		{
+			name:        "invalid component",
+			mirrorPods:  MirrorPodsConfig{Enabled: true, Components: []string{"Kube_Scheduler"}},
+			wantError:   true,
+			errorString: "component",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := ScaleLoadConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec:       ScaleLoadConfigSpec{MirrorPods: tt.mirrorPods},
+			}
+			err := config.validateMirrorPods()
+
+			if tt.wantError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+					return
+				}
+				if tt.errorString != "" && !contains(err.Error(), tt.errorString) {
+					t.Errorf("Expected error containing '%s', got '%s'", tt.errorString, err.Error())
+				}
+			} else {
+				if err != nil {
+					t.Errorf("Expected no error but got: %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestScaleLoadConfig_ValidatePodDensity(t *testing.T) {
+	tests := []struct {
+		name           string
+		pods           PodConfig
+		nodeManagement NodeManagementConfig
+		wantError      bool
+		errorString    string
+	}{
+		{name: "no density", pods: PodConfig{Enabled: true, Count: 5}, wantError: false},
+		{name: "disabled pods", pods: PodConfig{PodsPerNode: 1000}, wantError: false},
+		{name: "supported density", pods: PodConfig{Enabled: true, PodsPerNode: 250}, wantError: false},
+		{
+			name:        "over the supported maximum",
+			pods:        PodConfig{Enabled: true, PodsPerNode: 600},
+			wantError:   true,
+			errorString: "supported maximum",
+		},
+		{
+			name:           "within managed node allocatable",
+			pods:           PodConfig{Enabled: true, PodsPerNode: 200},
+			nodeManagement: NodeManagementConfig{Enabled: true, Pods: 250},
+			wantError:      false,
+		},
+		{
+			name:           "over managed node allocatable",
+			pods:           PodConfig{Enabled: true, PodsPerNode: 200},
+			nodeManagement: NodeManagementConfig{Enabled: true, Pods: 150},
+			wantError:      true,
+			errorString:    "allocatable",
+		},
+		{
+			name:           "over the default allocatable",
+			pods:           PodConfig{Enabled: true, PodsPerNode: 120},
+			nodeManagement: NodeManagementConfig{Enabled: true},
+			wantError:      true,
+			errorString:    "110 allocatable",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := ScaleLoadConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: ScaleLoadConfigSpec{
+					ResourceChurn:  ResourceChurnConfig{Pods: tt.pods},
+					NodeManagement: tt.nodeManagement,
+				},
+			}
+			err := config.validatePodDensity()
+
+			if tt.wantError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+					return
+				}
+				if tt.errorString != "" && !contains(err.Error(), tt.errorString) {
+					t.Errorf("Expected error containing '%s', got '%s'", tt.errorString, err.Error())
+				}
+			} else {
+				if err != nil {
+					t.Errorf("Expected no error but got: %v", err)
+				}
+			}
+		})
+	}
+}
+
+// Helper functions
+func int32Ptr(i int32) *int32 {
+	return &i
+}
+
+func contains(s, substr string) bool {
+	return len(s) >= len(substr) && (s == substr || (len(s) > len(substr) &&
+		(s[:len(substr)] == substr || s[len(s)-len(substr):] == substr ||
+			containsSubstring(s, substr))))
+}
+
+func containsSubstring(s, substr string) bool {
+	for i := 0; i <= len(s)-len(substr); i++ {
+		if s[i:i+len(substr)] == substr {
+			return true
+		}
+	}
+	return false
+}
+
+func TestScaleLoadConfig_ValidateNamespacePrefixUnchanged(t *testing.T) {
+	tests := []struct {
+		name      string
+		oldPrefix string
+		newPrefix string
+		generated int32
+		wantError bool
+	}{
+		{name: "unchanged prefix", oldPrefix: "team-", newPrefix: "team-", generated: 10},
+		{name: "default and empty are the same", oldPrefix: "", newPrefix: "openshift-fake-", generated: 10},
+		{name: "change while no namespaces are reported", oldPrefix: "team-", newPrefix: "other-", generated: 0, wantError: true},
+		{name: "change with generated namespaces", oldPrefix: "team-", newPrefix: "other-", generated: 10, wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oldConfig := ScaleLoadConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec:       ScaleLoadConfigSpec{NamespaceConfig: NamespaceConfig{NamespacePrefix: tt.oldPrefix}},
+				Status:     ScaleLoadConfigStatus{GeneratedNamespaces: tt.generated},
+			}
+			config := ScaleLoadConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec:       ScaleLoadConfigSpec{NamespaceConfig: NamespaceConfig{NamespacePrefix: tt.newPrefix}},
+			}
+
+			_, err := (&ScaleLoadConfigWebhook{}).ValidateUpdate(context.Background(), &oldConfig, &config)
+			if tt.wantError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.wantError && err != nil {
+				t.Errorf("Expected no error but got: %v", err)
+			}
+		})
+	}
+}
+
+func TestScaleLoadConfig_ValidateNamespaceOverlap(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    ScaleLoadConfigSpec
+		other     ScaleLoadConfigSpec
+		wantError bool
+	}{
+		{
+			name:   "disjoint prefixes",
+			config: ScaleLoadConfigSpec{NamespaceConfig: NamespaceConfig{NamespacePrefix: "team-a-"}},
+			other:  ScaleLoadConfigSpec{NamespaceConfig: NamespaceConfig{NamespacePrefix: "team-b-"}},
+		},
+		{
+			name:      "default prefix on both",
+			config:    ScaleLoadConfigSpec{},
+			other:     ScaleLoadConfigSpec{NamespaceConfig: NamespaceConfig{NamespacePrefix: "openshift-fake-"}},
+			wantError: true,
+		},
+		{
+			name:      "one prefix contains the other",
+			config:    ScaleLoadConfigSpec{NamespaceConfig: NamespaceConfig{NamespacePrefix: "team-"}},
+			other:     ScaleLoadConfigSpec{NamespaceConfig: NamespaceConfig{NamespacePrefix: "team-a-"}},
+			wantError: true,
+		},
+		{
+			name: "namespaced with disjoint selectors",
+			config: ScaleLoadConfigSpec{
+				NamespaceConfig: NamespaceConfig{NamespacePrefix: "team-"},
+				Scope:           ScopeConfig{Mode: "Namespaced", NamespaceSelector: map[string]string{"team": "a"}},
+			},
+			other: ScaleLoadConfigSpec{
+				NamespaceConfig: NamespaceConfig{NamespacePrefix: "team-"},
+				Scope:           ScopeConfig{Mode: "Namespaced", NamespaceSelector: map[string]string{"team": "b"}},
+			},
+		},
+		{
+			name: "namespaced with compatible selectors",
+			config: ScaleLoadConfigSpec{
+				NamespaceConfig: NamespaceConfig{NamespacePrefix: "team-"},
+				Scope:           ScopeConfig{Mode: "Namespaced", NamespaceSelector: map[string]string{"team": "a"}},
+			},
+			other: ScaleLoadConfigSpec{
+				NamespaceConfig: NamespaceConfig{NamespacePrefix: "team-"},
+				Scope:           ScopeConfig{Mode: "Namespaced", NamespaceSelector: map[string]string{"env": "perf"}},
+			},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := ScaleLoadConfig{ObjectMeta: metav1.ObjectMeta{Name: "test"}, Spec: tt.config}
+			others := []ScaleLoadConfig{
+				// The config itself is always part of the list on update
+				{ObjectMeta: metav1.ObjectMeta{Name: "test"}, Spec: tt.config},
+				{ObjectMeta: metav1.ObjectMeta{Name: "other"}, Spec: tt.other},
+			}
+
+			err := config.ValidateNamespaceOverlap(others)
+			if tt.wantError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.wantError && err != nil {
+				t.Errorf("Expected no error but got: %v", err)
+			}
+		})
+	}
+}
+
+func TestScaleLoadConfig_ValidateProtectedNamespaces(t *testing.T) {
+	tests := []struct {
+		name      string
+		spec      ScaleLoadConfigSpec
+		wantError bool
+	}{
+		{
+			name: "default prefix",
+			spec: ScaleLoadConfigSpec{},
+		},
+		{
+			name: "prefix under the reserved prefix",
+			spec: ScaleLoadConfigSpec{NamespaceConfig: NamespaceConfig{NamespacePrefix: "openshift-fake-team-a-"}},
+		},
+		{
+			name:      "openshift prefix",
+			spec:      ScaleLoadConfigSpec{NamespaceConfig: NamespaceConfig{NamespacePrefix: "openshift-"}},
+			wantError: true,
+		},
+		{
+			name:      "prefix shorter than a protected prefix",
+			spec:      ScaleLoadConfigSpec{NamespaceConfig: NamespaceConfig{NamespacePrefix: "kube"}},
+			wantError: true,
+		},
+		{
+			name: "excluded namespace outside the prefix",
+			spec: ScaleLoadConfigSpec{
+				NamespaceConfig:    NamespaceConfig{NamespacePrefix: "team-a-"},
+				ExcludedNamespaces: []string{"team-b-*", "shared"},
+			},
+		},
+		{
+			name: "prefix excluded",
+			spec: ScaleLoadConfigSpec{
+				NamespaceConfig:    NamespaceConfig{NamespacePrefix: "team-a-"},
+				ExcludedNamespaces: []string{"team-*"},
+			},
+			wantError: true,
+		},
+		{
+			name:      "wildcard only exclusion",
+			spec:      ScaleLoadConfigSpec{ExcludedNamespaces: []string{"*"}},
+			wantError: true,
+		},
+		{
+			name: "controller leases in kube-system",
+			spec: ScaleLoadConfigSpec{
+				ControllerLeases: ControllerLeasesConfig{Enabled: true, Namespace: "kube-system"},
+			},
+			wantError: true,
+		},
+		{
+			name: "mirror pods in kube-system",
+			spec: ScaleLoadConfigSpec{
+				MirrorPods: MirrorPodsConfig{Enabled: true, Namespace: "kube-system"},
+			},
+			wantError: true,
+		},
+		{
+			name: "system events in kube-system",
+			spec: ScaleLoadConfigSpec{
+				ResourceChurn: ResourceChurnConfig{Events: EventsConfig{
+					SystemEvents: SystemEventsConfig{Enabled: true, Namespace: "kube-system"},
+				}},
+			},
+			wantError: true,
+		},
+		{
+			name: "system events in default",
+			spec: ScaleLoadConfigSpec{
+				ResourceChurn: ResourceChurnConfig{Events: EventsConfig{
+					SystemEvents: SystemEventsConfig{Enabled: true, Namespace: "default"},
+				}},
+			},
+			wantError: false,
+		},
+		{
+			name: "controller leases in an excluded namespace",
+			spec: ScaleLoadConfigSpec{
+				ExcludedNamespaces: []string{"sim-controller-leases"},
+				ControllerLeases:   ControllerLeasesConfig{Enabled: true, Namespace: "sim-controller-leases"},
+			},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := ScaleLoadConfig{Spec: tt.spec}
+			err := config.ValidateProtectedNamespaces()
+			if tt.wantError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.wantError && err != nil {
+				t.Errorf("Expected no error but got: %v", err)
+			}
+		})
+	}
+}
+
+func TestScaleLoadConfig_ValidateNamespaceTargeting(t *testing.T) {
+	tests := []struct {
+		name      string
+		churn     ResourceChurnConfig
+		wantError bool
+	}{
+		{
+			name:      "no targeting",
+			churn:     ResourceChurnConfig{},
+			wantError: false,
+		},
+		{
+			name: "valid index range and selector",
+			churn: ResourceChurnConfig{
+				BuildConfigs: ResourceTypeConfig{NamespaceTargeting: &NamespaceTargeting{
+					IndexRange: &NamespaceIndexRange{From: 0, To: 19},
+					Selector:   &metav1.LabelSelector{MatchLabels: map[string]string{"tenant-class": "ci"}},
+				}},
+			},
+			wantError: false,
+		},
+		{
+			name: "single index range",
+			churn: ResourceChurnConfig{
+				Pods: PodConfig{NamespaceTargeting: &NamespaceTargeting{IndexRange: &NamespaceIndexRange{From: 5, To: 5}}},
+			},
+			wantError: false,
+		},
+		{
+			name: "reversed index range",
+			churn: ResourceChurnConfig{
+				Secrets: ResourceTypeConfig{NamespaceTargeting: &NamespaceTargeting{IndexRange: &NamespaceIndexRange{From: 10, To: 2}}},
+			},
+			wantError: true,
+		},
+		{
+			name: "invalid selector operator",
+			churn: ResourceChurnConfig{
+				AppBundles: AppBundleConfig{NamespaceTargeting: &NamespaceTargeting{Selector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "tenant-class", Operator: "Near"}},
+				}}},
+			},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := ScaleLoadConfig{Spec: ScaleLoadConfigSpec{ResourceChurn: tt.churn}}
+			err := config.validateNamespaceTargeting()
+			if tt.wantError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.wantError && err != nil {
+				t.Errorf("Expected no error but got: %v", err)
+			}
+		})
+	}
+}
+
+func TestScaleLoadConfig_ValidateNamespaceArchetypes(t *testing.T) {
+	int32Ptr := func(v int32) *int32 { return &v }
+	churn := ResourceChurnConfig{
+		BuildConfigs: ResourceTypeConfig{UpdateFrequencyMin: 120, UpdateFrequencyMax: 600},
+		Secrets:      ResourceTypeConfig{UpdateFrequencyMin: 120, UpdateFrequencyMax: 600},
+	}
+
+	tests := []struct {
+		name       string
+		archetypes []NamespaceArchetype
+		wantError  bool
+	}{
+		{
+			name:       "no archetypes",
+			archetypes: nil,
+			wantError:  false,
+		},
+		{
+			name: "valid archetypes",
+			archetypes: []NamespaceArchetype{
+				{Name: "ci", Weight: 1, BuildConfigs: &ArchetypeResourceMix{Count: int32Ptr(10), UpdateFrequencyMin: int32Ptr(30), UpdateFrequencyMax: int32Ptr(120)}},
+				{Name: "microservice", Weight: 3, BuildConfigs: &ArchetypeResourceMix{Count: int32Ptr(0)}},
+			},
+			wantError: false,
+		},
+		{
+			name: "duplicate names",
+			archetypes: []NamespaceArchetype{
+				{Name: "ci", Weight: 1},
+				{Name: "ci", Weight: 2},
+			},
+			wantError: true,
+		},
+		{
+			name: "all weights zero",
+			archetypes: []NamespaceArchetype{
+				{Name: "ci", Weight: 0},
+				{Name: "data", Weight: 0},
+			},
+			wantError: true,
+		},
+		{
+			name: "override above global maximum",
+			archetypes: []NamespaceArchetype{
+				{Name: "data", Weight: 1, Secrets: &ArchetypeResourceMix{UpdateFrequencyMin: int32Ptr(900)}},
+			},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := ScaleLoadConfig{Spec: ScaleLoadConfigSpec{ResourceChurn: churn, NamespaceArchetypes: tt.archetypes}}
+			err := config.validateNamespaceArchetypes()
+			if tt.wantError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.wantError && err != nil {
+				t.Errorf("Expected no error but got: %v", err)
+			}
+		})
+	}
+}
+
+func TestScaleLoadConfig_ValidatePools(t *testing.T) {
+	tests := []struct {
+		name      string
+		mode      string
+		excluded  []string
+		pools     []NamespacePool
+		wantError bool
+	}{
+		{
+			name:      "no pools",
+			pools:     nil,
+			wantError: false,
+		},
+		{
+			name: "valid pools",
+			pools: []NamespacePool{
+				{Name: "hot", Namespaces: 5, ResourceChurn: &ResourceChurnConfig{ConfigMaps: ResourceTypeConfig{Enabled: true, Count: 20}}},
+				{Name: "cold", Namespaces: 200, NamespacePrefix: "openshift-fake-cold-"},
+			},
+			wantError: false,
+		},
+		{
+			name: "duplicate names",
+			pools: []NamespacePool{
+				{Name: "hot", Namespaces: 5},
+				{Name: "hot", Namespaces: 10},
+			},
+			wantError: true,
+		},
+		{
+			name:      "prefix outside the config prefix",
+			pools:     []NamespacePool{{Name: "hot", Namespaces: 5, NamespacePrefix: "load-hot-"}},
+			wantError: true,
+		},
+		{
+			name:      "prefix excluded",
+			excluded:  []string{"openshift-fake-hot*"},
+			pools:     []NamespacePool{{Name: "hot", Namespaces: 5}},
+			wantError: true,
+		},
+		{
+			name: "invalid pool churn",
+			pools: []NamespacePool{
+				{Name: "hot", Namespaces: 5, ResourceChurn: &ResourceChurnConfig{Routes: ResourceTypeConfig{DataProvider: &DataProviderConfig{Name: "custom"}}}},
+			},
+			wantError: true,
+		},
+		{
+			name:      "namespaced scope",
+			mode:      "Namespaced",
+			pools:     []NamespacePool{{Name: "hot", Namespaces: 5}},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := ScaleLoadConfig{Spec: ScaleLoadConfigSpec{Scope: ScopeConfig{Mode: tt.mode}, ExcludedNamespaces: tt.excluded, Pools: tt.pools}}
+			err := config.validatePools()
+			if tt.wantError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.wantError && err != nil {
+				t.Errorf("Expected no error but got: %v", err)
+			}
+		})
+	}
+}
+
+func TestScaleLoadConfig_ValidateNodeSelectors(t *testing.T) {
+	tests := []struct {
+		name         string
+		kwokSelector map[string]string
+		nodeSelector map[string]string
+		pools        []NamespacePool
+		wantError    bool
+	}{
+		{
+			name:      "no node selectors",
+			wantError: false,
+		},
+		{
+			name:         "config and pool selectors",
+			nodeSelector: map[string]string{"node-role.kubernetes.io/worker": ""},
+			pools: []NamespacePool{
+				{Name: "infra", Namespaces: 5, NodeSelector: map[string]string{"node-role.kubernetes.io/infra": "", "pool": "infra"}},
+			},
+			wantError: false,
+		},
+		{
+			name:         "matching the kwok selector",
+			kwokSelector: map[string]string{"type": "kwok", "pool": "load"},
+			nodeSelector: map[string]string{"pool": "load"},
+			wantError:    false,
+		},
+		{
+			name:         "invalid key",
+			nodeSelector: map[string]string{"bad key": "x"},
+			wantError:    true,
+		},
+		{
+			name:      "invalid pool value",
+			pools:     []NamespacePool{{Name: "infra", Namespaces: 5, NodeSelector: map[string]string{"pool": "not valid!"}}},
+			wantError: true,
+		},
+		{
+			name:         "contradicts the default kwok selector",
+			nodeSelector: map[string]string{"type": "infra"},
+			wantError:    true,
+		},
+		{
+			name:         "pool contradicts the kwok selector",
+			kwokSelector: map[string]string{"kwok": "true"},
+			pools:        []NamespacePool{{Name: "infra", Namespaces: 5, NodeSelector: map[string]string{"kwok": "false"}}},
+			wantError:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := ScaleLoadConfig{Spec: ScaleLoadConfigSpec{
+				KwokNodeSelector: tt.kwokSelector,
+				NamespaceConfig:  NamespaceConfig{NodeSelector: tt.nodeSelector},
+				Pools:            tt.pools,
+			}}
+			err := config.validateNodeSelectors()
+			if tt.wantError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.wantError && err != nil {
+				t.Errorf("Expected no error but got: %v", err)
+			}
+		})
+	}
+}
+
+func TestScaleLoadConfig_ValidateSafetyLimits(t *testing.T) {
+	int64Ptr := func(v int64) *int64 { return &v }
+	churn := ResourceChurnConfig{
+		ConfigMaps: ResourceTypeConfig{Enabled: true, Count: 3, NamespaceInterval: 1},
+	}
+
+	tests := []struct {
+		name        string
+		loadProfile LoadProfile
+		scenario    string
+		limits      *SafetyLimits
+		wantRate    int64
+		wantObjects int64
+		wantError   bool
+	}{
+		{
+			name:        "no limits",
+			limits:      nil,
+			wantRate:    0,
+			wantObjects: 0,
+			wantError:   false,
+		},
+		{
+			name:        "within limits",
+			limits:      &SafetyLimits{ExpectedNodes: int32Ptr(100), MaxAPICallsPerMinute: int32Ptr(2000), MaxObjects: int64Ptr(240)},
+			wantRate:    2000,
+			wantObjects: 240,
+			wantError:   false,
+		},
+		{
+			name:        "default per-node rate over limit",
+			limits:      &SafetyLimits{ExpectedNodes: int32Ptr(100), MaxAPICallsPerMinute: int32Ptr(1000)},
+			wantRate:    2000,
+			wantObjects: 240,
+			wantError:   true,
+		},
+		{
+			name:        "static rate ignores node count",
+			loadProfile: LoadProfile{APICallRateStatic: int32Ptr(500)},
+			limits:      &SafetyLimits{ExpectedNodes: int32Ptr(100), MaxAPICallsPerMinute: int32Ptr(1000)},
+			wantRate:    500,
+			wantObjects: 240,
+			wantError:   false,
+		},
+		{
+			name:        "objects over limit",
+			limits:      &SafetyLimits{ExpectedNodes: int32Ptr(100), MaxObjects: int64Ptr(200)},
+			wantRate:    2000,
+			wantObjects: 240,
+			wantError:   true,
+		},
+		{
+			name:        "scenario profile raises rate and density",
+			scenario:    ScenarioUpgradeStorm,
+			limits:      &SafetyLimits{ExpectedNodes: int32Ptr(100), MaxAPICallsPerMinute: int32Ptr(5000)},
+			wantRate:    10000,
+			wantObjects: 400,
+			wantError:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := ScaleLoadConfig{Spec: ScaleLoadConfigSpec{
+				Scenario:      tt.scenario,
+				LoadProfile:   tt.loadProfile,
+				ResourceChurn: churn,
+				SafetyLimits:  tt.limits,
+			}}
+			err := config.ValidateSafetyLimits()
+			if tt.wantError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.wantError && err != nil {
+				t.Errorf("Expected no error but got: %v", err)
+			}
+			estimate := config.EstimateLoad()
+			if estimate.APICallsPerMinute != tt.wantRate || estimate.Objects != tt.wantObjects {
+				t.Errorf("Expected %d calls per minute and %d objects, got %d and %d",
+					tt.wantRate, tt.wantObjects, estimate.APICallsPerMinute, estimate.Objects)
+			}
+		})
+	}
+}
+
+func TestScaleLoadConfigWebhook_RejectsProtectedPrefix(t *testing.T) {
+	config := ScaleLoadConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "test"},
+		Spec:       ScaleLoadConfigSpec{NamespaceConfig: NamespaceConfig{NamespacePrefix: "kube-"}},
+	}
+
+	if _, err := (&ScaleLoadConfigWebhook{}).ValidateCreate(context.Background(), &config); err == nil {
+		t.Error("Expected the webhook to reject a protected namespace prefix")
+	}
+}
+
+func TestIsProtectedNamespace(t *testing.T) {
+	tests := []struct {
+		namespace string
+		excluded  []string
+		want      bool
+	}{
+		{namespace: "openshift-etcd", want: true},
+		{namespace: "kube-system", want: true},
+		{namespace: "openshift-fake-abc123-42", want: false},
+		{namespace: "openshift-fake-abc123-42", excluded: []string{"openshift-fake-abc*"}, want: true},
+		{namespace: "team-a-1", excluded: []string{"team-a-1"}, want: true},
+		{namespace: "team-a-10", excluded: []string{"team-a-1"}, want: false},
+		{namespace: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.namespace, func(t *testing.T) {
+			if got := IsProtectedNamespace(tt.namespace, tt.excluded); got != tt.want {
+				t.Errorf("IsProtectedNamespace(%q, %v) = %v, want %v", tt.namespace, tt.excluded, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScaleLoadConfig_ValidateNotifications(t *testing.T) {
+	tests := []struct {
+		name          string
+		notifications *NotificationsConfig
+		wantError     bool
+	}{
+		{name: "unset", notifications: nil, wantError: false},
+		{name: "https", notifications: &NotificationsConfig{WebhookURL: "https://ci.example.com/hooks/run"}, wantError: false},
+		{name: "http with port", notifications: &NotificationsConfig{WebhookURL: "http://receiver.ci.svc:8080/"}, wantError: false},
+		{name: "relative", notifications: &NotificationsConfig{WebhookURL: "/hooks/run"}, wantError: true},
+		{name: "unsupported scheme", notifications: &NotificationsConfig{WebhookURL: "ftp://ci.example.com/run"}, wantError: true},
+		{name: "empty", notifications: &NotificationsConfig{}, wantError: true},
+		{
+			name: "secret without name",
+			notifications: &NotificationsConfig{
+				WebhookURL:    "https://ci.example.com/hooks/run",
+				AuthSecretRef: &SecretKeyReference{Namespace: "ci"},
+			},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := ScaleLoadConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec:       ScaleLoadConfigSpec{Notifications: tt.notifications},
+			}
+			err := config.validateNotifications()
+
+			if tt.wantError && err == nil {
+				t.Errorf("Expected error but got none")
+			}
+			if !tt.wantError && err != nil {
+				t.Errorf("Expected no error but got: %v", err)
+			}
+		})
+	}
+}
+
+func TestScaleLoadConfig_ValidateGates(t *testing.T) {
+	rule := GateRule{Name: "apiserver-p99", Query: "vector(0.5)", Operator: "<", Threshold: "1"}
+	tests := []struct {
+		name      string
+		gates     *GatesConfig
+		wantError bool
+	}{
+		{name: "unset", gates: nil, wantError: false},
+		{name: "valid", gates: &GatesConfig{PrometheusURL: "https://thanos-querier.openshift-monitoring.svc:9091", Rules: []GateRule{rule}}, wantError: false},
+		{name: "relative URL", gates: &GatesConfig{PrometheusURL: "thanos-querier:9091", Rules: []GateRule{rule}}, wantError: true},
+		{
+			name:      "empty query",
+			gates:     &GatesConfig{PrometheusURL: "http://prometheus:9090", Rules: []GateRule{{Name: "empty", Threshold: "1"}}},
+			wantError: true,
+		},
+		{
+			name:      "invalid threshold",
+			gates:     &GatesConfig{PrometheusURL: "http://prometheus:9090", Rules: []GateRule{{Name: "etcd-fsync", Query: "up", Threshold: "10ms"}}},
+			wantError: true,
+		},
+		{
+			name:      "auth secret without namespace",
+			gates:     &GatesConfig{PrometheusURL: "http://prometheus:9090", AuthSecretRef: &SecretKeyReference{Name: "prometheus-token"}, Rules: []GateRule{rule}},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := ScaleLoadConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec:       ScaleLoadConfigSpec{Gates: tt.gates},
+			}
+			err := config.validateGates()
+
+			if tt.wantError && err == nil {
+				t.Errorf("Expected error but got none")
+			}
+			if !tt.wantError && err != nil {
+				t.Errorf("Expected no error but got: %v", err)
+			}
+		})
+	}
+}
+
+func TestScaleLoadConfig_ValidateImports(t *testing.T) {
+	tests := []struct {
+		name      string
+		imports   []ConfigImport
+		wantError bool
+	}{
+		{name: "unset", imports: nil, wantError: false},
+		{name: "valid", imports: []ConfigImport{{Namespace: "sim-config", Name: "event-catalog"}}, wantError: false},
+		{name: "missing name", imports: []ConfigImport{{Namespace: "sim-config"}}, wantError: true},
+		{
+			name:      "same object twice",
+			imports:   []ConfigImport{{Namespace: "sim-config", Name: "catalog"}, {Kind: "ConfigMap", Namespace: "sim-config", Name: "catalog"}},
+			wantError: true,
+		},
+		{
+			name:      "ConfigMap and Secret with one name",
+			imports:   []ConfigImport{{Namespace: "sim-config", Name: "catalog"}, {Kind: "Secret", Namespace: "sim-config", Name: "catalog"}},
+			wantError: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := ScaleLoadConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec:       ScaleLoadConfigSpec{Imports: tt.imports},
+			}
+			err := config.validateImports()
+
+			if tt.wantError && err == nil {
+				t.Errorf("Expected error but got none")
+			}
+			if !tt.wantError && err != nil {
+				t.Errorf("Expected no error but got: %v", err)
+			}
+		})
+	}
+}
+
+func TestImportedGenerationData_Validate(t *testing.T) {
+	negative := int32(-1)
+	density := "0.5x"
+	tests := []struct {
+		name      string
+		data      ImportedGenerationData
+		wantError bool
+	}{
+		{name: "empty", data: ImportedGenerationData{}, wantError: false},
+		{
+			name: "valid",
+			data: ImportedGenerationData{
+				EventTypes:          []EventTypeConfig{{Type: "Warning", Reason: "FailedMount", Weight: 5}},
+				NamespaceArchetypes: []NamespaceArchetype{{Name: "ci", Weight: 2}},
+				CustomProfiles:      []ProfileDefinition{{Name: "soak"}},
+			},
+			wantError: false,
+		},
+		{name: "event without reason", data: ImportedGenerationData{EventTypes: []EventTypeConfig{{Type: "Normal"}}}, wantError: true},
+		{name: "invalid archetype name", data: ImportedGenerationData{NamespaceArchetypes: []NamespaceArchetype{{Name: "CI_Tenants"}}}, wantError: true},
+		{
+			name:      "negative archetype count",
+			data:      ImportedGenerationData{NamespaceArchetypes: []NamespaceArchetype{{Name: "ci", Pods: &ArchetypeResourceMix{Count: &negative}}}},
+			wantError: true,
+		},
+		{name: "invalid profile density", data: ImportedGenerationData{CustomProfiles: []ProfileDefinition{{Name: "soak", NamespacesPerNode: &density}}}, wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.data.Validate()
+
+			if tt.wantError && err == nil {
+				t.Errorf("Expected error but got none")
+			}
+			if !tt.wantError && err != nil {
+				t.Errorf("Expected no error but got: %v", err)
+			}
+		})
+	}
+}
+
+func TestScaleLoadConfig_ValidateJanitor(t *testing.T) {
+	tests := []struct {
+		name        string
+		janitor     JanitorConfig
+		wantError   bool
+		errorString string
+	}{
+		{name: "disabled", janitor: JanitorConfig{}, wantError: false},
+		{name: "event TTL only", janitor: JanitorConfig{Enabled: true, EventTTLSeconds: 3600}, wantError: false},
+		{
+			name:      "all TTLs",
+			janitor:   JanitorConfig{Enabled: true, EventTTLSeconds: 600, CompletedPodTTLSeconds: 1800, RevisionTTLSeconds: 3600},
+			wantError: false,
+		},
+		{
+			name:        "no TTL set",
+			janitor:     JanitorConfig{Enabled: true, IntervalSeconds: 300},
+			wantError:   true,
+			errorString: "all 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := ScaleLoadConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec:       ScaleLoadConfigSpec{Janitor: tt.janitor},
+			}
+			err := config.validateJanitor()
+
+			if tt.wantError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+					return
+				}
+				if tt.errorString != "" && !contains(err.Error(), tt.errorString) {
+					t.Errorf("Expected error containing '%s', got '%s'", tt.errorString, err.Error())
+				}
+			} else {
+				if err != nil {
+					t.Errorf("Expected no error but got: %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestScaleLoadConfig_ValidateDataProviders(t *testing.T) {
+	tests := []struct {
+		name        string
+		churn       ResourceChurnConfig
+		wantError   bool
+		errorString string
+	}{
+		{name: "no providers", churn: ResourceChurnConfig{}, wantError: false},
+		{
+			name:      "synthetic configmaps",
+			churn:     ResourceChurnConfig{ConfigMaps: ResourceTypeConfig{DataProvider: &DataProviderConfig{Name: DataProviderSynthetic}}},
+			wantError: false,
+		},
+		{
+			name: "sample file secrets",
+			churn: ResourceChurnConfig{Secrets: ResourceTypeConfig{DataProvider: &DataProviderConfig{
+				Name:    DataProviderSampleFile,
+				Options: map[string]string{"path": "/samples/secrets.yaml", "keepSecretValues": "false"},
+			}}},
+			wantError: false,
+		},
+		{
+			name:      "registered provider",
+			churn:     ResourceChurnConfig{ConfigMaps: ResourceTypeConfig{DataProvider: &DataProviderConfig{Name: "faker"}}},
+			wantError: false,
+		},
+		{
+			name:        "sample file without path",
+			churn:       ResourceChurnConfig{ConfigMaps: ResourceTypeConfig{DataProvider: &DataProviderConfig{Name: DataProviderSampleFile}}},
+			wantError:   true,
+			errorString: "requires the path option",
+		},
+		{
+			name: "keepSecretValues not a boolean",
+			churn: ResourceChurnConfig{Secrets: ResourceTypeConfig{DataProvider: &DataProviderConfig{
+				Name:    DataProviderSampleFile,
+				Options: map[string]string{"path": "/samples/secrets.yaml", "keepSecretValues": "sometimes"},
+			}}},
+			wantError:   true,
+			errorString: "not a boolean",
+		},
+		{
+			name:        "provider on routes",
+			churn:       ResourceChurnConfig{Routes: ResourceTypeConfig{DataProvider: &DataProviderConfig{Name: DataProviderSynthetic}}},
+			wantError:   true,
+			errorString: "not supported",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := ScaleLoadConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec:       ScaleLoadConfigSpec{ResourceChurn: tt.churn},
+			}
+			err := config.validateDataProviders()
+
+			if tt.wantError {
+				if err == nil {
+					t.Errorf("Expected error but got none")
+					return
+				}
+				if tt.errorString != "" && !contains(err.Error(), tt.errorString) {
+					t.Errorf("Expected error containing '%s', got '%s'", tt.errorString, err.Error())
+				}
+			} else {
+				if err != nil {
+					t.Errorf("Expected no error but got: %v", err)
+				}
+			}
+		})
 	}
-	return false
 }
@@ -0,0 +1,110 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+//+kubebuilder:webhook:path=/mutate-scale-openshift-io-v1-scaleloadconfig,mutating=true,failurePolicy=fail,sideEffects=None,groups=scale.openshift.io,resources=scaleloadconfigs,verbs=create;update,versions=v1,name=mscaleloadconfig.kb.io,admissionReviewVersions=v1
+//+kubebuilder:webhook:path=/validate-scale-openshift-io-v1-scaleloadconfig,mutating=false,failurePolicy=fail,sideEffects=None,groups=scale.openshift.io,resources=scaleloadconfigs,verbs=create;update,versions=v1,name=vscaleloadconfig.kb.io,admissionReviewVersions=v1
+
+// ScaleLoadConfigWebhook defaults and validates ScaleLoadConfigs at admission
+type ScaleLoadConfigWebhook struct {
+	// Reader lists the existing configs for the namespace overlap check, which is skipped while it is nil
+	Reader client.Reader
+}
+
+var _ admission.CustomDefaulter = &ScaleLoadConfigWebhook{}
+var _ admission.CustomValidator = &ScaleLoadConfigWebhook{}
+
+// SetupWebhookWithManager serves the defaulting and validating webhooks from the manager's webhook server
+func (w *ScaleLoadConfigWebhook) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&ScaleLoadConfig{}).
+		WithDefaulter(w).
+		WithValidator(w).
+		Complete()
+}
+
+// Default records the load estimate of the admitted config
+func (w *ScaleLoadConfigWebhook) Default(_ context.Context, obj runtime.Object) error {
+	config, err := asScaleLoadConfig(obj)
+	if err != nil {
+		return err
+	}
+	config.recordLoadEstimate()
+	return nil
+}
+
+// ValidateCreate rejects configs that fail any spec check or would overlap another config's namespaces
+func (w *ScaleLoadConfigWebhook) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	config, err := asScaleLoadConfig(obj)
+	if err != nil {
+		return nil, err
+	}
+	if err := config.validateSpec(); err != nil {
+		return nil, err
+	}
+	return nil, w.validateNoNamespaceOverlap(ctx, config)
+}
+
+// ValidateUpdate applies the create checks and rejects changes to fields that cannot change in place.
+// Updates that leave the spec alone or hit a deleting config are admitted, so finalizer removal is
+// never blocked by a check the config no longer passes
+func (w *ScaleLoadConfigWebhook) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	config, err := asScaleLoadConfig(newObj)
+	if err != nil {
+		return nil, err
+	}
+	oldConfig, err := asScaleLoadConfig(oldObj)
+	if err != nil {
+		return nil, err
+	}
+	if config.DeletionTimestamp != nil || reflect.DeepEqual(oldConfig.Spec, config.Spec) {
+		return nil, nil
+	}
+	if err := config.validateNamespacePrefixUnchanged(oldConfig); err != nil {
+		return nil, err
+	}
+	if err := config.validateSpec(); err != nil {
+		return nil, err
+	}
+	// Existing configs stay editable; overlap is only rechecked when their namespace selection changes
+	if config.namespaceSelectionUnchanged(oldConfig) {
+		return nil, nil
+	}
+	return nil, w.validateNoNamespaceOverlap(ctx, config)
+}
+
+// ValidateDelete admits every delete
+func (w *ScaleLoadConfigWebhook) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateNoNamespaceOverlap lists the other configs and rejects the config when it could select
+// the same namespaces as one of them
+func (w *ScaleLoadConfigWebhook) validateNoNamespaceOverlap(ctx context.Context, config *ScaleLoadConfig) error {
+	if w.Reader == nil {
+		return nil
+	}
+	configList := &ScaleLoadConfigList{}
+	if err := w.Reader.List(ctx, configList); err != nil {
+		return fmt.Errorf("failed to list ScaleLoadConfigs for namespace overlap check: %w", err)
+	}
+	return config.ValidateNamespaceOverlap(configList.Items)
+}
+
+// asScaleLoadConfig returns the ScaleLoadConfig the webhook was called with
+func asScaleLoadConfig(obj runtime.Object) (*ScaleLoadConfig, error) {
+	config, ok := obj.(*ScaleLoadConfig)
+	if !ok {
+		return nil, fmt.Errorf("expected a ScaleLoadConfig but got %T", obj)
+	}
+	return config, nil
+}
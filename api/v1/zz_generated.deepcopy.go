@@ -26,450 +26,1686 @@ import (
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *AnnotationChurnConfig) DeepCopyInto(out *AnnotationChurnConfig) {
+func (in *ACMSimulationConfig) DeepCopyInto(out *ACMSimulationConfig) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnnotationChurnConfig.
-func (in *AnnotationChurnConfig) DeepCopy() *AnnotationChurnConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ACMSimulationConfig.
+func (in *ACMSimulationConfig) DeepCopy() *ACMSimulationConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(AnnotationChurnConfig)
+	out := new(ACMSimulationConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CleanupConfig) DeepCopyInto(out *CleanupConfig) {
+func (in *AlertSimulationConfig) DeepCopyInto(out *AlertSimulationConfig) {
 	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CleanupConfig.
-func (in *CleanupConfig) DeepCopy() *CleanupConfig {
-	if in == nil {
-		return nil
+	if in.AuthSecretRef != nil {
+		in, out := &in.AuthSecretRef, &out.AuthSecretRef
+		*out = new(SecretKeyReference)
+		**out = **in
 	}
-	out := new(CleanupConfig)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EventTypeConfig) DeepCopyInto(out *EventTypeConfig) {
-	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventTypeConfig.
-func (in *EventTypeConfig) DeepCopy() *EventTypeConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertSimulationConfig.
+func (in *AlertSimulationConfig) DeepCopy() *AlertSimulationConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(EventTypeConfig)
+	out := new(AlertSimulationConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EventsConfig) DeepCopyInto(out *EventsConfig) {
+func (in *AnnotationChurnConfig) DeepCopyInto(out *AnnotationChurnConfig) {
 	*out = *in
-	if in.EventTypes != nil {
-		in, out := &in.EventTypes, &out.EventTypes
-		*out = make([]EventTypeConfig, len(*in))
+	if in.ClusterNetwork != nil {
+		in, out := &in.ClusterNetwork, &out.ClusterNetwork
+		*out = new(ClusterNetworkModel)
+		**out = **in
+	}
+	if in.StableAnnotations != nil {
+		in, out := &in.StableAnnotations, &out.StableAnnotations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ChurningAnnotations != nil {
+		in, out := &in.ChurningAnnotations, &out.ChurningAnnotations
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	out.ServerSideApply = in.ServerSideApply
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventsConfig.
-func (in *EventsConfig) DeepCopy() *EventsConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnnotationChurnConfig.
+func (in *AnnotationChurnConfig) DeepCopy() *AnnotationChurnConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(EventsConfig)
+	out := new(AnnotationChurnConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LoadGenerationMetrics) DeepCopyInto(out *LoadGenerationMetrics) {
+func (in *AppBundleConfig) DeepCopyInto(out *AppBundleConfig) {
 	*out = *in
+	if in.NamespaceTargeting != nil {
+		in, out := &in.NamespaceTargeting, &out.NamespaceTargeting
+		*out = new(NamespaceTargeting)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadGenerationMetrics.
-func (in *LoadGenerationMetrics) DeepCopy() *LoadGenerationMetrics {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppBundleConfig.
+func (in *AppBundleConfig) DeepCopy() *AppBundleConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(LoadGenerationMetrics)
+	out := new(AppBundleConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LoadProfile) DeepCopyInto(out *LoadProfile) {
+func (in *ArchetypeResourceMix) DeepCopyInto(out *ArchetypeResourceMix) {
 	*out = *in
-	if in.NamespacesPerNode != nil {
-		in, out := &in.NamespacesPerNode, &out.NamespacesPerNode
-		*out = new(string)
-		**out = **in
-	}
-	if in.ResourcesPerNamespace != nil {
-		in, out := &in.ResourcesPerNamespace, &out.ResourcesPerNamespace
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
 		*out = new(int32)
 		**out = **in
 	}
-	if in.APICallRateStatic != nil {
-		in, out := &in.APICallRateStatic, &out.APICallRateStatic
+	if in.UpdateFrequencyMin != nil {
+		in, out := &in.UpdateFrequencyMin, &out.UpdateFrequencyMin
 		*out = new(int32)
 		**out = **in
 	}
-	if in.APICallRatePerNode != nil {
-		in, out := &in.APICallRatePerNode, &out.APICallRatePerNode
+	if in.UpdateFrequencyMax != nil {
+		in, out := &in.UpdateFrequencyMax, &out.UpdateFrequencyMax
 		*out = new(int32)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadProfile.
-func (in *LoadProfile) DeepCopy() *LoadProfile {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArchetypeResourceMix.
+func (in *ArchetypeResourceMix) DeepCopy() *ArchetypeResourceMix {
 	if in == nil {
 		return nil
 	}
-	out := new(LoadProfile)
+	out := new(ArchetypeResourceMix)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NamespaceChurnConfig) DeepCopyInto(out *NamespaceChurnConfig) {
+func (in *ArgoCDSimulationConfig) DeepCopyInto(out *ArgoCDSimulationConfig) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceChurnConfig.
-func (in *NamespaceChurnConfig) DeepCopy() *NamespaceChurnConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArgoCDSimulationConfig.
+func (in *ArgoCDSimulationConfig) DeepCopy() *ArgoCDSimulationConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(NamespaceChurnConfig)
+	out := new(ArgoCDSimulationConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NamespaceConfig) DeepCopyInto(out *NamespaceConfig) {
+func (in *BuildPushConfig) DeepCopyInto(out *BuildPushConfig) {
 	*out = *in
-	if in.Labels != nil {
-		in, out := &in.Labels, &out.Labels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.Annotations != nil {
-		in, out := &in.Annotations, &out.Annotations
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.ResourceQuota != nil {
-		in, out := &in.ResourceQuota, &out.ResourceQuota
-		*out = new(NamespaceResourceQuota)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceConfig.
-func (in *NamespaceConfig) DeepCopy() *NamespaceConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BuildPushConfig.
+func (in *BuildPushConfig) DeepCopy() *BuildPushConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(NamespaceConfig)
+	out := new(BuildPushConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NamespaceResourceQuota) DeepCopyInto(out *NamespaceResourceQuota) {
+func (in *ChurnEngineConfig) DeepCopyInto(out *ChurnEngineConfig) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceResourceQuota.
-func (in *NamespaceResourceQuota) DeepCopy() *NamespaceResourceQuota {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChurnEngineConfig.
+func (in *ChurnEngineConfig) DeepCopy() *ChurnEngineConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(NamespaceResourceQuota)
+	out := new(ChurnEngineConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PodConfig) DeepCopyInto(out *PodConfig) {
+func (in *CleanupConfig) DeepCopyInto(out *CleanupConfig) {
 	*out = *in
-	if in.WorkloadTypes != nil {
-		in, out := &in.WorkloadTypes, &out.WorkloadTypes
-		*out = make([]PodWorkloadType, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodConfig.
-func (in *PodConfig) DeepCopy() *PodConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CleanupConfig.
+func (in *CleanupConfig) DeepCopy() *CleanupConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(PodConfig)
+	out := new(CleanupConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PodResourceRequirements) DeepCopyInto(out *PodResourceRequirements) {
+func (in *CleanupProgressStatus) DeepCopyInto(out *CleanupProgressStatus) {
 	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodResourceRequirements.
-func (in *PodResourceRequirements) DeepCopy() *PodResourceRequirements {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CleanupProgressStatus.
+func (in *CleanupProgressStatus) DeepCopy() *CleanupProgressStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PodResourceRequirements)
+	out := new(CleanupProgressStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PodWorkloadType) DeepCopyInto(out *PodWorkloadType) {
+func (in *CleanupVerificationStatus) DeepCopyInto(out *CleanupVerificationStatus) {
 	*out = *in
-	out.Resources = in.Resources
-	if in.Labels != nil {
-		in, out := &in.Labels, &out.Labels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
 	}
-	if in.Annotations != nil {
-		in, out := &in.Annotations, &out.Annotations
-		*out = make(map[string]string, len(*in))
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Leftovers != nil {
+		in, out := &in.Leftovers, &out.Leftovers
+		*out = make(map[string]int32, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val
 		}
 	}
+	if in.Examples != nil {
+		in, out := &in.Examples, &out.Examples
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodWorkloadType.
-func (in *PodWorkloadType) DeepCopy() *PodWorkloadType {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CleanupVerificationStatus.
+func (in *CleanupVerificationStatus) DeepCopy() *CleanupVerificationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PodWorkloadType)
+	out := new(CleanupVerificationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourceChurnConfig) DeepCopyInto(out *ResourceChurnConfig) {
+func (in *ClusterNetworkModel) DeepCopyInto(out *ClusterNetworkModel) {
 	*out = *in
-	out.ConfigMaps = in.ConfigMaps
-	out.Secrets = in.Secrets
-	out.Routes = in.Routes
-	out.ImageStreams = in.ImageStreams
-	out.BuildConfigs = in.BuildConfigs
-	in.Events.DeepCopyInto(&out.Events)
-	in.Pods.DeepCopyInto(&out.Pods)
-	out.Namespaces = in.Namespaces
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceChurnConfig.
-func (in *ResourceChurnConfig) DeepCopy() *ResourceChurnConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterNetworkModel.
+func (in *ClusterNetworkModel) DeepCopy() *ClusterNetworkModel {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceChurnConfig)
+	out := new(ClusterNetworkModel)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourceCounts) DeepCopyInto(out *ResourceCounts) {
+func (in *ClusterStatus) DeepCopyInto(out *ClusterStatus) {
 	*out = *in
+	out.TotalResources = in.TotalResources
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceCounts.
-func (in *ResourceCounts) DeepCopy() *ResourceCounts {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStatus.
+func (in *ClusterStatus) DeepCopy() *ClusterStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceCounts)
+	out := new(ClusterStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourceDeletionStatus) DeepCopyInto(out *ResourceDeletionStatus) {
+func (in *CompletedPodsConfig) DeepCopyInto(out *CompletedPodsConfig) {
 	*out = *in
-	if in.PendingDeletions != nil {
-		in, out := &in.PendingDeletions, &out.PendingDeletions
-		*out = make(map[string]int32, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.LastDeletionBatch != nil {
-		in, out := &in.LastDeletionBatch, &out.LastDeletionBatch
-		*out = make(map[string]*metav1.Time, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val.DeepCopy()
-		}
-	}
-	if in.DeletionErrors != nil {
-		in, out := &in.DeletionErrors, &out.DeletionErrors
-		*out = make(map[string][]string, len(*in))
-		for key, val := range *in {
-			var outVal []string
-			if val == nil {
-				(*out)[key] = nil
-			} else {
-				inVal := (*in)[key]
-				in, out := &inVal, &outVal
-				*out = make([]string, len(*in))
-				copy(*out, *in)
-			}
-			(*out)[key] = outVal
-		}
-	}
-	if in.InFlightDeletions != nil {
-		in, out := &in.InFlightDeletions, &out.InFlightDeletions
-		*out = make(map[string]int32, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.NamespaceTargeting != nil {
+		in, out := &in.NamespaceTargeting, &out.NamespaceTargeting
+		*out = new(NamespaceTargeting)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceDeletionStatus.
-func (in *ResourceDeletionStatus) DeepCopy() *ResourceDeletionStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompletedPodsConfig.
+func (in *CompletedPodsConfig) DeepCopy() *CompletedPodsConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceDeletionStatus)
+	out := new(CompletedPodsConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourceTypeConfig) DeepCopyInto(out *ResourceTypeConfig) {
+func (in *ConfigImport) DeepCopyInto(out *ConfigImport) {
 	*out = *in
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceTypeConfig.
-func (in *ResourceTypeConfig) DeepCopy() *ResourceTypeConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigImport.
+func (in *ConfigImport) DeepCopy() *ConfigImport {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceTypeConfig)
+	out := new(ConfigImport)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ScaleLoadConfig) DeepCopyInto(out *ScaleLoadConfig) {
+func (in *ControllerLeasesConfig) DeepCopyInto(out *ControllerLeasesConfig) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaleLoadConfig.
-func (in *ScaleLoadConfig) DeepCopy() *ScaleLoadConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerLeasesConfig.
+func (in *ControllerLeasesConfig) DeepCopy() *ControllerLeasesConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(ScaleLoadConfig)
+	out := new(ControllerLeasesConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ScaleLoadConfig) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConvergenceStatus) DeepCopyInto(out *ConvergenceStatus) {
+	*out = *in
+	if in.LastYieldTime != nil {
+		in, out := &in.LastYieldTime, &out.LastYieldTime
+		*out = (*in).DeepCopy()
 	}
-	return nil
+	if in.EstimatedCompletionTime != nil {
+		in, out := &in.EstimatedCompletionTime, &out.EstimatedCompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConvergenceStatus.
+func (in *ConvergenceStatus) DeepCopy() *ConvergenceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ConvergenceStatus)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ScaleLoadConfigList) DeepCopyInto(out *ScaleLoadConfigList) {
+func (in *DaemonSetConfig) DeepCopyInto(out *DaemonSetConfig) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]ScaleLoadConfig, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+	if in.NamespaceTargeting != nil {
+		in, out := &in.NamespaceTargeting, &out.NamespaceTargeting
+		*out = new(NamespaceTargeting)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaleLoadConfigList.
-func (in *ScaleLoadConfigList) DeepCopy() *ScaleLoadConfigList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DaemonSetConfig.
+func (in *DaemonSetConfig) DeepCopy() *DaemonSetConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(ScaleLoadConfigList)
+	out := new(DaemonSetConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ScaleLoadConfigList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ScaleLoadConfigSpec) DeepCopyInto(out *ScaleLoadConfigSpec) {
+func (in *DataProviderConfig) DeepCopyInto(out *DataProviderConfig) {
 	*out = *in
-	if in.KwokNodeSelector != nil {
-		in, out := &in.KwokNodeSelector, &out.KwokNodeSelector
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
 		*out = make(map[string]string, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val
 		}
 	}
-	in.LoadProfile.DeepCopyInto(&out.LoadProfile)
-	in.NamespaceConfig.DeepCopyInto(&out.NamespaceConfig)
-	out.AnnotationChurn = in.AnnotationChurn
-	in.ResourceChurn.DeepCopyInto(&out.ResourceChurn)
-	out.CleanupConfig = in.CleanupConfig
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaleLoadConfigSpec.
-func (in *ScaleLoadConfigSpec) DeepCopy() *ScaleLoadConfigSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataProviderConfig.
+func (in *DataProviderConfig) DeepCopy() *DataProviderConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(ScaleLoadConfigSpec)
+	out := new(DataProviderConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ScaleLoadConfigStatus) DeepCopyInto(out *ScaleLoadConfigStatus) {
+func (in *EffectiveProfile) DeepCopyInto(out *EffectiveProfile) {
 	*out = *in
-	out.TotalResources = in.TotalResources
-	if in.LastReconcileTime != nil {
-		in, out := &in.LastReconcileTime, &out.LastReconcileTime
-		*out = (*in).DeepCopy()
-	}
+	if in.APICallRatePerNode != nil {
+		in, out := &in.APICallRatePerNode, &out.APICallRatePerNode
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EffectiveProfile.
+func (in *EffectiveProfile) DeepCopy() *EffectiveProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(EffectiveProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdPressureConfig) DeepCopyInto(out *EtcdPressureConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdPressureConfig.
+func (in *EtcdPressureConfig) DeepCopy() *EtcdPressureConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdPressureConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdPressureStatus) DeepCopyInto(out *EtcdPressureStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdPressureStatus.
+func (in *EtcdPressureStatus) DeepCopy() *EtcdPressureStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdPressureStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventRateStatus) DeepCopyInto(out *EventRateStatus) {
+	*out = *in
+	if in.Dropped != nil {
+		in, out := &in.Dropped, &out.Dropped
+		*out = make(map[string]int64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventRateStatus.
+func (in *EventRateStatus) DeepCopy() *EventRateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EventRateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventTypeConfig) DeepCopyInto(out *EventTypeConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventTypeConfig.
+func (in *EventTypeConfig) DeepCopy() *EventTypeConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EventTypeConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventsConfig) DeepCopyInto(out *EventsConfig) {
+	*out = *in
+	if in.EventTypes != nil {
+		in, out := &in.EventTypes, &out.EventTypes
+		*out = make([]EventTypeConfig, len(*in))
+		copy(*out, *in)
+	}
+	out.SystemEvents = in.SystemEvents
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventsConfig.
+func (in *EventsConfig) DeepCopy() *EventsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EventsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GateRule) DeepCopyInto(out *GateRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GateRule.
+func (in *GateRule) DeepCopy() *GateRule {
+	if in == nil {
+		return nil
+	}
+	out := new(GateRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatesConfig) DeepCopyInto(out *GatesConfig) {
+	*out = *in
+	if in.AuthSecretRef != nil {
+		in, out := &in.AuthSecretRef, &out.AuthSecretRef
+		*out = new(SecretKeyReference)
+		**out = **in
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]GateRule, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatesConfig.
+func (in *GatesConfig) DeepCopy() *GatesConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(GatesConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostedClusterReference) DeepCopyInto(out *HostedClusterReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostedClusterReference.
+func (in *HostedClusterReference) DeepCopy() *HostedClusterReference {
+	if in == nil {
+		return nil
+	}
+	out := new(HostedClusterReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImportedGenerationData) DeepCopyInto(out *ImportedGenerationData) {
+	*out = *in
+	if in.EventTypes != nil {
+		in, out := &in.EventTypes, &out.EventTypes
+		*out = make([]EventTypeConfig, len(*in))
+		copy(*out, *in)
+	}
+	if in.NamespaceAnnotations != nil {
+		in, out := &in.NamespaceAnnotations, &out.NamespaceAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NamespaceAnnotationKeys != nil {
+		in, out := &in.NamespaceAnnotationKeys, &out.NamespaceAnnotationKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NamespaceArchetypes != nil {
+		in, out := &in.NamespaceArchetypes, &out.NamespaceArchetypes
+		*out = make([]NamespaceArchetype, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CustomProfiles != nil {
+		in, out := &in.CustomProfiles, &out.CustomProfiles
+		*out = make([]ProfileDefinition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImportedGenerationData.
+func (in *ImportedGenerationData) DeepCopy() *ImportedGenerationData {
+	if in == nil {
+		return nil
+	}
+	out := new(ImportedGenerationData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JanitorConfig) DeepCopyInto(out *JanitorConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JanitorConfig.
+func (in *JanitorConfig) DeepCopy() *JanitorConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(JanitorConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeconfigSecretReference) DeepCopyInto(out *KubeconfigSecretReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeconfigSecretReference.
+func (in *KubeconfigSecretReference) DeepCopy() *KubeconfigSecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeconfigSecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LabelCardinalityConfig) DeepCopyInto(out *LabelCardinalityConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LabelCardinalityConfig.
+func (in *LabelCardinalityConfig) DeepCopy() *LabelCardinalityConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LabelCardinalityConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadEstimate) DeepCopyInto(out *LoadEstimate) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadEstimate.
+func (in *LoadEstimate) DeepCopy() *LoadEstimate {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadEstimate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadGenerationMetrics) DeepCopyInto(out *LoadGenerationMetrics) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadGenerationMetrics.
+func (in *LoadGenerationMetrics) DeepCopy() *LoadGenerationMetrics {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadGenerationMetrics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadProfile) DeepCopyInto(out *LoadProfile) {
+	*out = *in
+	if in.NamespacesPerNode != nil {
+		in, out := &in.NamespacesPerNode, &out.NamespacesPerNode
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResourcesPerNamespace != nil {
+		in, out := &in.ResourcesPerNamespace, &out.ResourcesPerNamespace
+		*out = new(int32)
+		**out = **in
+	}
+	if in.APICallRateStatic != nil {
+		in, out := &in.APICallRateStatic, &out.APICallRateStatic
+		*out = new(int32)
+		**out = **in
+	}
+	if in.APICallRatePerNode != nil {
+		in, out := &in.APICallRatePerNode, &out.APICallRatePerNode
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadProfile.
+func (in *LoadProfile) DeepCopy() *LoadProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadProfilePreset) DeepCopyInto(out *LoadProfilePreset) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadProfilePreset.
+func (in *LoadProfilePreset) DeepCopy() *LoadProfilePreset {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadProfilePreset)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LoadProfilePreset) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadProfilePresetList) DeepCopyInto(out *LoadProfilePresetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LoadProfilePreset, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadProfilePresetList.
+func (in *LoadProfilePresetList) DeepCopy() *LoadProfilePresetList {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadProfilePresetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LoadProfilePresetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadProfilePresetSpec) DeepCopyInto(out *LoadProfilePresetSpec) {
+	*out = *in
+	if in.LoadProfile != nil {
+		in, out := &in.LoadProfile, &out.LoadProfile
+		*out = new(LoadProfile)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceChurn != nil {
+		in, out := &in.ResourceChurn, &out.ResourceChurn
+		*out = new(ResourceChurnConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AnnotationChurn != nil {
+		in, out := &in.AnnotationChurn, &out.AnnotationChurn
+		*out = new(AnnotationChurnConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReconcileInterval != nil {
+		in, out := &in.ReconcileInterval, &out.ReconcileInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadProfilePresetSpec.
+func (in *LoadProfilePresetSpec) DeepCopy() *LoadProfilePresetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadProfilePresetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadTargets) DeepCopyInto(out *LoadTargets) {
+	*out = *in
+	out.Resources = in.Resources
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadTargets.
+func (in *LoadTargets) DeepCopy() *LoadTargets {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadTargets)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedFieldsBloatConfig) DeepCopyInto(out *ManagedFieldsBloatConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedFieldsBloatConfig.
+func (in *ManagedFieldsBloatConfig) DeepCopy() *ManagedFieldsBloatConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedFieldsBloatConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MirrorPodsConfig) DeepCopyInto(out *MirrorPodsConfig) {
+	*out = *in
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MirrorPodsConfig.
+func (in *MirrorPodsConfig) DeepCopy() *MirrorPodsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MirrorPodsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceAnnotationChurnConfig) DeepCopyInto(out *NamespaceAnnotationChurnConfig) {
+	*out = *in
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceAnnotationChurnConfig.
+func (in *NamespaceAnnotationChurnConfig) DeepCopy() *NamespaceAnnotationChurnConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceAnnotationChurnConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceArchetype) DeepCopyInto(out *NamespaceArchetype) {
+	*out = *in
+	if in.ConfigMaps != nil {
+		in, out := &in.ConfigMaps, &out.ConfigMaps
+		*out = new(ArchetypeResourceMix)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Secrets != nil {
+		in, out := &in.Secrets, &out.Secrets
+		*out = new(ArchetypeResourceMix)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = new(ArchetypeResourceMix)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImageStreams != nil {
+		in, out := &in.ImageStreams, &out.ImageStreams
+		*out = new(ArchetypeResourceMix)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BuildConfigs != nil {
+		in, out := &in.BuildConfigs, &out.BuildConfigs
+		*out = new(ArchetypeResourceMix)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Pods != nil {
+		in, out := &in.Pods, &out.Pods
+		*out = new(ArchetypeResourceMix)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AppBundles != nil {
+		in, out := &in.AppBundles, &out.AppBundles
+		*out = new(ArchetypeResourceMix)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceArchetype.
+func (in *NamespaceArchetype) DeepCopy() *NamespaceArchetype {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceArchetype)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceChurnConfig) DeepCopyInto(out *NamespaceChurnConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceChurnConfig.
+func (in *NamespaceChurnConfig) DeepCopy() *NamespaceChurnConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceChurnConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceConfig) DeepCopyInto(out *NamespaceConfig) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ResourceQuota != nil {
+		in, out := &in.ResourceQuota, &out.ResourceQuota
+		*out = new(NamespaceResourceQuota)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceConfig.
+func (in *NamespaceConfig) DeepCopy() *NamespaceConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceIndexRange) DeepCopyInto(out *NamespaceIndexRange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceIndexRange.
+func (in *NamespaceIndexRange) DeepCopy() *NamespaceIndexRange {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceIndexRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespacePool) DeepCopyInto(out *NamespacePool) {
+	*out = *in
+	if in.ResourceChurn != nil {
+		in, out := &in.ResourceChurn, &out.ResourceChurn
+		*out = new(ResourceChurnConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacePool.
+func (in *NamespacePool) DeepCopy() *NamespacePool {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespacePool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceResourceQuota) DeepCopyInto(out *NamespaceResourceQuota) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceResourceQuota.
+func (in *NamespaceResourceQuota) DeepCopy() *NamespaceResourceQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceResourceQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceTargeting) DeepCopyInto(out *NamespaceTargeting) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IndexRange != nil {
+		in, out := &in.IndexRange, &out.IndexRange
+		*out = new(NamespaceIndexRange)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceTargeting.
+func (in *NamespaceTargeting) DeepCopy() *NamespaceTargeting {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceTargeting)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicyBaselineConfig) DeepCopyInto(out *NetworkPolicyBaselineConfig) {
+	*out = *in
+	if in.NamespaceTargeting != nil {
+		in, out := &in.NamespaceTargeting, &out.NamespaceTargeting
+		*out = new(NamespaceTargeting)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicyBaselineConfig.
+func (in *NetworkPolicyBaselineConfig) DeepCopy() *NetworkPolicyBaselineConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicyBaselineConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeManagementConfig) DeepCopyInto(out *NodeManagementConfig) {
+	*out = *in
+	if in.ScaleSchedule != nil {
+		in, out := &in.ScaleSchedule, &out.ScaleSchedule
+		*out = new(NodeScaleSchedule)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeManagementConfig.
+func (in *NodeManagementConfig) DeepCopy() *NodeManagementConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeManagementConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeScaleSchedule) DeepCopyInto(out *NodeScaleSchedule) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]NodeScaleStep, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeScaleSchedule.
+func (in *NodeScaleSchedule) DeepCopy() *NodeScaleSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeScaleSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeScaleStep) DeepCopyInto(out *NodeScaleStep) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeScaleStep.
+func (in *NodeScaleStep) DeepCopy() *NodeScaleStep {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeScaleStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeServerSideApplyConfig) DeepCopyInto(out *NodeServerSideApplyConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeServerSideApplyConfig.
+func (in *NodeServerSideApplyConfig) DeepCopy() *NodeServerSideApplyConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeServerSideApplyConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeVerificationStatus) DeepCopyInto(out *NodeVerificationStatus) {
+	*out = *in
+	if in.RejectedExamples != nil {
+		in, out := &in.RejectedExamples, &out.RejectedExamples
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeVerificationStatus.
+func (in *NodeVerificationStatus) DeepCopy() *NodeVerificationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeVerificationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationsConfig) DeepCopyInto(out *NotificationsConfig) {
+	*out = *in
+	if in.AuthSecretRef != nil {
+		in, out := &in.AuthSecretRef, &out.AuthSecretRef
+		*out = new(SecretKeyReference)
+		**out = **in
+	}
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]NotificationEvent, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationsConfig.
+func (in *NotificationsConfig) DeepCopy() *NotificationsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacingConfig) DeepCopyInto(out *PacingConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacingConfig.
+func (in *PacingConfig) DeepCopy() *PacingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PacingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodConfig) DeepCopyInto(out *PodConfig) {
+	*out = *in
+	if in.NamespaceTargeting != nil {
+		in, out := &in.NamespaceTargeting, &out.NamespaceTargeting
+		*out = new(NamespaceTargeting)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkloadTypes != nil {
+		in, out := &in.WorkloadTypes, &out.WorkloadTypes
+		*out = make([]PodWorkloadType, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodConfig.
+func (in *PodConfig) DeepCopy() *PodConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PodConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodDensityStatus) DeepCopyInto(out *PodDensityStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodDensityStatus.
+func (in *PodDensityStatus) DeepCopy() *PodDensityStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PodDensityStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodResourceRequirements) DeepCopyInto(out *PodResourceRequirements) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodResourceRequirements.
+func (in *PodResourceRequirements) DeepCopy() *PodResourceRequirements {
+	if in == nil {
+		return nil
+	}
+	out := new(PodResourceRequirements)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodWorkloadType) DeepCopyInto(out *PodWorkloadType) {
+	*out = *in
+	out.Resources = in.Resources
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodWorkloadType.
+func (in *PodWorkloadType) DeepCopy() *PodWorkloadType {
+	if in == nil {
+		return nil
+	}
+	out := new(PodWorkloadType)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProfileDefinition) DeepCopyInto(out *ProfileDefinition) {
+	*out = *in
+	if in.NamespacesPerNode != nil {
+		in, out := &in.NamespacesPerNode, &out.NamespacesPerNode
+		*out = new(string)
+		**out = **in
+	}
+	if in.APICallRatePerNode != nil {
+		in, out := &in.APICallRatePerNode, &out.APICallRatePerNode
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ChurnMultiplier != nil {
+		in, out := &in.ChurnMultiplier, &out.ChurnMultiplier
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReconcileInterval != nil {
+		in, out := &in.ReconcileInterval, &out.ReconcileInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProfileDefinition.
+func (in *ProfileDefinition) DeepCopy() *ProfileDefinition {
+	if in == nil {
+		return nil
+	}
+	out := new(ProfileDefinition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceChurnConfig) DeepCopyInto(out *ResourceChurnConfig) {
+	*out = *in
+	in.ConfigMaps.DeepCopyInto(&out.ConfigMaps)
+	in.Secrets.DeepCopyInto(&out.Secrets)
+	in.Routes.DeepCopyInto(&out.Routes)
+	in.ImageStreams.DeepCopyInto(&out.ImageStreams)
+	in.BuildConfigs.DeepCopyInto(&out.BuildConfigs)
+	out.BuildPushes = in.BuildPushes
+	in.Events.DeepCopyInto(&out.Events)
+	in.Pods.DeepCopyInto(&out.Pods)
+	in.AppBundles.DeepCopyInto(&out.AppBundles)
+	in.DaemonSets.DeepCopyInto(&out.DaemonSets)
+	in.CompletedPods.DeepCopyInto(&out.CompletedPods)
+	in.NetworkPolicies.DeepCopyInto(&out.NetworkPolicies)
+	out.Namespaces = in.Namespaces
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceChurnConfig.
+func (in *ResourceChurnConfig) DeepCopy() *ResourceChurnConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceChurnConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceCounts) DeepCopyInto(out *ResourceCounts) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceCounts.
+func (in *ResourceCounts) DeepCopy() *ResourceCounts {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceCounts)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceDeletionStatus) DeepCopyInto(out *ResourceDeletionStatus) {
+	*out = *in
+	if in.PendingDeletions != nil {
+		in, out := &in.PendingDeletions, &out.PendingDeletions
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.LastDeletionBatch != nil {
+		in, out := &in.LastDeletionBatch, &out.LastDeletionBatch
+		*out = make(map[string]*metav1.Time, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.DeletionErrors != nil {
+		in, out := &in.DeletionErrors, &out.DeletionErrors
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.InFlightDeletions != nil {
+		in, out := &in.InFlightDeletions, &out.InFlightDeletions
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceDeletionStatus.
+func (in *ResourceDeletionStatus) DeepCopy() *ResourceDeletionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceDeletionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceTypeConfig) DeepCopyInto(out *ResourceTypeConfig) {
+	*out = *in
+	if in.NamespaceTargeting != nil {
+		in, out := &in.NamespaceTargeting, &out.NamespaceTargeting
+		*out = new(NamespaceTargeting)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DataProvider != nil {
+		in, out := &in.DataProvider, &out.DataProvider
+		*out = new(DataProviderConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceTypeConfig.
+func (in *ResourceTypeConfig) DeepCopy() *ResourceTypeConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceTypeConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SafetyLimits) DeepCopyInto(out *SafetyLimits) {
+	*out = *in
+	if in.ExpectedNodes != nil {
+		in, out := &in.ExpectedNodes, &out.ExpectedNodes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxAPICallsPerMinute != nil {
+		in, out := &in.MaxAPICallsPerMinute, &out.MaxAPICallsPerMinute
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxObjects != nil {
+		in, out := &in.MaxObjects, &out.MaxObjects
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SafetyLimits.
+func (in *SafetyLimits) DeepCopy() *SafetyLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(SafetyLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScaleLoadConfig) DeepCopyInto(out *ScaleLoadConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaleLoadConfig.
+func (in *ScaleLoadConfig) DeepCopy() *ScaleLoadConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ScaleLoadConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScaleLoadConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScaleLoadConfigList) DeepCopyInto(out *ScaleLoadConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ScaleLoadConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaleLoadConfigList.
+func (in *ScaleLoadConfigList) DeepCopy() *ScaleLoadConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(ScaleLoadConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScaleLoadConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScaleLoadConfigSpec) DeepCopyInto(out *ScaleLoadConfigSpec) {
+	*out = *in
+	if in.KwokNodeSelector != nil {
+		in, out := &in.KwokNodeSelector, &out.KwokNodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Imports != nil {
+		in, out := &in.Imports, &out.Imports
+		*out = make([]ConfigImport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.LoadProfile.DeepCopyInto(&out.LoadProfile)
+	if in.ReconcileInterval != nil {
+		in, out := &in.ReconcileInterval, &out.ReconcileInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ScenarioReconcileIntervals != nil {
+		in, out := &in.ScenarioReconcileIntervals, &out.ScenarioReconcileIntervals
+		*out = make(map[string]metav1.Duration, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	out.Pacing = in.Pacing
+	out.ChurnEngine = in.ChurnEngine
+	out.StatusUpdates = in.StatusUpdates
+	if in.ConvergenceTolerancePercent != nil {
+		in, out := &in.ConvergenceTolerancePercent, &out.ConvergenceTolerancePercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CustomProfiles != nil {
+		in, out := &in.CustomProfiles, &out.CustomProfiles
+		*out = make([]ProfileDefinition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.NamespaceConfig.DeepCopyInto(&out.NamespaceConfig)
+	if in.ExcludedNamespaces != nil {
+		in, out := &in.ExcludedNamespaces, &out.ExcludedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.AnnotationChurn.DeepCopyInto(&out.AnnotationChurn)
+	in.NamespaceAnnotationChurn.DeepCopyInto(&out.NamespaceAnnotationChurn)
+	in.ResourceChurn.DeepCopyInto(&out.ResourceChurn)
+	if in.NamespaceArchetypes != nil {
+		in, out := &in.NamespaceArchetypes, &out.NamespaceArchetypes
+		*out = make([]NamespaceArchetype, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Pools != nil {
+		in, out := &in.Pools, &out.Pools
+		*out = make([]NamespacePool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.CleanupConfig = in.CleanupConfig
+	in.NodeManagement.DeepCopyInto(&out.NodeManagement)
+	in.Topology.DeepCopyInto(&out.Topology)
+	in.SchedulingLabelChurn.DeepCopyInto(&out.SchedulingLabelChurn)
+	in.MirrorPods.DeepCopyInto(&out.MirrorPods)
+	in.AlertSimulation.DeepCopyInto(&out.AlertSimulation)
+	out.ACMSimulation = in.ACMSimulation
+	out.ArgoCDSimulation = in.ArgoCDSimulation
+	out.EtcdPressure = in.EtcdPressure
+	out.LabelCardinality = in.LabelCardinality
+	out.ManagedFieldsBloat = in.ManagedFieldsBloat
+	out.ControllerLeases = in.ControllerLeases
+	out.Janitor = in.Janitor
+	in.Scope.DeepCopyInto(&out.Scope)
+	if in.SafetyLimits != nil {
+		in, out := &in.SafetyLimits, &out.SafetyLimits
+		*out = new(SafetyLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = new(NotificationsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	out.Summary = in.Summary
+	out.WarmUp = in.WarmUp
+	if in.Gates != nil {
+		in, out := &in.Gates, &out.Gates
+		*out = new(GatesConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TargetClusters != nil {
+		in, out := &in.TargetClusters, &out.TargetClusters
+		*out = make([]TargetCluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaleLoadConfigSpec.
+func (in *ScaleLoadConfigSpec) DeepCopy() *ScaleLoadConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ScaleLoadConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScaleLoadConfigStatus) DeepCopyInto(out *ScaleLoadConfigStatus) {
+	*out = *in
+	if in.RunStartTime != nil {
+		in, out := &in.RunStartTime, &out.RunStartTime
+		*out = (*in).DeepCopy()
+	}
+	in.NodeVerification.DeepCopyInto(&out.NodeVerification)
+	out.TotalResources = in.TotalResources
+	in.EffectiveProfile.DeepCopyInto(&out.EffectiveProfile)
+	out.Targets = in.Targets
+	if in.LastReconcileTime != nil {
+		in, out := &in.LastReconcileTime, &out.LastReconcileTime
+		*out = (*in).DeepCopy()
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]metav1.Condition, len(*in))
@@ -478,6 +1714,49 @@ func (in *ScaleLoadConfigStatus) DeepCopyInto(out *ScaleLoadConfigStatus) {
 		}
 	}
 	out.Metrics = in.Metrics
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]ClusterStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EtcdPressure != nil {
+		in, out := &in.EtcdPressure, &out.EtcdPressure
+		*out = new(EtcdPressureStatus)
+		**out = **in
+	}
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = new(EventRateStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodDensity != nil {
+		in, out := &in.PodDensity, &out.PodDensity
+		*out = new(PodDensityStatus)
+		**out = **in
+	}
+	in.WriteVolume.DeepCopyInto(&out.WriteVolume)
+	if in.WarmUp != nil {
+		in, out := &in.WarmUp, &out.WarmUp
+		*out = new(WarmUpStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Convergence != nil {
+		in, out := &in.Convergence, &out.Convergence
+		*out = new(ConvergenceStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Cleanup != nil {
+		in, out := &in.Cleanup, &out.Cleanup
+		*out = new(CleanupProgressStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CleanupVerification != nil {
+		in, out := &in.CleanupVerification, &out.CleanupVerification
+		*out = new(CleanupVerificationStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	in.DeletionStatus.DeepCopyInto(&out.DeletionStatus)
 }
 
@@ -490,3 +1769,266 @@ func (in *ScaleLoadConfigStatus) DeepCopy() *ScaleLoadConfigStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingLabel) DeepCopyInto(out *SchedulingLabel) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingLabel.
+func (in *SchedulingLabel) DeepCopy() *SchedulingLabel {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingLabel)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingLabelChurnConfig) DeepCopyInto(out *SchedulingLabelChurnConfig) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]SchedulingLabel, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingLabelChurnConfig.
+func (in *SchedulingLabelChurnConfig) DeepCopy() *SchedulingLabelChurnConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingLabelChurnConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScopeConfig) DeepCopyInto(out *ScopeConfig) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScopeConfig.
+func (in *ScopeConfig) DeepCopy() *ScopeConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ScopeConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretKeyReference) DeepCopyInto(out *SecretKeyReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretKeyReference.
+func (in *SecretKeyReference) DeepCopy() *SecretKeyReference {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretKeyReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StatusUpdateConfig) DeepCopyInto(out *StatusUpdateConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StatusUpdateConfig.
+func (in *StatusUpdateConfig) DeepCopy() *StatusUpdateConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(StatusUpdateConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SummaryConfig) DeepCopyInto(out *SummaryConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SummaryConfig.
+func (in *SummaryConfig) DeepCopy() *SummaryConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SummaryConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemEventsConfig) DeepCopyInto(out *SystemEventsConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemEventsConfig.
+func (in *SystemEventsConfig) DeepCopy() *SystemEventsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemEventsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetCluster) DeepCopyInto(out *TargetCluster) {
+	*out = *in
+	if in.KubeconfigSecretRef != nil {
+		in, out := &in.KubeconfigSecretRef, &out.KubeconfigSecretRef
+		*out = new(KubeconfigSecretReference)
+		**out = **in
+	}
+	if in.HostedClusterRef != nil {
+		in, out := &in.HostedClusterRef, &out.HostedClusterRef
+		*out = new(HostedClusterReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetCluster.
+func (in *TargetCluster) DeepCopy() *TargetCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetCluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopologyConfig) DeepCopyInto(out *TopologyConfig) {
+	*out = *in
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]ZoneConfig, len(*in))
+		copy(*out, *in)
+	}
+	out.ZoneOutage = in.ZoneOutage
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopologyConfig.
+func (in *TopologyConfig) DeepCopy() *TopologyConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TopologyConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WarmUpConfig) DeepCopyInto(out *WarmUpConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarmUpConfig.
+func (in *WarmUpConfig) DeepCopy() *WarmUpConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(WarmUpConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WarmUpStatus) DeepCopyInto(out *WarmUpStatus) {
+	*out = *in
+	if in.CompletedTime != nil {
+		in, out := &in.CompletedTime, &out.CompletedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.BaselineListLatencyMs != nil {
+		in, out := &in.BaselineListLatencyMs, &out.BaselineListLatencyMs
+		*out = make(map[string]int64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarmUpStatus.
+func (in *WarmUpStatus) DeepCopy() *WarmUpStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WarmUpStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WriteVolume) DeepCopyInto(out *WriteVolume) {
+	*out = *in
+	if in.ByResourceType != nil {
+		in, out := &in.ByResourceType, &out.ByResourceType
+		*out = make(map[string]int64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WriteVolume.
+func (in *WriteVolume) DeepCopy() *WriteVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(WriteVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneConfig) DeepCopyInto(out *ZoneConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneConfig.
+func (in *ZoneConfig) DeepCopy() *ZoneConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneOutageConfig) DeepCopyInto(out *ZoneOutageConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneOutageConfig.
+func (in *ZoneOutageConfig) DeepCopy() *ZoneOutageConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneOutageConfig)
+	in.DeepCopyInto(out)
+	return out
+}
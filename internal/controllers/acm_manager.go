@@ -0,0 +1,327 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+	"github.com/jtaleric/sim-operator/pkg/generator"
+)
+
+// acmNamespaceLabel marks managed cluster namespaces created for ACM simulation. These are kept
+// apart from the managed-by label so they are not counted as generated load namespaces.
+const acmNamespaceLabel = "scale.openshift.io/acm-managed-by"
+
+var (
+	managedClusterGVK = schema.GroupVersionKind{Group: "cluster.open-cluster-management.io", Version: "v1", Kind: "ManagedCluster"}
+	manifestWorkGVK   = schema.GroupVersionKind{Group: "work.open-cluster-management.io", Version: "v1", Kind: "ManifestWork"}
+	placementGVK      = schema.GroupVersionKind{Group: "cluster.open-cluster-management.io", Version: "v1beta1", Kind: "Placement"}
+)
+
+// manageACMSimulation creates, updates and removes simulated ManagedClusters with their
+// ManifestWorks and Placements, returning counts per kind
+func (r *ScaleLoadConfigReconciler) manageACMSimulation(ctx context.Context, config *scalev1.ScaleLoadConfig) (map[string]int, error) {
+	log := r.Log.WithName("acm-manager")
+	acmConfig := config.Spec.ACMSimulation
+	counts := make(map[string]int)
+
+	existing, err := r.listACMObjects(ctx, managedClusterGVK, "", config.Name)
+	if meta.IsNoMatchError(err) {
+		log.V(1).Info("ManagedCluster API not available, skipping ACM simulation")
+		r.setAPIAvailable(config.Name, "ManagedCluster", false)
+		return counts, nil
+	}
+	if err != nil {
+		return counts, fmt.Errorf("failed to list ManagedClusters: %w", err)
+	}
+	r.setAPIAvailable(config.Name, "ManagedCluster", true)
+	r.recordAPICall(config, 1)
+
+	existingByName := make(map[string]*unstructured.Unstructured, len(existing))
+	for i := range existing {
+		existingByName[existing[i].GetName()] = &existing[i]
+	}
+
+	desired := make(map[string]bool, acmConfig.ManagedClusters)
+	for i := int32(0); i < acmConfig.ManagedClusters; i++ {
+		name := fmt.Sprintf("sim-%s-%d", config.Name, i)
+		desired[name] = true
+
+		if cluster, ok := existingByName[name]; ok {
+			if err := r.churnManagedCluster(ctx, config, cluster); err != nil {
+				log.Error(err, "Failed to update ManagedCluster", "name", name)
+			}
+		} else if err := r.createManagedCluster(ctx, config, name); err != nil {
+			return counts, err
+		}
+		counts["managedClusters"]++
+
+		workCount, err := r.manageACMNamespacedObjects(ctx, config, name, manifestWorkGVK, acmConfig.ManifestWorksPerCluster)
+		if err != nil {
+			log.Error(err, "Failed to manage ManifestWorks", "cluster", name)
+		}
+		counts["manifestWorks"] += workCount
+
+		placementCount, err := r.manageACMNamespacedObjects(ctx, config, name, placementGVK, acmConfig.PlacementsPerCluster)
+		if err != nil {
+			log.Error(err, "Failed to manage Placements", "cluster", name)
+		}
+		counts["placements"] += placementCount
+	}
+
+	// Scale down clusters beyond the configured count
+	for name, cluster := range existingByName {
+		if desired[name] {
+			continue
+		}
+		if err := r.deleteManagedCluster(ctx, config, cluster); err != nil {
+			log.Error(err, "Failed to delete excess ManagedCluster", "name", name)
+		}
+	}
+
+	return counts, nil
+}
+
+// createManagedCluster creates a ManagedCluster the hub will not accept, plus its namespace
+func (r *ScaleLoadConfigReconciler) createManagedCluster(ctx context.Context, config *scalev1.ScaleLoadConfig, name string) error {
+	cluster := &unstructured.Unstructured{}
+	cluster.SetGroupVersionKind(managedClusterGVK)
+	cluster.SetName(name)
+	cluster.SetLabels(map[string]string{
+		"scale.openshift.io/managed-by":    config.Name,
+		"scale.openshift.io/resource-type": "managedcluster",
+		"scale.openshift.io/created-by":    "sim-operator",
+		"cloud":                            "Simulated",
+		"vendor":                           "OpenShift",
+	})
+	// No registration agent exists for these clusters, so keep the hub from trying to accept them
+	cluster.Object["spec"] = map[string]interface{}{
+		"hubAcceptsClient":     false,
+		"leaseDurationSeconds": int64(60),
+	}
+	if err := r.createOrAdopt(ctx, config, cluster); err != nil {
+		return fmt.Errorf("failed to create ManagedCluster %s: %w", name, err)
+	}
+	r.recordAPICall(config, 1)
+
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				acmNamespaceLabel:               config.Name,
+				"scale.openshift.io/created-by": "sim-operator",
+			},
+		},
+	}
+	if err := r.createOrAdopt(ctx, config, namespace); err != nil {
+		return fmt.Errorf("failed to create namespace for ManagedCluster %s: %w", name, err)
+	}
+	r.recordAPICall(config, 1)
+	return nil
+}
+
+// churnManagedCluster periodically rewrites a label on the ManagedCluster
+func (r *ScaleLoadConfigReconciler) churnManagedCluster(ctx context.Context, config *scalev1.ScaleLoadConfig, cluster *unstructured.Unstructured) error {
+	acmConfig := config.Spec.ACMSimulation
+	if !r.shouldPerformResourceOperation(cluster.GetName(), "managedClusters", acmConfig.UpdateFrequencyMin, acmConfig.UpdateFrequencyMax) {
+		return nil
+	}
+
+	labels := cluster.GetLabels()
+	labels["scale.openshift.io/churn-generation"] = strconv.FormatInt(time.Now().Unix(), 10)
+	cluster.SetLabels(labels)
+	if err := r.Update(ctx, cluster); err != nil {
+		return err
+	}
+	r.recordAPICall(config, 1)
+	r.updateLastResourceOperation(cluster.GetName(), "managedClusters")
+	return nil
+}
+
+// deleteManagedCluster removes a ManagedCluster and its namespace
+func (r *ScaleLoadConfigReconciler) deleteManagedCluster(ctx context.Context, config *scalev1.ScaleLoadConfig, cluster *unstructured.Unstructured) error {
+	if err := r.Delete(ctx, cluster); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	r.recordAPICall(config, 1)
+
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: cluster.GetName()}}
+	if err := r.Delete(ctx, namespace); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	r.recordAPICall(config, 1)
+	return nil
+}
+
+// manageACMNamespacedObjects keeps count ManifestWorks or Placements in a managed cluster namespace
+func (r *ScaleLoadConfigReconciler) manageACMNamespacedObjects(ctx context.Context, config *scalev1.ScaleLoadConfig,
+	namespace string, gvk schema.GroupVersionKind, count int32) (int, error) {
+
+	acmConfig := config.Spec.ACMSimulation
+	resourceType := gvk.Kind
+
+	existing, err := r.listACMObjects(ctx, gvk, namespace, config.Name)
+	if meta.IsNoMatchError(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	r.recordAPICall(config, 1)
+
+	existingByName := make(map[string]*unstructured.Unstructured, len(existing))
+	for i := range existing {
+		existingByName[existing[i].GetName()] = &existing[i]
+	}
+
+	churnDue := r.shouldPerformResourceOperation(namespace, resourceType, acmConfig.UpdateFrequencyMin, acmConfig.UpdateFrequencyMax)
+	managed := 0
+	desired := make(map[string]bool, count)
+	for i := int32(0); i < count; i++ {
+		obj := r.generateACMObject(config, gvk, namespace, i)
+		desired[obj.GetName()] = true
+
+		if current, ok := existingByName[obj.GetName()]; ok {
+			if churnDue {
+				current.Object["spec"] = obj.Object["spec"]
+				if err := r.Update(ctx, current); err != nil {
+					return managed, fmt.Errorf("failed to update %s %s/%s: %w", gvk.Kind, namespace, obj.GetName(), err)
+				}
+				r.recordAPICall(config, 1)
+			}
+		} else {
+			if err := r.createOrAdopt(ctx, config, obj); err != nil {
+				return managed, fmt.Errorf("failed to create %s %s/%s: %w", gvk.Kind, namespace, obj.GetName(), err)
+			}
+			r.recordAPICall(config, 1)
+		}
+		managed++
+	}
+	if churnDue {
+		r.updateLastResourceOperation(namespace, resourceType)
+	}
+
+	for name, obj := range existingByName {
+		if desired[name] {
+			continue
+		}
+		if err := r.Delete(ctx, obj); err != nil && !errors.IsNotFound(err) {
+			return managed, fmt.Errorf("failed to delete %s %s/%s: %w", gvk.Kind, namespace, name, err)
+		}
+		r.recordAPICall(config, 1)
+	}
+
+	return managed, nil
+}
+
+// generateACMObject builds a ManifestWork carrying a ConfigMap payload or a Placement selecting the simulated clusters
+func (r *ScaleLoadConfigReconciler) generateACMObject(config *scalev1.ScaleLoadConfig, gvk schema.GroupVersionKind,
+	namespace string, index int32) *unstructured.Unstructured {
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	obj.SetNamespace(namespace)
+	obj.SetLabels(map[string]string{
+		"scale.openshift.io/managed-by":    config.Name,
+		"scale.openshift.io/resource-type": gvkResourceType(gvk),
+		"scale.openshift.io/created-by":    "sim-operator",
+	})
+
+	switch gvk.Kind {
+	case manifestWorkGVK.Kind:
+		obj.SetName(fmt.Sprintf("sim-work-%d", index))
+		obj.Object["spec"] = map[string]interface{}{
+			"workload": map[string]interface{}{
+				"manifests": []interface{}{
+					map[string]interface{}{
+						"apiVersion": "v1",
+						"kind":       "ConfigMap",
+						"metadata": map[string]interface{}{
+							"name":      fmt.Sprintf("sim-work-payload-%d", index),
+							"namespace": "default",
+						},
+						"data": map[string]interface{}{
+							"config.yaml": generator.ConfigYAML(),
+							"revision":    strconv.FormatInt(time.Now().Unix(), 10),
+						},
+					},
+				},
+			},
+		}
+	default:
+		obj.SetName(fmt.Sprintf("sim-placement-%d", index))
+		obj.Object["spec"] = map[string]interface{}{
+			"numberOfClusters": int64(1 + index%3),
+			"predicates": []interface{}{
+				map[string]interface{}{
+					"requiredClusterSelector": map[string]interface{}{
+						"labelSelector": map[string]interface{}{
+							"matchLabels": map[string]interface{}{
+								"scale.openshift.io/managed-by": config.Name,
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	return obj
+}
+
+// listACMObjects lists ACM objects of one kind owned by a config
+func (r *ScaleLoadConfigReconciler) listACMObjects(ctx context.Context, gvk schema.GroupVersionKind,
+	namespace, configName string) ([]unstructured.Unstructured, error) {
+
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+
+	opts := []client.ListOption{client.MatchingLabels{"scale.openshift.io/managed-by": configName}}
+	if namespace != "" {
+		opts = append(opts, client.InNamespace(namespace))
+	}
+	if err := r.List(ctx, list, opts...); err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// cleanupACMObjects removes every simulated ManagedCluster and its namespace for a config
+func (r *ScaleLoadConfigReconciler) cleanupACMObjects(ctx context.Context, config *scalev1.ScaleLoadConfig) error {
+	clusters, err := r.listACMObjects(ctx, managedClusterGVK, "", config.Name)
+	if meta.IsNoMatchError(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to list ManagedClusters: %w", err)
+	}
+
+	// ManifestWorks and Placements are removed along with the namespaces
+	for i := range clusters {
+		if err := r.deleteManagedCluster(ctx, config, &clusters[i]); err != nil {
+			return fmt.Errorf("failed to delete ManagedCluster %s: %w", clusters[i].GetName(), err)
+		}
+	}
+	return nil
+}
+
+// gvkResourceType returns the resource-type label value used for an ACM kind
+func gvkResourceType(gvk schema.GroupVersionKind) string {
+	switch gvk.Kind {
+	case manifestWorkGVK.Kind:
+		return "manifestwork"
+	case placementGVK.Kind:
+		return "placement"
+	default:
+		return "managedcluster"
+	}
+}
@@ -0,0 +1,47 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+// recheckAdmission repeats the webhook checks that protect the cluster: protected namespaces, safety
+// limits and namespace overlap. Configs admitted while the webhook was not deployed or unavailable
+// are held back by it, as are configs whose load estimate grew with the node count
+func (r *ScaleLoadConfigReconciler) recheckAdmission(ctx context.Context, config *scalev1.ScaleLoadConfig) error {
+	if err := config.ValidateProtectedNamespaces(); err != nil {
+		return err
+	}
+	if err := config.ValidateSafetyLimits(); err != nil {
+		return err
+	}
+	return r.checkNamespaceOverlap(ctx, config)
+}
+
+// checkNamespaceOverlap returns an error when the config could select namespaces of an older config.
+// The webhook rejects such configs at admission; this catches the ones admitted without it, holding
+// back only the newer of two overlapping configs so the older one keeps its namespaces
+func (r *ScaleLoadConfigReconciler) checkNamespaceOverlap(ctx context.Context, config *scalev1.ScaleLoadConfig) error {
+	configList := &scalev1.ScaleLoadConfigList{}
+	if err := r.List(ctx, configList); err != nil {
+		return fmt.Errorf("failed to list ScaleLoadConfigs for namespace overlap check: %w", err)
+	}
+
+	var older []scalev1.ScaleLoadConfig
+	for i := range configList.Items {
+		if createdBefore(&configList.Items[i], config) {
+			older = append(older, configList.Items[i])
+		}
+	}
+	return config.ValidateNamespaceOverlap(older)
+}
+
+// createdBefore orders configs by creation time, and by name when created in the same second
+func createdBefore(a, b *scalev1.ScaleLoadConfig) bool {
+	if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.CreationTimestamp.Before(&b.CreationTimestamp)
+	}
+	return a.Name < b.Name
+}
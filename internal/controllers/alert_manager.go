@@ -0,0 +1,291 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+const (
+	alertModePrometheusRule = "prometheusRule"
+	alertModeAlertmanager   = "alertmanager"
+
+	// simulatedAlertRuleName is the fixed name of the PrometheusRule created in each selected namespace
+	simulatedAlertRuleName = "sim-operator-alerts"
+)
+
+var prometheusRuleGVK = schema.GroupVersionKind{
+	Group:   "monitoring.coreos.com",
+	Version: "v1",
+	Kind:    "PrometheusRule",
+}
+
+// alertmanagerAlert mirrors the postableAlert schema of the Alertmanager v2 API
+type alertmanagerAlert struct {
+	Labels      map[string]string `json:"labels"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	StartsAt    time.Time         `json:"startsAt"`
+	EndsAt      time.Time         `json:"endsAt"`
+}
+
+// manageAlertSimulation produces firing alerts proportional to the managed namespaces
+// and returns the number of alerts currently being simulated
+func (r *ScaleLoadConfigReconciler) manageAlertSimulation(ctx context.Context, config *scalev1.ScaleLoadConfig) (int, error) {
+	alertConfig := config.Spec.AlertSimulation
+
+	namespaces, _, err := r.getManagedNamespacesWithStatus(ctx, config)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list managed namespaces: %w", err)
+	}
+	r.recordAPICall(config, 1)
+
+	var selected []corev1.Namespace
+	for _, ns := range namespaces {
+		if r.shouldCreateResourceForNamespace(ns, alertConfig.NamespaceInterval) {
+			selected = append(selected, ns)
+		}
+	}
+
+	switch alertConfig.Mode {
+	case alertModeAlertmanager:
+		return r.postAlertmanagerAlerts(ctx, config, selected)
+	case alertModePrometheusRule, "":
+		return r.managePrometheusRules(ctx, config, selected)
+	default:
+		return 0, fmt.Errorf("unknown alert simulation mode %q", alertConfig.Mode)
+	}
+}
+
+// managePrometheusRules ensures each selected namespace has a PrometheusRule with always-firing rules
+func (r *ScaleLoadConfigReconciler) managePrometheusRules(ctx context.Context, config *scalev1.ScaleLoadConfig,
+	namespaces []corev1.Namespace) (int, error) {
+
+	log := r.Log.WithName("alert-manager")
+	alertsPerNamespace := int(getAlertsPerNamespace(config))
+	alertCount := 0
+
+	for _, ns := range namespaces {
+		existing := &unstructured.Unstructured{}
+		existing.SetGroupVersionKind(prometheusRuleGVK)
+		err := r.Get(ctx, types.NamespacedName{Namespace: ns.Name, Name: simulatedAlertRuleName}, existing)
+		r.recordAPICall(config, 1)
+
+		if meta.IsNoMatchError(err) {
+			log.V(1).Info("PrometheusRule API not available, skipping alert simulation")
+			r.setAPIAvailable(config.Name, "PrometheusRule", false)
+			return 0, nil
+		}
+		r.setAPIAvailable(config.Name, "PrometheusRule", true)
+
+		desired := r.generatePrometheusRule(config, ns.Name)
+
+		if errors.IsNotFound(err) {
+			if err := r.createOrAdopt(ctx, config, desired); err != nil {
+				if isAPIServerTimeoutError(err) {
+					log.V(1).Info("API server timeout creating PrometheusRule, continuing", "namespace", ns.Name)
+					continue
+				}
+				return alertCount, fmt.Errorf("failed to create PrometheusRule in %s: %w", ns.Name, err)
+			}
+			r.recordAPICall(config, 1)
+			alertCount += alertsPerNamespace
+			continue
+		}
+		if err != nil {
+			if isAPIServerTimeoutError(err) {
+				log.V(1).Info("API server timeout getting PrometheusRule, continuing", "namespace", ns.Name)
+				continue
+			}
+			return alertCount, fmt.Errorf("failed to get PrometheusRule in %s: %w", ns.Name, err)
+		}
+
+		// Rewrite the rule group when the configured alert count or severity changes
+		if prometheusRuleSignature(existing) != prometheusRuleSignature(desired) {
+			existing.Object["spec"] = desired.Object["spec"]
+			if err := r.Update(ctx, existing); err != nil {
+				return alertCount, fmt.Errorf("failed to update PrometheusRule in %s: %w", ns.Name, err)
+			}
+			r.recordAPICall(config, 1)
+		}
+		alertCount += alertsPerNamespace
+	}
+
+	return alertCount, nil
+}
+
+// generatePrometheusRule creates a PrometheusRule whose rules fire as soon as they are evaluated
+func (r *ScaleLoadConfigReconciler) generatePrometheusRule(config *scalev1.ScaleLoadConfig, namespace string) *unstructured.Unstructured {
+	severity := getAlertSeverity(config)
+
+	rules := make([]interface{}, 0, getAlertsPerNamespace(config))
+	for i := int32(0); i < getAlertsPerNamespace(config); i++ {
+		rules = append(rules, map[string]interface{}{
+			"alert": fmt.Sprintf("SimulatedAlert%d", i),
+			"expr":  "vector(1)",
+			"labels": map[string]interface{}{
+				"severity": severity,
+			},
+			"annotations": map[string]interface{}{
+				"summary":     fmt.Sprintf("Simulated alert %d in namespace %s", i, namespace),
+				"description": "Always-firing alert generated by sim-operator for alert pipeline scale testing",
+			},
+		})
+	}
+
+	rule := &unstructured.Unstructured{}
+	rule.SetGroupVersionKind(prometheusRuleGVK)
+	rule.SetName(simulatedAlertRuleName)
+	rule.SetNamespace(namespace)
+	rule.SetLabels(map[string]string{
+		"scale.openshift.io/managed-by":    config.Name,
+		"scale.openshift.io/resource-type": "prometheusrule",
+		"scale.openshift.io/created-by":    "sim-operator",
+	})
+	rule.Object["spec"] = map[string]interface{}{
+		"groups": []interface{}{
+			map[string]interface{}{
+				"name":  "sim-operator.simulated",
+				"rules": rules,
+			},
+		},
+	}
+	return rule
+}
+
+// prometheusRuleSignature summarizes the fields of a simulated rule group that are driven by the spec
+func prometheusRuleSignature(rule *unstructured.Unstructured) string {
+	groups, _, _ := unstructured.NestedSlice(rule.Object, "spec", "groups")
+	if len(groups) == 0 {
+		return ""
+	}
+	group, ok := groups[0].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	rules, _, _ := unstructured.NestedSlice(group, "rules")
+	severity := ""
+	if len(rules) > 0 {
+		if first, ok := rules[0].(map[string]interface{}); ok {
+			severity, _, _ = unstructured.NestedString(first, "labels", "severity")
+		}
+	}
+	return fmt.Sprintf("%d/%s", len(rules), severity)
+}
+
+// postAlertmanagerAlerts posts firing alerts for the selected namespaces directly to Alertmanager
+func (r *ScaleLoadConfigReconciler) postAlertmanagerAlerts(ctx context.Context, config *scalev1.ScaleLoadConfig,
+	namespaces []corev1.Namespace) (int, error) {
+
+	log := r.Log.WithName("alert-manager")
+	alertConfig := config.Spec.AlertSimulation
+
+	if alertConfig.AlertmanagerURL == "" {
+		return 0, fmt.Errorf("alertmanagerURL is required when alert simulation mode is %s", alertModeAlertmanager)
+	}
+
+	refreshInterval := time.Duration(alertConfig.RefreshIntervalSeconds) * time.Second
+	if refreshInterval <= 0 {
+		refreshInterval = 300 * time.Second
+	}
+
+	alertsPerNamespace := int(getAlertsPerNamespace(config))
+	alertCount := len(namespaces) * alertsPerNamespace
+
+	// Alerts stay active in Alertmanager until EndsAt, so only re-post once per refresh interval
+	if last, posted := r.lastAlertPost[config.Name]; posted && time.Since(last) < refreshInterval {
+		return alertCount, nil
+	}
+
+	now := time.Now()
+	severity := getAlertSeverity(config)
+	alerts := make([]alertmanagerAlert, 0, alertCount)
+	for _, ns := range namespaces {
+		for i := 0; i < alertsPerNamespace; i++ {
+			alerts = append(alerts, alertmanagerAlert{
+				Labels: map[string]string{
+					"alertname":  fmt.Sprintf("SimulatedAlert%d", i),
+					"namespace":  ns.Name,
+					"severity":   severity,
+					"managed_by": config.Name,
+					"created_by": "sim-operator",
+				},
+				Annotations: map[string]string{
+					"summary": fmt.Sprintf("Simulated alert %d in namespace %s", i, ns.Name),
+				},
+				StartsAt: now,
+				EndsAt:   now.Add(2 * refreshInterval),
+			})
+		}
+	}
+
+	if len(alerts) == 0 {
+		return 0, nil
+	}
+
+	body, err := json.Marshal(alerts)
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal alerts: %w", err)
+	}
+
+	endpoint := strings.TrimSuffix(alertConfig.AlertmanagerURL, "/") + "/api/v2/alerts"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to build Alertmanager request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	// The URL is chosen by the config's author, so only a token they placed in the operator's
+	// namespace is sent, never the operator's own credentials
+	token, err := r.secretToken(ctx, config, alertConfig.AuthSecretRef, "alertmanager")
+	if err != nil {
+		return 0, err
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := newSimHTTPClient().Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to post alerts to Alertmanager: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return 0, fmt.Errorf("alertmanager returned status %d", resp.StatusCode)
+	}
+
+	if r.lastAlertPost == nil {
+		r.lastAlertPost = make(map[string]time.Time)
+	}
+	r.lastAlertPost[config.Name] = now
+	log.V(1).Info("Posted simulated alerts to Alertmanager", "alerts", len(alerts), "namespaces", len(namespaces))
+	return alertCount, nil
+}
+
+// getAlertsPerNamespace returns the configured alerts per namespace with default
+func getAlertsPerNamespace(config *scalev1.ScaleLoadConfig) int32 {
+	if config.Spec.AlertSimulation.AlertsPerNamespace > 0 {
+		return config.Spec.AlertSimulation.AlertsPerNamespace
+	}
+	return 2
+}
+
+// getAlertSeverity returns the configured alert severity with default
+func getAlertSeverity(config *scalev1.ScaleLoadConfig) string {
+	if config.Spec.AlertSimulation.Severity != "" {
+		return config.Spec.AlertSimulation.Severity
+	}
+	return "warning"
+}
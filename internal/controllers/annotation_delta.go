@@ -0,0 +1,52 @@
+package controllers
+
+import (
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+// annotationDelta is what one node annotation update changed
+type annotationDelta struct {
+	keys  []string
+	bytes int
+}
+
+// diffNodeAnnotations returns the annotation keys whose value the update sets or changes, and the
+// bytes of those entries, keys included
+func diffNodeAnnotations(before, after *corev1.Node) annotationDelta {
+	var delta annotationDelta
+	for key, value := range after.Annotations {
+		if previous, ok := before.Annotations[key]; ok && previous == value {
+			continue
+		}
+		delta.keys = append(delta.keys, key)
+		delta.bytes += len(key) + len(value)
+	}
+	sort.Strings(delta.keys)
+	return delta
+}
+
+// recordAnnotationDelta logs a changelog entry for a written node annotation update and counts it,
+// so observed apiserver and watch traffic can be matched to what the operator changed
+func (r *ScaleLoadConfigReconciler) recordAnnotationDelta(config *scalev1.ScaleLoadConfig, before, after *corev1.Node) {
+	delta := diffNodeAnnotations(before, after)
+	if len(delta.keys) == 0 {
+		return
+	}
+	r.Log.WithName("annotation-delta").Info("Node annotation delta",
+		"config", config.Name,
+		"node", after.Name,
+		"keys", delta.keys,
+		"bytes", delta.bytes,
+		"timestamp", time.Now().UTC().Format(time.RFC3339Nano))
+	if r.NodeAnnotationKeysChanged != nil {
+		r.NodeAnnotationKeysChanged.WithLabelValues(config.Name).Add(float64(len(delta.keys)))
+	}
+	if r.NodeAnnotationBytesChanged != nil {
+		r.NodeAnnotationBytesChanged.WithLabelValues(config.Name).Add(float64(delta.bytes))
+	}
+}
@@ -0,0 +1,53 @@
+package controllers
+
+import (
+	"math/rand"
+
+	corev1 "k8s.io/api/core/v1"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+// defaultStableNodeAnnotations are set once by their agents in real clusters and only change when
+// the node is rebuilt, so rewriting them would produce diffs no real cluster sees
+var defaultStableNodeAnnotations = []string{
+	"k8s.ovn.org/node-chassis-id",
+	"k8s.ovn.org/zone-name",
+	"k8s.ovn.org/remote-zone-migrated",
+	"k8s.ovn.org/layer2-topology-version",
+	"machineconfiguration.openshift.io/controlPlaneTopology",
+	"machineconfiguration.openshift.io/lastObservedServerCAAnnotation",
+	"csi.volume.kubernetes.io/nodeid",
+	"machine.openshift.io/machine",
+}
+
+// annotationStability classifies node annotation keys into stable ones, set once, and churning
+// ones, rewritten on updates
+type annotationStability struct {
+	stable map[string]bool
+}
+
+// newAnnotationStability combines the built-in stable keys with the config's overrides
+func newAnnotationStability(churn scalev1.AnnotationChurnConfig) annotationStability {
+	stable := make(map[string]bool, len(defaultStableNodeAnnotations)+len(churn.StableAnnotations))
+	for _, key := range defaultStableNodeAnnotations {
+		stable[key] = true
+	}
+	for _, key := range churn.StableAnnotations {
+		stable[key] = true
+	}
+	for _, key := range churn.ChurningAnnotations {
+		delete(stable, key)
+	}
+	return annotationStability{stable: stable}
+}
+
+// due reports whether an annotation is written this update: a stable one only while the node lacks
+// it, a churning one with the given chance
+func (s annotationStability) due(node *corev1.Node, key string, chance float64) bool {
+	if s.stable[key] {
+		_, set := node.Annotations[key]
+		return !set
+	}
+	return rand.Float64() < chance
+}
@@ -0,0 +1,445 @@
+package controllers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"time"
+
+	routev1 "github.com/openshift/api/route/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+	"github.com/jtaleric/sim-operator/pkg/generator"
+)
+
+const (
+	appBundleResourceType = "appbundle"
+	appBundleLabel        = "scale.openshift.io/app-bundle"
+	appBundleRevision     = "scale.openshift.io/config-revision"
+	// appBundleConfigChecksum is the pod template annotation Helm charts and config reloaders keep in
+	// step with the ConfigMap a Deployment mounts
+	appBundleConfigChecksum = "checksum/config"
+)
+
+// appBundleResourceTypes lists every kind a bundle is made of; all members carry the appbundle resource-type
+var appBundleResourceTypes = []managedResourceType{
+	{appBundleResourceType, func() client.ObjectList { return &appsv1.DeploymentList{} }},
+	{appBundleResourceType, func() client.ObjectList { return &corev1.ServiceList{} }},
+	{appBundleResourceType, func() client.ObjectList { return &routev1.RouteList{} }},
+	{appBundleResourceType, func() client.ObjectList { return &networkingv1.IngressList{} }},
+	{appBundleResourceType, func() client.ObjectList { return &corev1.ConfigMapList{} }},
+	{appBundleResourceType, func() client.ObjectList { return &corev1.SecretList{} }},
+	{appBundleResourceType, func() client.ObjectList { return &corev1.ServiceAccountList{} }},
+}
+
+func init() {
+	RegisterResourceChurner(appBundleChurner{})
+}
+
+// appBundleChurner keeps a number of application bundles in each selected namespace
+type appBundleChurner struct{}
+
+func (appBundleChurner) Name() string { return "appBundles" }
+
+func (appBundleChurner) Enabled(config *scalev1.ScaleLoadConfig) bool {
+	return config.Spec.ResourceChurn.AppBundles.Enabled
+}
+
+func (appBundleChurner) NamespaceInterval(config *scalev1.ScaleLoadConfig) int32 {
+	return config.Spec.ResourceChurn.AppBundles.NamespaceInterval
+}
+
+func (appBundleChurner) NamespaceTargeting(config *scalev1.ScaleLoadConfig) *scalev1.NamespaceTargeting {
+	return config.Spec.ResourceChurn.AppBundles.NamespaceTargeting
+}
+
+func (appBundleChurner) EnsureCount(ctx context.Context, r *ScaleLoadConfigReconciler,
+	config *scalev1.ScaleLoadConfig, namespace string) (int32, error) {
+	return r.manageAppBundles(ctx, config, namespace)
+}
+
+// Churn is a no-op since manageAppBundles rolls bundle configuration within its update window
+func (appBundleChurner) Churn(context.Context, *ScaleLoadConfigReconciler, *scalev1.ScaleLoadConfig, string) error {
+	return nil
+}
+
+func (appBundleChurner) Cleanup(ctx context.Context, r *ScaleLoadConfigReconciler,
+	config *scalev1.ScaleLoadConfig, namespace string) (int, error) {
+	var deleted int
+	for _, resource := range appBundleResourceTypes {
+		count, err := r.deleteResourceType(ctx, config, resource, client.InNamespace(namespace))
+		deleted += count
+		if err != nil {
+			return deleted, err
+		}
+	}
+	return deleted, nil
+}
+
+// manageAppBundles creates missing bundles, removes surplus ones and, when churn is due, rolls each
+// bundle's ConfigMap and Deployment together the way a configuration change rolls out a real app.
+// With the ConfigChecksum rollout trigger the ConfigMaps are compared on every pass instead, and any
+// Deployment whose configuration changed is rolled, whoever changed it
+func (r *ScaleLoadConfigReconciler) manageAppBundles(ctx context.Context, config *scalev1.ScaleLoadConfig, namespace string) (int32, error) {
+	log := r.Log.WithName("appbundle-manager").WithValues("namespace", namespace)
+	bundleConfig := config.Spec.ResourceChurn.AppBundles
+
+	labels := client.MatchingLabels{
+		"scale.openshift.io/managed-by":    config.Name,
+		"scale.openshift.io/resource-type": appBundleResourceType,
+	}
+	deployments := &appsv1.DeploymentList{}
+	if err := r.List(ctx, deployments, client.InNamespace(namespace), labels); err != nil {
+		return 0, fmt.Errorf("failed to list app bundle Deployments: %w", err)
+	}
+	r.recordAPICall(config, 1)
+
+	existing := make(map[string]*appsv1.Deployment, len(deployments.Items))
+	for i := range deployments.Items {
+		existing[deployments.Items[i].Labels[appBundleLabel]] = &deployments.Items[i]
+	}
+
+	churnDue := r.shouldPerformResourceOperation(namespace, "appBundles", bundleConfig.UpdateFrequencyMin, bundleConfig.UpdateFrequencyMax)
+	reloadOnChange := bundleConfig.RolloutTrigger == scalev1.AppBundleRolloutConfigChecksum
+	var configMaps map[string]*corev1.ConfigMap
+	if churnDue || reloadOnChange {
+		configMapList := &corev1.ConfigMapList{}
+		if err := r.List(ctx, configMapList, client.InNamespace(namespace), labels); err != nil {
+			return 0, fmt.Errorf("failed to list app bundle ConfigMaps: %w", err)
+		}
+		r.recordAPICall(config, 1)
+		configMaps = make(map[string]*corev1.ConfigMap, len(configMapList.Items))
+		for i := range configMapList.Items {
+			configMaps[configMapList.Items[i].Name] = &configMapList.Items[i]
+		}
+	}
+
+	var managed, reloaded int32
+	desired := make(map[string]bool, bundleConfig.Count)
+	for i := int32(0); i < bundleConfig.Count; i++ {
+		name := fmt.Sprintf("sim-bundle-%s-%d", configNameHash(config.Name), i)
+		desired[name] = true
+
+		deployment, ok := existing[name]
+		if !ok {
+			if err := r.createAppBundle(ctx, config, namespace, name); err != nil {
+				return managed, err
+			}
+			managed++
+			continue
+		}
+		managed++
+		if churnDue {
+			if err := r.rollAppBundle(ctx, config, deployment, configMaps[name]); err != nil {
+				log.Error(err, "Failed to roll app bundle", "bundle", name)
+				continue
+			}
+		}
+		if reloadOnChange {
+			rolled, err := r.reloadAppBundle(ctx, config, deployment, configMaps[name])
+			if err != nil {
+				log.Error(err, "Failed to reload app bundle", "bundle", name)
+			} else if rolled {
+				reloaded++
+			}
+		}
+	}
+	if churnDue {
+		r.updateLastResourceOperation(namespace, "appBundles")
+	}
+	if reloaded > 0 {
+		log.V(1).Info("App bundles rolled out on configuration change", "count", reloaded)
+	}
+
+	for name := range existing {
+		if desired[name] {
+			continue
+		}
+		if err := r.deleteAppBundle(ctx, config, namespace, name); err != nil {
+			log.Error(err, "Failed to delete surplus app bundle", "bundle", name)
+		}
+	}
+
+	return managed, nil
+}
+
+// createAppBundle creates every member of a bundle; members left over from a partial create are kept
+func (r *ScaleLoadConfigReconciler) createAppBundle(ctx context.Context, config *scalev1.ScaleLoadConfig, namespace, name string) error {
+	for _, obj := range r.generateAppBundle(config, namespace, name) {
+		err := r.createOrAdopt(ctx, config, obj)
+		if _, isRoute := obj.(*routev1.Route); isRoute && meta.IsNoMatchError(err) {
+			// Plain Kubernetes clusters have no Route API; the bundle is still useful without it
+			r.setAPIAvailable(config.Name, "Route", false)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to create app bundle %T %s/%s: %w", obj, namespace, name, err)
+		}
+		r.recordAPICall(config, 1)
+	}
+	return nil
+}
+
+// rollAppBundle rewrites the bundle's ConfigMap and stamps its revision on the pod template,
+// so the Deployment rolls out new pods that remount the changed configuration. With the
+// ConfigChecksum rollout trigger only the ConfigMap is rewritten and reloadAppBundle rolls the Deployment
+func (r *ScaleLoadConfigReconciler) rollAppBundle(ctx context.Context, config *scalev1.ScaleLoadConfig,
+	deployment *appsv1.Deployment, configMap *corev1.ConfigMap) error {
+
+	revision := strconv.FormatInt(time.Now().Unix(), 10)
+	if configMap != nil {
+		configMap.Data = appBundleConfigData(revision)
+		if err := r.Update(ctx, configMap); err != nil {
+			return fmt.Errorf("failed to update ConfigMap %s/%s: %w", configMap.Namespace, configMap.Name, err)
+		}
+		r.recordAPICall(config, 1)
+	}
+
+	if config.Spec.ResourceChurn.AppBundles.RolloutTrigger == scalev1.AppBundleRolloutConfigChecksum {
+		return nil
+	}
+	return r.annotatePodTemplate(ctx, config, deployment, appBundleRevision, revision)
+}
+
+// reloadAppBundle rolls the bundle's Deployment when the checksum of its ConfigMap no longer matches
+// the checksum/config annotation of its pod template, and reports whether it did. Like a config reloader
+// it follows every change to the ConfigMap, including those made outside the operator
+func (r *ScaleLoadConfigReconciler) reloadAppBundle(ctx context.Context, config *scalev1.ScaleLoadConfig,
+	deployment *appsv1.Deployment, configMap *corev1.ConfigMap) (bool, error) {
+
+	if configMap == nil {
+		return false, nil
+	}
+	checksum := configChecksum(configMap.Data)
+	if deployment.Spec.Template.Annotations[appBundleConfigChecksum] == checksum {
+		return false, nil
+	}
+	if err := r.annotatePodTemplate(ctx, config, deployment, appBundleConfigChecksum, checksum); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// annotatePodTemplate sets an annotation on the Deployment's pod template, rolling out new pods
+func (r *ScaleLoadConfigReconciler) annotatePodTemplate(ctx context.Context, config *scalev1.ScaleLoadConfig,
+	deployment *appsv1.Deployment, key, value string) error {
+
+	patch := client.MergeFrom(deployment.DeepCopy())
+	if deployment.Spec.Template.Annotations == nil {
+		deployment.Spec.Template.Annotations = make(map[string]string)
+	}
+	deployment.Spec.Template.Annotations[key] = value
+	if err := r.Patch(ctx, deployment, patch); err != nil {
+		return fmt.Errorf("failed to roll Deployment %s/%s: %w", deployment.Namespace, deployment.Name, err)
+	}
+	r.recordAPICall(config, 1)
+	return nil
+}
+
+// deleteAppBundle deletes every member of one bundle
+func (r *ScaleLoadConfigReconciler) deleteAppBundle(ctx context.Context, config *scalev1.ScaleLoadConfig, namespace, name string) error {
+	for _, resource := range appBundleResourceTypes {
+		list := resource.newList()
+		err := r.List(ctx, list, client.InNamespace(namespace), client.MatchingLabels{
+			"scale.openshift.io/managed-by": config.Name,
+			appBundleLabel:                  name,
+		})
+		if meta.IsNoMatchError(err) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to list members of app bundle %s/%s: %w", namespace, name, err)
+		}
+		r.recordAPICall(config, 1)
+
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return fmt.Errorf("failed to extract members of app bundle %s/%s: %w", namespace, name, err)
+		}
+		for _, item := range items {
+			obj, ok := item.(client.Object)
+			if !ok {
+				continue
+			}
+			if err := r.Delete(ctx, obj); err != nil && !errors.IsNotFound(err) {
+				return fmt.Errorf("failed to delete app bundle member %s/%s: %w", namespace, obj.GetName(), err)
+			}
+			r.recordAPICall(config, 1)
+		}
+	}
+	return nil
+}
+
+// generateAppBundle builds the members of one bundle in creation order: the objects the Deployment
+// references come first, then the Deployment, then the Service and its Route or Ingress
+func (r *ScaleLoadConfigReconciler) generateAppBundle(config *scalev1.ScaleLoadConfig, namespace, name string) []client.Object {
+	bundleConfig := config.Spec.ResourceChurn.AppBundles
+	objectMeta := func(component string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels: map[string]string{
+				"scale.openshift.io/managed-by":    config.Name,
+				"scale.openshift.io/resource-type": appBundleResourceType,
+				"scale.openshift.io/created-by":    "sim-operator",
+				appBundleLabel:                     name,
+				"app.kubernetes.io/name":           name,
+				"app.kubernetes.io/component":      component,
+			},
+		}
+	}
+	podLabels := map[string]string{
+		"scale.openshift.io/managed-by": config.Name,
+		appBundleLabel:                  name,
+		"app.kubernetes.io/name":        name,
+	}
+
+	image := bundleConfig.Image
+	if image == "" {
+		image = "registry.redhat.io/ubi8/ubi-minimal:latest"
+	}
+	replicas := bundleConfig.Replicas
+	revision := strconv.FormatInt(time.Now().Unix(), 10)
+	configData := appBundleConfigData(revision)
+	podAnnotations := map[string]string{appBundleRevision: revision}
+	if bundleConfig.RolloutTrigger == scalev1.AppBundleRolloutConfigChecksum {
+		podAnnotations = map[string]string{appBundleConfigChecksum: configChecksum(configData)}
+	}
+
+	objects := []client.Object{
+		&corev1.ServiceAccount{ObjectMeta: objectMeta("identity")},
+		&corev1.ConfigMap{ObjectMeta: objectMeta("configuration"), Data: configData},
+		&corev1.Secret{
+			ObjectMeta: objectMeta("credentials"),
+			Type:       corev1.SecretTypeOpaque,
+			Data: map[string][]byte{
+				"DATABASE_USER":     []byte("app"),
+				"DATABASE_PASSWORD": []byte(generator.Password(16)),
+			},
+		},
+		&appsv1.Deployment{
+			ObjectMeta: objectMeta("application"),
+			Spec: appsv1.DeploymentSpec{
+				Replicas: &replicas,
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{appBundleLabel: name}},
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels:      podLabels,
+						Annotations: podAnnotations,
+					},
+					Spec: corev1.PodSpec{
+						ServiceAccountName: name,
+						NodeSelector:       workloadNodeSelector(config),
+						Tolerations: []corev1.Toleration{{
+							Key:      "kwok.x-k8s.io/node",
+							Operator: corev1.TolerationOpEqual,
+							Value:    "fake",
+							Effect:   corev1.TaintEffectNoSchedule,
+						}},
+						Containers: []corev1.Container{{
+							Name:            "app",
+							Image:           image,
+							ImagePullPolicy: corev1.PullIfNotPresent,
+							Command:         []string{"sleep", "3600"},
+							Ports:           []corev1.ContainerPort{{Name: "http", ContainerPort: 8080}},
+							EnvFrom: []corev1.EnvFromSource{
+								{ConfigMapRef: &corev1.ConfigMapEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: name}}},
+								{SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: name}}},
+							},
+							VolumeMounts: []corev1.VolumeMount{
+								{Name: "config", MountPath: "/etc/app", ReadOnly: true},
+								{Name: "credentials", MountPath: "/etc/credentials", ReadOnly: true},
+							},
+						}},
+						Volumes: []corev1.Volume{
+							{Name: "config", VolumeSource: corev1.VolumeSource{
+								ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: name}},
+							}},
+							{Name: "credentials", VolumeSource: corev1.VolumeSource{
+								Secret: &corev1.SecretVolumeSource{SecretName: name},
+							}},
+						},
+					},
+				},
+			},
+		},
+		&corev1.Service{
+			ObjectMeta: objectMeta("backend"),
+			Spec: corev1.ServiceSpec{
+				Selector: map[string]string{appBundleLabel: name},
+				Ports: []corev1.ServicePort{{
+					Name:       "http",
+					Port:       8080,
+					TargetPort: intstr.FromInt(8080),
+					Protocol:   corev1.ProtocolTCP,
+				}},
+				Type: corev1.ServiceTypeClusterIP,
+			},
+		},
+	}
+
+	switch bundleConfig.Exposure {
+	case scalev1.AppBundleExposureNone:
+	case scalev1.AppBundleExposureIngress:
+		pathType := networkingv1.PathTypePrefix
+		objects = append(objects, &networkingv1.Ingress{
+			ObjectMeta: objectMeta("frontend"),
+			Spec: networkingv1.IngressSpec{
+				Rules: []networkingv1.IngressRule{{
+					Host: fmt.Sprintf("%s.%s.apps.example.invalid", name, namespace),
+					IngressRuleValue: networkingv1.IngressRuleValue{HTTP: &networkingv1.HTTPIngressRuleValue{
+						Paths: []networkingv1.HTTPIngressPath{{
+							Path:     "/",
+							PathType: &pathType,
+							Backend: networkingv1.IngressBackend{Service: &networkingv1.IngressServiceBackend{
+								Name: name,
+								Port: networkingv1.ServiceBackendPort{Name: "http"},
+							}},
+						}},
+					}},
+				}},
+			},
+		})
+	default:
+		objects = append(objects, &routev1.Route{
+			ObjectMeta: objectMeta("frontend"),
+			Spec: routev1.RouteSpec{
+				To:   routev1.RouteTargetReference{Kind: "Service", Name: name},
+				Port: &routev1.RoutePort{TargetPort: intstr.FromString("http")},
+				TLS:  &routev1.TLSConfig{Termination: routev1.TLSTerminationEdge},
+			},
+		})
+	}
+	return objects
+}
+
+// appBundleConfigData returns the configuration a bundle's pods consume as environment and files
+func appBundleConfigData(revision string) map[string]string {
+	return map[string]string{
+		"APP_REVISION":   revision,
+		"LOG_LEVEL":      []string{"debug", "info", "warn"}[time.Now().Unix()%3],
+		"app.properties": generator.AppProperties(),
+		"config.yaml":    generator.ConfigYAML(),
+	}
+}
+
+// configChecksum returns the sha256 of a ConfigMap's data, independent of map order
+func configChecksum(data map[string]string) string {
+	hash := sha256.New()
+	for _, key := range slices.Sorted(maps.Keys(data)) {
+		fmt.Fprintf(hash, "%s=%s\n", key, data[key])
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
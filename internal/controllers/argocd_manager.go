@@ -0,0 +1,133 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+	"github.com/jtaleric/sim-operator/pkg/generator"
+)
+
+var applicationGVK = schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "Application"}
+
+// manageArgoCDApplications keeps a set of Argo CD Applications in each selected managed namespace
+// and periodically moves their target revision, returning the number of Applications managed
+func (r *ScaleLoadConfigReconciler) manageArgoCDApplications(ctx context.Context, config *scalev1.ScaleLoadConfig) (int, error) {
+	log := r.Log.WithName("argocd-manager")
+	argoConfig := config.Spec.ArgoCDSimulation
+
+	namespaces, _, err := r.getManagedNamespacesWithStatus(ctx, config)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list managed namespaces: %w", err)
+	}
+	r.recordAPICall(config, 1)
+
+	total := 0
+	for _, ns := range namespaces {
+		if !r.shouldCreateResourceForNamespace(ns, argoConfig.NamespaceInterval) {
+			continue
+		}
+
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(applicationGVK.GroupVersion().WithKind("ApplicationList"))
+		err := r.List(ctx, list, client.InNamespace(ns.Name),
+			client.MatchingLabels{"scale.openshift.io/managed-by": config.Name})
+		if meta.IsNoMatchError(err) {
+			log.V(1).Info("Argo CD Application API not available, skipping Application simulation")
+			r.setAPIAvailable(config.Name, "Application", false)
+			return 0, nil
+		}
+		r.setAPIAvailable(config.Name, "Application", true)
+		if err != nil {
+			if isAPIServerTimeoutError(err) {
+				log.V(1).Info("API server timeout listing Applications, continuing", "namespace", ns.Name)
+				continue
+			}
+			return total, fmt.Errorf("failed to list Applications in %s: %w", ns.Name, err)
+		}
+		r.recordAPICall(config, 1)
+
+		existing := make(map[string]*unstructured.Unstructured, len(list.Items))
+		for i := range list.Items {
+			existing[list.Items[i].GetName()] = &list.Items[i]
+		}
+
+		churnDue := r.shouldPerformResourceOperation(ns.Name, "applications", argoConfig.UpdateFrequencyMin, argoConfig.UpdateFrequencyMax)
+		desired := make(map[string]bool, argoConfig.Count)
+		for i := int32(0); i < argoConfig.Count; i++ {
+			app := r.generateApplication(config, ns.Name, i)
+			desired[app.GetName()] = true
+
+			if current, ok := existing[app.GetName()]; ok {
+				if !churnDue {
+					total++
+					continue
+				}
+				// Moving the target revision is what a GitOps controller sees on every new commit
+				current.Object["spec"] = app.Object["spec"]
+				if err := r.Update(ctx, current); err != nil {
+					log.Error(err, "Failed to update Application", "namespace", ns.Name, "name", app.GetName())
+					continue
+				}
+			} else if err := r.createOrAdopt(ctx, config, app); err != nil {
+				return total, fmt.Errorf("failed to create Application %s/%s: %w", ns.Name, app.GetName(), err)
+			}
+			r.recordAPICall(config, 1)
+			total++
+		}
+		if churnDue {
+			r.updateLastResourceOperation(ns.Name, "applications")
+		}
+
+		for name, app := range existing {
+			if desired[name] {
+				continue
+			}
+			if err := r.Delete(ctx, app); err != nil && !errors.IsNotFound(err) {
+				log.Error(err, "Failed to delete excess Application", "namespace", ns.Name, "name", name)
+				continue
+			}
+			r.recordAPICall(config, 1)
+		}
+	}
+
+	return total, nil
+}
+
+// generateApplication creates an Application pointing at a dummy repository with manual sync,
+// so Argo CD records it without ever deploying anything
+func (r *ScaleLoadConfigReconciler) generateApplication(config *scalev1.ScaleLoadConfig, namespace string, index int32) *unstructured.Unstructured {
+	repoURL := config.Spec.ArgoCDSimulation.RepoURL
+	if repoURL == "" {
+		repoURL = "https://git.example.invalid/sim-operator/manifests.git"
+	}
+
+	app := &unstructured.Unstructured{}
+	app.SetGroupVersionKind(applicationGVK)
+	app.SetName(fmt.Sprintf("sim-app-%s-%d", configNameHash(config.Name), index))
+	app.SetNamespace(namespace)
+	app.SetLabels(map[string]string{
+		"scale.openshift.io/managed-by":    config.Name,
+		"scale.openshift.io/resource-type": "application",
+		"scale.openshift.io/created-by":    "sim-operator",
+	})
+	app.Object["spec"] = map[string]interface{}{
+		"project": "default",
+		"source": map[string]interface{}{
+			"repoURL":        repoURL,
+			"path":           fmt.Sprintf("apps/app-%d", index),
+			"targetRevision": generator.Hash(),
+		},
+		"destination": map[string]interface{}{
+			"server":    "https://kubernetes.default.svc",
+			"namespace": namespace,
+		},
+	}
+	return app
+}
@@ -0,0 +1,127 @@
+package controllers
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	mathrand "math/rand"
+	"strings"
+	"time"
+
+	buildv1 "github.com/openshift/api/build/v1"
+	imagev1 "github.com/openshift/api/image/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+const (
+	// defaultBuildPushRegistry is the internal registry host builds push to
+	defaultBuildPushRegistry = "image-registry.openshift-image-registry.svc:5000"
+	// defaultBuildPushHistory is how many pushed images a tag keeps when historyLimit is unset
+	defaultBuildPushHistory = 5
+)
+
+// simulateBuildPushes has each BuildConfig complete a build and push a new image to its output
+// ImageStreamTag, writing the ImageStream's status the way the registry does after a push. BuildConfigs
+// whose output stream does not exist, e.g. with fewer ImageStreams than BuildConfigs, push nothing.
+// It returns how many images were pushed
+func (r *ScaleLoadConfigReconciler) simulateBuildPushes(ctx context.Context, config *scalev1.ScaleLoadConfig,
+	namespace string, buildConfigs []buildv1.BuildConfig) (int, error) {
+
+	pushes := config.Spec.ResourceChurn.BuildPushes
+	if !pushes.Enabled || !config.Spec.ResourceChurn.ImageStreams.Enabled || len(buildConfigs) == 0 {
+		return 0, nil
+	}
+	log := r.Log.WithName("build-push").WithValues("namespace", namespace)
+
+	streams := &imagev1.ImageStreamList{}
+	if err := r.List(ctx, streams, client.InNamespace(namespace), client.MatchingLabels{
+		"scale.openshift.io/managed-by":    config.Name,
+		"scale.openshift.io/resource-type": "imagestream",
+	}); err != nil {
+		return 0, fmt.Errorf("failed to list ImageStreams for build pushes: %w", err)
+	}
+	r.recordAPICall(config, 1)
+	byName := make(map[string]*imagev1.ImageStream, len(streams.Items))
+	for i := range streams.Items {
+		byName[streams.Items[i].Name] = &streams.Items[i]
+	}
+
+	registry := pushes.Registry
+	if registry == "" {
+		registry = defaultBuildPushRegistry
+	}
+	historyLimit := int(pushes.HistoryLimit)
+	if historyLimit <= 0 {
+		historyLimit = defaultBuildPushHistory
+	}
+
+	pushed := 0
+	now := time.Now()
+	for _, buildConfig := range buildConfigs {
+		if cycleBudgetSpent(ctx) {
+			break
+		}
+		output := buildConfig.Spec.Output.To
+		if output == nil || output.Kind != "ImageStreamTag" {
+			continue
+		}
+		streamName, tag, ok := strings.Cut(output.Name, ":")
+		stream := byName[streamName]
+		if !ok || stream == nil {
+			continue
+		}
+
+		original := stream.DeepCopy()
+		pushImage(stream, tag, registry, historyLimit, now)
+		if err := r.Status().Patch(ctx, stream, client.MergeFrom(original)); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return pushed, fmt.Errorf("failed to push image to %s/%s: %w", namespace, output.Name, err)
+		}
+		r.recordAPICall(config, 1)
+		pushed++
+	}
+	log.V(1).Info("Simulated build pushes", "pushed", pushed, "buildConfigs", len(buildConfigs))
+	return pushed, nil
+}
+
+// pushImage records a newly pushed image at the head of the tag's history in the stream's status,
+// keeping at most historyLimit images
+func pushImage(stream *imagev1.ImageStream, tag, registry string, historyLimit int, now time.Time) {
+	repository := fmt.Sprintf("%s/%s/%s", registry, stream.Namespace, stream.Name)
+	digest := imageDigest()
+	event := imagev1.TagEvent{
+		Created:              metav1.NewTime(now),
+		DockerImageReference: repository + "@" + digest,
+		Image:                digest,
+		Generation:           stream.Generation,
+	}
+	stream.Status.DockerImageRepository = repository
+
+	for i := range stream.Status.Tags {
+		history := &stream.Status.Tags[i]
+		if history.Tag != tag {
+			continue
+		}
+		history.Items = append([]imagev1.TagEvent{event}, history.Items...)
+		if len(history.Items) > historyLimit {
+			history.Items = history.Items[:historyLimit]
+		}
+		// A successful push clears the import errors of the tag
+		history.Conditions = nil
+		return
+	}
+	stream.Status.Tags = append(stream.Status.Tags, imagev1.NamedTagEventList{Tag: tag, Items: []imagev1.TagEvent{event}})
+}
+
+// imageDigest returns a random sha256 image digest
+func imageDigest() string {
+	sum := make([]byte, 32)
+	mathrand.Read(sum)
+	return "sha256:" + hex.EncodeToString(sum)
+}
@@ -0,0 +1,201 @@
+package controllers
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+// churnEngine runs one long-lived worker per namespace for configs in Background churn mode.
+// The reconcile only decides which workers should exist; the workers do the churn
+type churnEngine struct {
+	mu      sync.Mutex
+	workers map[string]*churnWorker // config/namespace -> worker
+}
+
+// churnWorker churns a single namespace with a snapshot of the resolved config
+type churnWorker struct {
+	cancel context.CancelFunc
+	spec   scalev1.ScaleLoadConfigSpec
+	zone   string
+
+	mu     sync.Mutex
+	counts map[string]int
+}
+
+// backgroundChurn reports whether a config churns in background workers
+func backgroundChurn(config *scalev1.ScaleLoadConfig) bool {
+	return config.Spec.ChurnEngine.Mode == scalev1.ChurnEngineBackground
+}
+
+// syncChurnWorkers starts workers for new namespaces, restarts those whose resolved spec changed and
+// stops those whose namespace is gone, returning the latest resource counts reported by the workers
+func (r *ScaleLoadConfigReconciler) syncChurnWorkers(config *scalev1.ScaleLoadConfig, namespaces []corev1.Namespace) map[string]int {
+	log := r.Log.WithName("churn-engine")
+
+	r.churnMutex.Lock()
+	if r.churnEngine == nil {
+		r.churnEngine = &churnEngine{workers: make(map[string]*churnWorker)}
+	}
+	engine := r.churnEngine
+	r.churnMutex.Unlock()
+
+	engine.mu.Lock()
+	defer engine.mu.Unlock()
+
+	zones := namespaceZones(namespaces)
+	wanted := make(map[string]bool, len(namespaces))
+	var started, restarted int
+	for _, ns := range namespaces {
+		key := config.Name + "/" + ns.Name
+		wanted[key] = true
+
+		if worker, exists := engine.workers[key]; exists {
+			if equality.Semantic.DeepEqual(worker.spec, config.Spec) && worker.zone == zones[ns.Name] {
+				continue
+			}
+			worker.cancel()
+			restarted++
+		} else {
+			started++
+		}
+		engine.workers[key] = r.startChurnWorker(config, ns, zones[ns.Name])
+	}
+
+	prefix := config.Name + "/"
+	var stopped int
+	for key, worker := range engine.workers {
+		if strings.HasPrefix(key, prefix) && !wanted[key] {
+			worker.cancel()
+			delete(engine.workers, key)
+			stopped++
+		}
+	}
+
+	if started+restarted+stopped > 0 {
+		log.Info("Synced churn workers", "config", config.Name, "workers", len(wanted),
+			"started", started, "restarted", restarted, "stopped", stopped)
+	}
+
+	counts := make(map[string]int)
+	for key := range wanted {
+		worker := engine.workers[key]
+		worker.mu.Lock()
+		for resourceType, count := range worker.counts {
+			counts[resourceType] += count
+		}
+		worker.mu.Unlock()
+	}
+	return counts
+}
+
+// startChurnWorker launches the churn loop for one namespace
+func (r *ScaleLoadConfigReconciler) startChurnWorker(config *scalev1.ScaleLoadConfig, namespace corev1.Namespace, zone string) *churnWorker {
+	// Workers outlive the reconcile that started them, so they get their own context
+	ctx, cancel := context.WithCancel(withRunID(context.Background(), config.Status.RunID))
+	snapshot := config.DeepCopy()
+	worker := &churnWorker{cancel: cancel, spec: *snapshot.Spec.DeepCopy(), zone: zone}
+
+	tick := time.Duration(config.Spec.ChurnEngine.TickSeconds) * time.Second
+	if tick <= 0 {
+		tick = 5 * time.Second
+	}
+
+	go func() {
+		log := r.Log.WithName("churn-worker").WithValues("config", snapshot.Name, "namespace", namespace.Name)
+
+		// Stagger the first tick so workers started together do not churn in lockstep
+		delay := churnPhaseOffset(namespace.Name, "churn-worker", tick)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(delay):
+			}
+			delay = tick
+
+			counts, err := r.manageNamespaceResources(ctx, snapshot, namespace)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				log.V(1).Info("Churn pass failed, retrying on next tick", "error", err.Error())
+				continue
+			}
+			worker.mu.Lock()
+			worker.counts = counts
+			worker.mu.Unlock()
+		}
+	}()
+	return worker
+}
+
+// stopChurnWorkers stops every background worker of a config
+func (r *ScaleLoadConfigReconciler) stopChurnWorkers(configName string) {
+	r.stopChurnWorkersMatching(func(key string) bool {
+		return strings.HasPrefix(key, configName+"/")
+	})
+}
+
+// stopAllChurnWorkers stops every background worker, used when a reconciler is discarded
+func (r *ScaleLoadConfigReconciler) stopAllChurnWorkers() {
+	r.stopChurnWorkersMatching(func(string) bool { return true })
+}
+
+// stopChurnWorkersMatching stops the workers whose key matches
+func (r *ScaleLoadConfigReconciler) stopChurnWorkersMatching(match func(key string) bool) {
+	r.churnMutex.Lock()
+	engine := r.churnEngine
+	r.churnMutex.Unlock()
+	if engine == nil {
+		return
+	}
+
+	engine.mu.Lock()
+	defer engine.mu.Unlock()
+	for key, worker := range engine.workers {
+		if match(key) {
+			worker.cancel()
+			delete(engine.workers, key)
+		}
+	}
+}
+
+// namespaceZoneFor returns the synthetic zone of a namespace, from the current reconcile or its churn worker
+func (r *ScaleLoadConfigReconciler) namespaceZoneFor(namespace string) string {
+	r.zonesMutex.RLock()
+	zone := r.currentNamespaceZones[namespace]
+	r.zonesMutex.RUnlock()
+	if zone != "" {
+		return zone
+	}
+
+	r.churnMutex.Lock()
+	engine := r.churnEngine
+	r.churnMutex.Unlock()
+	if engine == nil {
+		return ""
+	}
+
+	engine.mu.Lock()
+	defer engine.mu.Unlock()
+	for key, worker := range engine.workers {
+		if worker.zone != "" && strings.HasSuffix(key, "/"+namespace) {
+			return worker.zone
+		}
+	}
+	return ""
+}
+
+// setCurrentNamespaceZones publishes the namespace zones of the running reconcile
+func (r *ScaleLoadConfigReconciler) setCurrentNamespaceZones(zones map[string]string) {
+	r.zonesMutex.Lock()
+	defer r.zonesMutex.Unlock()
+	r.currentNamespaceZones = zones
+}
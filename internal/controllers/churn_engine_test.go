@@ -0,0 +1,43 @@
+package controllers_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+	"github.com/jtaleric/sim-operator/pkg/simtest"
+)
+
+// TestScaleLoadConfigReconciler_BackgroundChurn runs background workers alongside reconciles so
+// the race detector sees their shared API call accounting
+func TestScaleLoadConfigReconciler_BackgroundChurn(t *testing.T) {
+	ctx := context.Background()
+	h := simtest.NewFake(t, simtest.Options{}, simtest.KwokNodes(3)...)
+
+	config := newTestConfig("background")
+	config.Spec.ChurnEngine = scalev1.ChurnEngineConfig{Mode: scalev1.ChurnEngineBackground, TickSeconds: 1}
+	if err := h.Apply(ctx, config); err != nil {
+		t.Fatal(err)
+	}
+	if err := h.ReconcilePasses(ctx, "background", 2); err != nil {
+		t.Fatal(err)
+	}
+
+	// Workers tick every second, so a few seconds of reconciles overlap several churn passes
+	deadline := time.Now().Add(3 * time.Second)
+	for time.Now().Before(deadline) {
+		if _, err := h.Reconcile(ctx, "background"); err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	set, err := h.Generated(ctx, "background")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if set.Count("namespace") == 0 {
+		t.Fatalf("expected namespaces from background churn, got %v", set.Counts())
+	}
+}
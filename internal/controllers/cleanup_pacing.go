@@ -0,0 +1,104 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+const (
+	// cleanupPassBudget bounds a paced cleanup pass well inside the reconcile timeout
+	cleanupPassBudget = time.Minute
+	// minCleanupDeletesPerSecond keeps cleanup moving when the effective rate is zero, e.g. without nodes
+	minCleanupDeletesPerSecond = 10
+)
+
+// errCleanupInProgress reports that a paced cleanup pass ran out of time with namespaces left to
+// delete; callers requeue to continue it
+var errCleanupInProgress = errors.New("cleanup in progress")
+
+// isCleanupInProgress reports whether err only means the paced cleanup continues on the next pass
+func isCleanupInProgress(err error) bool {
+	return errors.Is(err, errCleanupInProgress)
+}
+
+// cleanupDeletesPerSecond returns the pace of a config's cleanup deletes: DeletesPerSecond when set,
+// otherwise the config's effective API call rate, and 0 for a fast teardown
+func (r *ScaleLoadConfigReconciler) cleanupDeletesPerSecond(config *scalev1.ScaleLoadConfig) int32 {
+	cleanup := config.Spec.CleanupConfig
+	if cleanup.FastTeardown {
+		return 0
+	}
+	if cleanup.DeletesPerSecond > 0 {
+		return cleanup.DeletesPerSecond
+	}
+	callsPerMinute, _ := r.getEffectiveAPIRate(config, int(config.Status.KwokNodeCount))
+	return max(callsPerMinute/60, minCleanupDeletesPerSecond)
+}
+
+// paceCleanup points the shared request pacer at the cleanup rate, whether or not the config
+// paces its load, so cleanup deletes arrive as a steady stream rather than a storm
+func (r *ScaleLoadConfigReconciler) paceCleanup(config *scalev1.ScaleLoadConfig) {
+	if r.pacer != nil {
+		r.pacer.configureCleanup(r.cleanupDeletesPerSecond(config))
+	}
+}
+
+// recordCleanupProgress counts the config's namespaces still to delete and writes the cleanup's
+// progress into status; done marks the cleanup completed
+func (r *ScaleLoadConfigReconciler) recordCleanupProgress(ctx context.Context, config *scalev1.ScaleLoadConfig, done bool) error {
+	namespaces, err := r.getManagedNamespaces(ctx, config)
+	if err != nil {
+		return fmt.Errorf("failed to count namespaces left to clean up: %w", err)
+	}
+	progress := &scalev1.CleanupProgressStatus{
+		Phase:            scalev1.CleanupInProgress,
+		Generation:       config.Generation,
+		DeletesPerSecond: r.cleanupDeletesPerSecond(config),
+	}
+	// Namespaced mode leaves the selected namespaces in place
+	if !isNamespaceScoped(config) {
+		for _, ns := range namespaces {
+			if namespaceTerminating(ns) {
+				progress.NamespacesTerminating++
+			} else {
+				progress.NamespacesRemaining++
+			}
+		}
+	}
+
+	latestConfig := &scalev1.ScaleLoadConfig{}
+	if err := r.Get(ctx, types.NamespacedName{Name: config.Name, Namespace: config.Namespace}, latestConfig); err != nil {
+		return fmt.Errorf("failed to fetch latest ScaleLoadConfig: %w", err)
+	}
+	original := latestConfig.DeepCopy()
+
+	// Disabled configs repeat the cleanup every reconcile; once it completed, there is nothing to update
+	previous := latestConfig.Status.Cleanup
+	if done && previous != nil && previous.Phase == scalev1.CleanupCompleted && previous.Generation == config.Generation {
+		return nil
+	}
+
+	now := metav1.Now()
+	progress.StartTime = &now
+	if previous != nil && previous.Phase == scalev1.CleanupInProgress && previous.Generation == config.Generation {
+		progress.StartTime = previous.StartTime
+	}
+	if done {
+		progress.Phase = scalev1.CleanupCompleted
+		progress.CompletionTime = &now
+	}
+	latestConfig.Status.Cleanup = progress
+	if err := r.Status().Patch(ctx, latestConfig, client.MergeFrom(original)); err != nil {
+		return fmt.Errorf("failed to record cleanup progress: %w", err)
+	}
+	config.Status.Cleanup = progress
+	return nil
+}
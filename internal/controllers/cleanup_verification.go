@@ -0,0 +1,244 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+// maxLeftoverExamples caps the leftover objects named in status and the report Event
+const maxLeftoverExamples = 10
+
+// cleanupLeftovers are the objects a verification pass found
+type cleanupLeftovers struct {
+	counts   map[string]int32
+	examples []string
+	// deleting counts found objects that are being deleted, directly or with their namespace
+	deleting int
+	// seen skips objects matched by both the config and the run label
+	seen map[types.UID]bool
+}
+
+// add records a found object under its kind
+func (l *cleanupLeftovers) add(kind string, obj client.Object, deleting bool) {
+	if l.seen[obj.GetUID()] {
+		return
+	}
+	l.seen[obj.GetUID()] = true
+	l.counts[kind]++
+	if deleting {
+		l.deleting++
+	}
+	if len(l.examples) < maxLeftoverExamples {
+		l.examples = append(l.examples, strings.TrimPrefix(fmt.Sprintf("%s %s/%s", kind, obj.GetNamespace(), obj.GetName()), "/"))
+	}
+}
+
+// verifyCleanup searches for objects left behind by cleanup and records the result in status. Objects
+// still being deleted are waited for until VerifyTimeoutSeconds passes, so it reports whether the
+// result is final; until then the caller requeues. A final result is also reported in an Event
+func (r *ScaleLoadConfigReconciler) verifyCleanup(ctx context.Context, config *scalev1.ScaleLoadConfig) (bool, error) {
+	log := r.Log.WithName("cleanup-verification").WithValues("config", config.Name)
+
+	start := metav1.Now()
+	if previous := config.Status.CleanupVerification; previous != nil && previous.StartTime != nil &&
+		previous.Phase == scalev1.CleanupVerificationVerifying && previous.Generation == config.Generation {
+		start = *previous.StartTime
+	}
+
+	leftovers, err := r.findLeftovers(ctx, config)
+	if err != nil {
+		return false, err
+	}
+	verification := &scalev1.CleanupVerificationStatus{
+		Phase:      scalev1.CleanupVerificationVerifying,
+		Generation: config.Generation,
+		StartTime:  &start,
+		Leftovers:  leftovers.counts,
+		Examples:   leftovers.examples,
+	}
+
+	timeout := time.Duration(config.Spec.CleanupConfig.VerifyTimeoutSeconds) * time.Second
+	done := leftovers.deleting == 0 || time.Since(start.Time) >= timeout
+	if done {
+		now := metav1.Now()
+		verification.CompletionTime = &now
+		verification.Phase = scalev1.CleanupVerificationClean
+		if len(leftovers.counts) > 0 {
+			verification.Phase = scalev1.CleanupVerificationLeftovers
+		}
+	}
+
+	if err := r.recordCleanupVerification(ctx, config, verification); err != nil {
+		return false, err
+	}
+	if !done {
+		log.V(1).Info("Waiting for deleted objects before verifying cleanup", "deleting", leftovers.deleting)
+		return false, nil
+	}
+
+	if verification.Phase == scalev1.CleanupVerificationClean {
+		log.Info("Verified cleanup, no objects left behind")
+	} else {
+		log.Info("Cleanup left objects behind", "leftovers", verification.Leftovers, "examples", verification.Examples)
+	}
+	if err := r.reportCleanupVerification(ctx, config, verification); err != nil {
+		// The result is in status and the log, so a missing Event does not repeat the verification
+		log.Error(err, "Failed to create cleanup verification event")
+	}
+	return true, nil
+}
+
+// cleanupVerified reports whether the config's current generation already has a final verification
+func cleanupVerified(config *scalev1.ScaleLoadConfig) bool {
+	verification := config.Status.CleanupVerification
+	return verification != nil && verification.Phase != scalev1.CleanupVerificationVerifying &&
+		verification.Generation == config.Generation
+}
+
+// findLeftovers lists every kind the operator creates for objects labeled with the config or its run.
+// Namespaced mode only looks inside the selected namespaces, which is all its Roles may list
+func (r *ScaleLoadConfigReconciler) findLeftovers(ctx context.Context, config *scalev1.ScaleLoadConfig) (*cleanupLeftovers, error) {
+	leftovers := &cleanupLeftovers{counts: make(map[string]int32), seen: make(map[types.UID]bool)}
+	selectors := []client.MatchingLabels{{"scale.openshift.io/managed-by": config.Name}}
+	if config.Status.RunID != "" {
+		selectors = append(selectors, client.MatchingLabels{runIDLabel: config.Status.RunID})
+	}
+
+	lists := append(scopedResourceLists(), &coordinationv1.LeaseList{})
+	scopes := []client.ListOption{client.InNamespace("")}
+	terminating := make(map[string]bool)
+
+	if isNamespaceScoped(config) {
+		namespaces, err := r.getScopedNamespaces(ctx, config)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list scoped namespaces: %w", err)
+		}
+		scopes = scopes[:0]
+		for _, ns := range namespaces {
+			scopes = append(scopes, client.InNamespace(ns.Name))
+		}
+	} else {
+		managedClusters := &unstructured.UnstructuredList{}
+		managedClusters.SetGroupVersionKind(managedClusterGVK.GroupVersion().WithKind("ManagedClusterList"))
+		// Namespaces go first so objects inside terminating ones count as being deleted
+		lists = append([]client.ObjectList{&corev1.NamespaceList{}, &corev1.NodeList{}, managedClusters}, lists...)
+	}
+
+	for _, newList := range lists {
+		for _, scope := range scopes {
+			for _, selector := range selectors {
+				// Lists are reused, so each one starts from an empty copy
+				list := newList.DeepCopyObject().(client.ObjectList)
+				if err := r.List(ctx, list, scope, selector); err != nil {
+					// Kinds that are not served, or were never allowed, cannot have been created
+					if meta.IsNoMatchError(err) || errors.IsForbidden(err) {
+						continue
+					}
+					return nil, fmt.Errorf("failed to list leftovers: %w", err)
+				}
+				r.recordAPICall(config, 1)
+
+				items, err := meta.ExtractList(list)
+				if err != nil {
+					return nil, fmt.Errorf("failed to extract leftovers: %w", err)
+				}
+				for _, item := range items {
+					obj, ok := item.(client.Object)
+					if !ok {
+						continue
+					}
+					gvk, err := apiutil.GVKForObject(obj, r.Scheme)
+					if err != nil {
+						return nil, fmt.Errorf("failed to identify leftover %s: %w", obj.GetName(), err)
+					}
+					deleting := !obj.GetDeletionTimestamp().IsZero() || terminating[obj.GetNamespace()]
+					if gvk.Kind == "Namespace" && deleting {
+						terminating[obj.GetName()] = true
+					}
+					leftovers.add(gvk.Kind, obj, deleting)
+				}
+			}
+		}
+	}
+	sort.Strings(leftovers.examples)
+	return leftovers, nil
+}
+
+// recordCleanupVerification writes the verification into status, which deleting configs still accept
+func (r *ScaleLoadConfigReconciler) recordCleanupVerification(ctx context.Context, config *scalev1.ScaleLoadConfig,
+	verification *scalev1.CleanupVerificationStatus) error {
+
+	latestConfig := &scalev1.ScaleLoadConfig{}
+	if err := r.Get(ctx, types.NamespacedName{Name: config.Name, Namespace: config.Namespace}, latestConfig); err != nil {
+		return fmt.Errorf("failed to fetch latest ScaleLoadConfig: %w", err)
+	}
+	original := latestConfig.DeepCopy()
+	latestConfig.Status.CleanupVerification = verification
+	if err := r.Status().Patch(ctx, latestConfig, client.MergeFrom(original)); err != nil {
+		return fmt.Errorf("failed to record cleanup verification: %w", err)
+	}
+	config.Status.CleanupVerification = verification
+	return nil
+}
+
+// reportCleanupVerification records the final result as an Event on the config, which outlives a
+// deleted config's status. ScaleLoadConfigs are cluster-scoped, so the Event goes to the operator's namespace
+func (r *ScaleLoadConfigReconciler) reportCleanupVerification(ctx context.Context, config *scalev1.ScaleLoadConfig,
+	verification *scalev1.CleanupVerificationStatus) error {
+
+	namespace := r.OperatorNamespace
+	if namespace == "" {
+		namespace = "default"
+	}
+	eventType, reason, message := corev1.EventTypeNormal, "CleanupVerified", "No objects with the config's or run's labels remain"
+	if verification.Phase == scalev1.CleanupVerificationLeftovers {
+		kinds := make([]string, 0, len(verification.Leftovers))
+		for kind, count := range verification.Leftovers {
+			kinds = append(kinds, fmt.Sprintf("%d %s", count, kind))
+		}
+		sort.Strings(kinds)
+		eventType, reason = corev1.EventTypeWarning, "CleanupLeftovers"
+		message = fmt.Sprintf("Objects left behind by cleanup: %s (e.g. %s)",
+			strings.Join(kinds, ", "), strings.Join(verification.Examples, ", "))
+	}
+	if config.Status.RunID != "" {
+		message += fmt.Sprintf("; run %s", config.Status.RunID)
+	}
+
+	now := metav1.Now()
+	event := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: config.Name + ".",
+			Namespace:    namespace,
+		},
+		InvolvedObject: corev1.ObjectReference{
+			APIVersion: scalev1.GroupVersion.String(),
+			Kind:       "ScaleLoadConfig",
+			Name:       config.Name,
+			UID:        config.UID,
+		},
+		Reason:         reason,
+		Message:        message,
+		Type:           eventType,
+		Source:         corev1.EventSource{Component: "sim-operator"},
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+	}
+	return r.Create(ctx, event)
+}
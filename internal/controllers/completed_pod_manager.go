@@ -0,0 +1,208 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+	"github.com/jtaleric/sim-operator/pkg/generator"
+)
+
+const completedPodResourceType = "completed-pod"
+
+func init() {
+	RegisterResourceChurner(completedPodChurner{})
+}
+
+// completedPodChurner leaves Succeeded and Failed pods behind in each selected namespace and clears
+// them on a schedule, reproducing the terminated pod buildup of clusters running many Jobs
+type completedPodChurner struct{}
+
+func (completedPodChurner) Name() string { return "completedPods" }
+
+func (completedPodChurner) Enabled(config *scalev1.ScaleLoadConfig) bool {
+	return config.Spec.ResourceChurn.CompletedPods.Enabled
+}
+
+func (completedPodChurner) NamespaceInterval(config *scalev1.ScaleLoadConfig) int32 {
+	return config.Spec.ResourceChurn.CompletedPods.NamespaceInterval
+}
+
+func (completedPodChurner) NamespaceTargeting(config *scalev1.ScaleLoadConfig) *scalev1.NamespaceTargeting {
+	return config.Spec.ResourceChurn.CompletedPods.NamespaceTargeting
+}
+
+func (completedPodChurner) EnsureCount(ctx context.Context, r *ScaleLoadConfigReconciler,
+	config *scalev1.ScaleLoadConfig, namespace string) (int32, error) {
+	return r.manageCompletedPods(ctx, config, namespace)
+}
+
+// Churn is a no-op since completed pods never change; manageCompletedPods clears them on schedule
+func (completedPodChurner) Churn(context.Context, *ScaleLoadConfigReconciler, *scalev1.ScaleLoadConfig, string) error {
+	return nil
+}
+
+func (completedPodChurner) Cleanup(ctx context.Context, r *ScaleLoadConfigReconciler,
+	config *scalev1.ScaleLoadConfig, namespace string) (int, error) {
+	return r.deleteResourceType(ctx, config, managedResourceType{completedPodResourceType,
+		func() client.ObjectList { return &corev1.PodList{} }}, client.InNamespace(namespace))
+}
+
+// manageCompletedPods clears the namespace's completed pods once the oldest outlives the cleanup
+// interval, and otherwise adds up to CreatePerPass of them until the namespace holds Count. Pods the
+// pod garbage collector removed are replaced the same way, so its threshold is held under pressure
+func (r *ScaleLoadConfigReconciler) manageCompletedPods(ctx context.Context, config *scalev1.ScaleLoadConfig, namespace string) (int32, error) {
+	log := r.Log.WithName("completed-pod-manager").WithValues("namespace", namespace)
+	completed := config.Spec.ResourceChurn.CompletedPods
+
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(namespace), client.MatchingLabels{
+		"scale.openshift.io/managed-by":    config.Name,
+		"scale.openshift.io/resource-type": completedPodResourceType,
+	}); err != nil {
+		return 0, fmt.Errorf("failed to list completed pods: %w", err)
+	}
+	r.recordAPICall(config, 1)
+
+	// Oldest first, so surplus pods are removed in the order the garbage collector would remove them
+	sort.Slice(pods.Items, func(i, j int) bool {
+		return pods.Items[i].CreationTimestamp.Before(&pods.Items[j].CreationTimestamp)
+	})
+
+	if interval := time.Duration(completed.CleanupIntervalSeconds) * time.Second; interval > 0 && len(pods.Items) > 0 &&
+		time.Since(pods.Items[0].CreationTimestamp.Time) >= interval {
+		deleted := r.deleteCompletedPods(ctx, config, pods.Items)
+		log.Info("Cleared completed pods", "count", deleted)
+		return int32(len(pods.Items) - deleted), nil
+	}
+
+	existing := int32(len(pods.Items))
+	if existing > completed.Count {
+		deleted := r.deleteCompletedPods(ctx, config, pods.Items[:existing-completed.Count])
+		return existing - int32(deleted), nil
+	}
+
+	var nodeNames []string
+	if missing := min(completed.Count-existing, max(completed.CreatePerPass, 1)); missing > 0 {
+		nodes := &corev1.NodeList{}
+		if err := r.List(ctx, nodes, client.MatchingLabelsSelector{Selector: kwokNodeSelector(config)}); err != nil {
+			return existing, fmt.Errorf("failed to list KWOK nodes for completed pods: %w", err)
+		}
+		r.recordAPICall(config, 1)
+		for _, node := range nodesMatching(nodes.Items, config.Spec.NamespaceConfig.NodeSelector) {
+			nodeNames = append(nodeNames, node.Name)
+		}
+
+		for i := int32(0); i < missing; i++ {
+			nodeName := ""
+			if len(nodeNames) > 0 {
+				nodeName = nodeNames[int(existing+i)%len(nodeNames)]
+			}
+			if err := r.createCompletedPod(ctx, config, namespace, nodeName); err != nil {
+				log.Error(err, "Failed to create completed pod")
+				continue
+			}
+			existing++
+		}
+	}
+	return existing, nil
+}
+
+// createCompletedPod creates a pod bound to a KWOK node and records it as terminated through the
+// status subresource. The pod IP is set too, so KWOK's readiness stage does not pick the pod up afterwards
+func (r *ScaleLoadConfigReconciler) createCompletedPod(ctx context.Context, config *scalev1.ScaleLoadConfig,
+	namespace, nodeName string) error {
+
+	completed := config.Spec.ResourceChurn.CompletedPods
+	image := completed.Image
+	if image == "" {
+		image = "registry.redhat.io/ubi8/ubi-minimal:latest"
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: fmt.Sprintf("sim-completed-%s-", configNameHash(config.Name)),
+			Namespace:    namespace,
+			Labels: map[string]string{
+				"scale.openshift.io/managed-by":    config.Name,
+				"scale.openshift.io/resource-type": completedPodResourceType,
+				"scale.openshift.io/created-by":    "sim-operator",
+			},
+		},
+		Spec: corev1.PodSpec{
+			NodeName:      nodeName,
+			RestartPolicy: corev1.RestartPolicyNever,
+			Tolerations:   []corev1.Toleration{{Operator: corev1.TolerationOpExists}},
+			Containers: []corev1.Container{{
+				Name:            "job",
+				Image:           image,
+				ImagePullPolicy: corev1.PullIfNotPresent,
+				Command:         []string{"sleep", "30"},
+			}},
+		},
+	}
+	if err := r.Create(ctx, pod); err != nil {
+		return fmt.Errorf("failed to create completed pod in %s: %w", namespace, err)
+	}
+	r.recordAPICall(config, 1)
+
+	original := pod.DeepCopy()
+	finished := metav1.Now()
+	started := metav1.NewTime(finished.Add(-time.Duration(30+rand.Intn(600)) * time.Second))
+	phase, reason, exitCode := corev1.PodSucceeded, "Completed", int32(0)
+	if rand.Int31n(100) < completed.FailedPercent {
+		phase, reason, exitCode = corev1.PodFailed, "Error", 1
+	}
+	pod.Status = corev1.PodStatus{
+		Phase:     phase,
+		StartTime: &started,
+		PodIP:     generator.IPv4(),
+		Conditions: []corev1.PodCondition{
+			{Type: corev1.PodScheduled, Status: corev1.ConditionTrue, LastTransitionTime: started},
+			{Type: corev1.PodInitialized, Status: corev1.ConditionTrue, LastTransitionTime: started},
+			{Type: corev1.PodReady, Status: corev1.ConditionFalse, Reason: "PodCompleted", LastTransitionTime: finished},
+			{Type: corev1.ContainersReady, Status: corev1.ConditionFalse, Reason: "PodCompleted", LastTransitionTime: finished},
+		},
+		ContainerStatuses: []corev1.ContainerStatus{{
+			Name:  "job",
+			Image: image,
+			State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+				ExitCode:   exitCode,
+				Reason:     reason,
+				StartedAt:  started,
+				FinishedAt: finished,
+			}},
+		}},
+	}
+	// A merge patch carries no resourceVersion, so it wins over KWOK if KWOK marked the pod running first
+	if err := r.Status().Patch(ctx, pod, client.MergeFrom(original)); err != nil {
+		// A pod left running would count as a completed pod that never completed
+		if deleteErr := r.Delete(ctx, pod); deleteErr != nil && !errors.IsNotFound(deleteErr) {
+			r.Log.Error(deleteErr, "Failed to delete completed pod without terminated status", "pod", pod.Name)
+		}
+		return fmt.Errorf("failed to mark pod %s/%s terminated: %w", namespace, pod.Name, err)
+	}
+	r.recordAPICall(config, 1)
+	return nil
+}
+
+// deleteCompletedPods deletes the given pods, returning how many are gone
+func (r *ScaleLoadConfigReconciler) deleteCompletedPods(ctx context.Context, config *scalev1.ScaleLoadConfig, pods []corev1.Pod) int {
+	deleted := 0
+	for i := range pods {
+		if err := r.Delete(ctx, &pods[i]); err != nil && !errors.IsNotFound(err) {
+			r.Log.Error(err, "Failed to delete completed pod", "pod", pods[i].Namespace+"/"+pods[i].Name)
+			continue
+		}
+		r.recordAPICall(config, 1)
+		deleted++
+	}
+	return deleted
+}
@@ -0,0 +1,78 @@
+package controllers
+
+import (
+	"math"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+// convergenceTolerancePercent returns how far, in percent of its target, a count may be from it and
+// still count as converged
+func convergenceTolerancePercent(config *scalev1.ScaleLoadConfig) int32 {
+	if config.Spec.ConvergenceTolerancePercent != nil {
+		return *config.Spec.ConvergenceTolerancePercent
+	}
+	return 2
+}
+
+// estimateConvergence adds the work left to reach the targets to a cycle's convergence status, with
+// when it will be done at the pace the operation budget and the API rate allow. It returns progress
+// as is, nil included, once every count is within the convergence tolerance
+func (r *ScaleLoadConfigReconciler) estimateConvergence(config *scalev1.ScaleLoadConfig, progress *scalev1.ConvergenceStatus,
+	targets scalev1.LoadTargets, achieved scalev1.ResourceCounts, kwokNodeCount int, now time.Time) *scalev1.ConvergenceStatus {
+
+	if !config.Spec.Enabled {
+		return progress
+	}
+	remaining := remainingOperations(config, targets.Resources, achieved)
+	if remaining == 0 {
+		return progress
+	}
+	if progress == nil {
+		progress = &scalev1.ConvergenceStatus{}
+	}
+	progress.RemainingOperations = remaining
+	progress.OperationsPerMinute = convergenceRate(config, targets.APICallsPerMinute, r.calculateReconcileInterval(config, kwokNodeCount))
+	if progress.OperationsPerMinute > 0 {
+		left := time.Duration(float64(remaining) / float64(progress.OperationsPerMinute) * float64(time.Minute))
+		eta := metav1.NewTime(now.Add(left).Truncate(time.Second))
+		progress.EstimatedCompletionTime = &eta
+	}
+	return progress
+}
+
+// remainingOperations adds up how far each count outside the convergence tolerance is from its target;
+// each missing or surplus object takes one create or delete
+func remainingOperations(config *scalev1.ScaleLoadConfig, targets, achieved scalev1.ResourceCounts) int32 {
+	tolerancePercent := convergenceTolerancePercent(config)
+	var remaining int32
+	for _, pair := range targetPairs(targets, achieved) {
+		target, count := pair[0], pair[1]
+		tolerance := int32(math.Ceil(float64(target) * float64(tolerancePercent) / 100))
+		diff := target - count
+		if diff < 0 {
+			diff = -diff
+		}
+		if diff > tolerance {
+			remaining += diff
+		}
+	}
+	return remaining
+}
+
+// convergenceRate returns the creates and deletes per minute a convergence can make: the effective API
+// call rate, lowered to maxOperationsPerCycle per reconcile interval when that is smaller. Churn shares
+// the API rate, so the estimate errs on the early side
+func convergenceRate(config *scalev1.ScaleLoadConfig, apiCallsPerMinute int32, interval time.Duration) int32 {
+	rate := apiCallsPerMinute
+	if perCycle := config.Spec.MaxOperationsPerCycle; perCycle > 0 && interval > 0 {
+		budgetRate := int32(float64(perCycle) * float64(time.Minute) / float64(interval))
+		if rate <= 0 || budgetRate < rate {
+			rate = budgetRate
+		}
+	}
+	return rate
+}
@@ -0,0 +1,100 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+// Results of a create that found the name taken, as reported by the already-exists metric
+const (
+	// alreadyExistsOwned marks an existing object the config already owns, e.g. created before a restart
+	alreadyExistsOwned = "owned"
+	// alreadyExistsAdopted marks an unowned object the operator created, now relabeled to the config
+	alreadyExistsAdopted = "adopted"
+	// alreadyExistsUnowned marks a shared object such as a namespace several configs write to
+	alreadyExistsUnowned = "unowned"
+	// alreadyExistsConflict marks an object owned by another config or not created by the operator
+	alreadyExistsConflict = "conflict"
+)
+
+// createOrAdopt creates a generated object and treats an existing object of the same name as created
+// when it belongs to the config. Names collide after operator restarts and in races with background
+// churn workers, and neither should abort the namespace's pass. An existing object the operator
+// created but no config owns is adopted by adding the generated labels; one owned by another config
+// or created by someone else is left alone and the AlreadyExists error is returned
+func (r *ScaleLoadConfigReconciler) createOrAdopt(ctx context.Context, config *scalev1.ScaleLoadConfig, obj client.Object) error {
+	err := r.Create(ctx, obj)
+	if err == nil || !errors.IsAlreadyExists(err) {
+		return err
+	}
+
+	result, adoptErr := r.resolveExisting(ctx, obj)
+	if r.CreateAlreadyExists != nil {
+		r.CreateAlreadyExists.WithLabelValues(r.generatedResourceType(obj), result).Inc()
+	}
+	if adoptErr != nil {
+		return adoptErr
+	}
+	if result == alreadyExistsConflict {
+		return err
+	}
+	r.Log.WithName("create-manager").V(1).Info("Object already existed, treating it as created",
+		"config", config.Name, "namespace", obj.GetNamespace(), "name", obj.GetName(), "result", result)
+	return nil
+}
+
+// resolveExisting decides whether the object holding the generated object's name can stand in for it,
+// adopting it when the operator created it and no config owns it
+func (r *ScaleLoadConfigReconciler) resolveExisting(ctx context.Context, desired client.Object) (string, error) {
+	ownerLabel, owner := orphanOwner(desired)
+	if owner == "" {
+		return alreadyExistsUnowned, nil
+	}
+
+	existing := desired.DeepCopyObject().(client.Object)
+	if err := r.Get(ctx, client.ObjectKeyFromObject(desired), existing); err != nil {
+		if errors.IsNotFound(err) {
+			// Deleted since the create; the next pass creates it again
+			return alreadyExistsOwned, nil
+		}
+		return alreadyExistsConflict, fmt.Errorf("failed to get existing %s: %w", desired.GetName(), err)
+	}
+	labels := existing.GetLabels()
+	if labels[ownerLabel] == owner {
+		return alreadyExistsOwned, nil
+	}
+	if _, existingOwner := orphanOwner(existing); existingOwner != "" || labels["scale.openshift.io/created-by"] != "sim-operator" {
+		return alreadyExistsConflict, nil
+	}
+
+	patch := client.MergeFrom(existing.DeepCopyObject().(client.Object))
+	for key, value := range desired.GetLabels() {
+		if _, ok := labels[key]; !ok {
+			labels[key] = value
+		}
+	}
+	labels[ownerLabel] = owner
+	existing.SetLabels(labels)
+	if err := r.Patch(ctx, existing, patch); err != nil {
+		return alreadyExistsConflict, fmt.Errorf("failed to adopt existing %s: %w", desired.GetName(), err)
+	}
+	return alreadyExistsAdopted, nil
+}
+
+// generatedResourceType returns the object's resource-type label, falling back to its lowercased kind
+func (r *ScaleLoadConfigReconciler) generatedResourceType(obj client.Object) string {
+	if resourceType := obj.GetLabels()["scale.openshift.io/resource-type"]; resourceType != "" {
+		return resourceType
+	}
+	gvk, err := r.GroupVersionKindFor(obj)
+	if err != nil {
+		return "unknown"
+	}
+	return strings.ToLower(gvk.Kind)
+}
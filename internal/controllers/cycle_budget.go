@@ -0,0 +1,165 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+const (
+	// defaultCycleBudget applies to configs stored before cycleBudgetSeconds existed
+	defaultCycleBudget = 2 * time.Minute
+	// yieldRequeueDelay is how soon a cycle that yielded continues
+	yieldRequeueDelay = time.Second
+)
+
+// errCycleBudgetSpent reports that a reconcile cycle stopped because its time budget ran out;
+// the work left is picked up by the next cycle
+var errCycleBudgetSpent = errors.New("cycle budget spent")
+
+// isCycleBudgetSpent reports whether err only means the cycle yielded
+func isCycleBudgetSpent(err error) bool {
+	return errors.Is(err, errCycleBudgetSpent)
+}
+
+type cycleDeadlineKey struct{}
+
+// withCycleBudget tags ctx with the time a cycle that started at start has to yield by
+func withCycleBudget(ctx context.Context, config *scalev1.ScaleLoadConfig, start time.Time) context.Context {
+	budget := time.Duration(config.Spec.CycleBudgetSeconds) * time.Second
+	if budget <= 0 {
+		budget = defaultCycleBudget
+	}
+	return context.WithValue(ctx, cycleDeadlineKey{}, start.Add(budget))
+}
+
+// cycleBudgetSpent reports whether the cycle ctx belongs to is past its budget. Contexts without a
+// budget, such as the background workers', never are
+func cycleBudgetSpent(ctx context.Context) bool {
+	deadline, ok := ctx.Value(cycleDeadlineKey{}).(time.Time)
+	return ok && !time.Now().Before(deadline)
+}
+
+// operationBudget counts down the creates and deletes a cycle may still make; the parallel
+// namespace workers share it
+type operationBudget struct {
+	remaining atomic.Int64
+	deferred  atomic.Int64
+}
+
+type operationBudgetKey struct{}
+
+// withOperationBudget gives the cycle ctx belongs to the config's maxOperationsPerCycle; without it
+// the cycle's operations are unlimited
+func withOperationBudget(ctx context.Context, config *scalev1.ScaleLoadConfig) context.Context {
+	if config.Spec.MaxOperationsPerCycle <= 0 {
+		return ctx
+	}
+	budget := &operationBudget{}
+	budget.remaining.Store(int64(config.Spec.MaxOperationsPerCycle))
+	return context.WithValue(ctx, operationBudgetKey{}, budget)
+}
+
+// takeOperations takes up to n operations from the cycle's budget and returns how many it may make;
+// the rest are counted as deferred to a later cycle
+func takeOperations(ctx context.Context, n int) int {
+	budget, ok := ctx.Value(operationBudgetKey{}).(*operationBudget)
+	if !ok || n <= 0 {
+		return n
+	}
+	for {
+		remaining := budget.remaining.Load()
+		granted := min(int64(n), remaining)
+		if budget.remaining.CompareAndSwap(remaining, remaining-granted) {
+			budget.deferred.Add(int64(n) - granted)
+			return int(granted)
+		}
+	}
+}
+
+// deferredOperations returns how many operations the cycle ctx belongs to held back so far
+func deferredOperations(ctx context.Context) int {
+	budget, ok := ctx.Value(operationBudgetKey{}).(*operationBudget)
+	if !ok {
+		return 0
+	}
+	return int(budget.deferred.Load())
+}
+
+// limitCycleOperations trims the creates and deletes that bring a type to its target to what is left
+// of the cycle's operation budget, creates first. offset is how far the type's count stays from the
+// target afterwards, negative while objects are still missing
+func limitCycleOperations[T any](ctx context.Context, missing []int32, surplus []T) ([]int32, []T, int32) {
+	creates := takeOperations(ctx, len(missing))
+	deletes := takeOperations(ctx, len(surplus))
+	offset := int32(len(surplus)-deletes) - int32(len(missing)-creates)
+	return missing[:creates], surplus[:deletes], offset
+}
+
+// recordConvergence keeps what a cycle left undone for the config's status, counting consecutive
+// yields; a cycle that yielded nothing and deferred no operations clears it
+func (r *ScaleLoadConfigReconciler) recordConvergence(ctx context.Context, configName string, yielded bool,
+	toCreate, toDelete, deferred int) {
+
+	progress := &scalev1.ConvergenceStatus{
+		NamespacesToCreate: int32(toCreate),
+		NamespacesToDelete: int32(toDelete),
+		NamespacesDeferred: int32(deferred),
+		OperationsDeferred: int32(deferredOperations(ctx)),
+	}
+	if !yielded && progress.OperationsDeferred == 0 {
+		delete(r.convergence, configName)
+		return
+	}
+	if yielded {
+		now := metav1.Now()
+		progress.LastYieldTime = &now
+		progress.YieldedCycles = 1
+		if previous := r.convergence[configName]; previous != nil {
+			progress.YieldedCycles = previous.YieldedCycles + 1
+		}
+	}
+	if r.convergence == nil {
+		r.convergence = make(map[string]*scalev1.ConvergenceStatus)
+	}
+	r.convergence[configName] = progress
+}
+
+// convergenceStatus returns what the config's latest cycle left undone, or nil when it finished all of its work
+func (r *ScaleLoadConfigReconciler) convergenceStatus(configName string) *scalev1.ConvergenceStatus {
+	return r.convergence[configName].DeepCopy()
+}
+
+// deferredFirst orders namespaces by when their resources were last managed, oldest first, so
+// namespaces a yielded cycle did not get to are the first ones the next cycle manages
+func (r *ScaleLoadConfigReconciler) deferredFirst(namespaces []corev1.Namespace) {
+	lastManaged := func(ns corev1.Namespace) time.Time {
+		if manager := r.resourceManagers[ns.Name]; manager != nil {
+			return manager.lastUpdate
+		}
+		return time.Time{}
+	}
+	sort.SliceStable(namespaces, func(i, j int) bool {
+		return lastManaged(namespaces[i]).Before(lastManaged(namespaces[j]))
+	})
+}
+
+// lastManagedCounts adds up the resource counts of the namespaces' latest passes
+func (r *ScaleLoadConfigReconciler) lastManagedCounts(namespaces []corev1.Namespace) map[string]int {
+	counts := make(map[string]int)
+	for _, ns := range namespaces {
+		if manager := r.resourceManagers[ns.Name]; manager != nil {
+			for resourceType, count := range manager.resourceCounters {
+				counts[resourceType] += count
+			}
+		}
+	}
+	return counts
+}
@@ -0,0 +1,190 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strconv"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+const (
+	daemonSetResourceType = "daemonset"
+	daemonSetRevision     = "scale.openshift.io/rollout-revision"
+)
+
+func init() {
+	RegisterResourceChurner(daemonSetChurner{})
+}
+
+// daemonSetChurner keeps DaemonSets targeting every KWOK node in each selected namespace and rolls
+// them out periodically, the way node agents are upgraded
+type daemonSetChurner struct{}
+
+func (daemonSetChurner) Name() string { return "daemonSets" }
+
+func (daemonSetChurner) Enabled(config *scalev1.ScaleLoadConfig) bool {
+	return config.Spec.ResourceChurn.DaemonSets.Enabled
+}
+
+func (daemonSetChurner) NamespaceInterval(config *scalev1.ScaleLoadConfig) int32 {
+	return config.Spec.ResourceChurn.DaemonSets.NamespaceInterval
+}
+
+func (daemonSetChurner) NamespaceTargeting(config *scalev1.ScaleLoadConfig) *scalev1.NamespaceTargeting {
+	return config.Spec.ResourceChurn.DaemonSets.NamespaceTargeting
+}
+
+func (daemonSetChurner) EnsureCount(ctx context.Context, r *ScaleLoadConfigReconciler,
+	config *scalev1.ScaleLoadConfig, namespace string) (int32, error) {
+	return r.manageDaemonSets(ctx, config, namespace)
+}
+
+// Churn is a no-op since manageDaemonSets triggers rollouts within its update window
+func (daemonSetChurner) Churn(context.Context, *ScaleLoadConfigReconciler, *scalev1.ScaleLoadConfig, string) error {
+	return nil
+}
+
+// Cleanup deletes the DaemonSets; garbage collection removes their pods
+func (daemonSetChurner) Cleanup(ctx context.Context, r *ScaleLoadConfigReconciler,
+	config *scalev1.ScaleLoadConfig, namespace string) (int, error) {
+	return r.deleteResourceType(ctx, config, managedResourceType{daemonSetResourceType,
+		func() client.ObjectList { return &appsv1.DaemonSetList{} }}, client.InNamespace(namespace))
+}
+
+// manageDaemonSets creates missing DaemonSets, removes surplus ones and, when churn is due, stamps a
+// new revision on each pod template so the DaemonSet controller replaces the pod on every node
+func (r *ScaleLoadConfigReconciler) manageDaemonSets(ctx context.Context, config *scalev1.ScaleLoadConfig, namespace string) (int32, error) {
+	log := r.Log.WithName("daemonset-manager").WithValues("namespace", namespace)
+	dsConfig := config.Spec.ResourceChurn.DaemonSets
+
+	daemonSets := &appsv1.DaemonSetList{}
+	if err := r.List(ctx, daemonSets, client.InNamespace(namespace), client.MatchingLabels{
+		"scale.openshift.io/managed-by":    config.Name,
+		"scale.openshift.io/resource-type": daemonSetResourceType,
+	}); err != nil {
+		return 0, fmt.Errorf("failed to list DaemonSets: %w", err)
+	}
+	r.recordAPICall(config, 1)
+
+	existing := make(map[string]*appsv1.DaemonSet, len(daemonSets.Items))
+	for i := range daemonSets.Items {
+		existing[daemonSets.Items[i].Name] = &daemonSets.Items[i]
+	}
+
+	churnDue := r.shouldPerformResourceOperation(namespace, "daemonSets", dsConfig.UpdateFrequencyMin, dsConfig.UpdateFrequencyMax)
+	revision := strconv.FormatInt(time.Now().Unix(), 10)
+
+	var managed int32
+	desired := make(map[string]bool, dsConfig.Count)
+	for i := int32(0); i < dsConfig.Count; i++ {
+		name := fmt.Sprintf("sim-ds-%s-%d", configNameHash(config.Name), i)
+		desired[name] = true
+
+		daemonSet, ok := existing[name]
+		if !ok {
+			if err := r.createOrAdopt(ctx, config, r.generateDaemonSet(config, namespace, name, revision)); err != nil {
+				return managed, fmt.Errorf("failed to create DaemonSet %s/%s: %w", namespace, name, err)
+			}
+			r.recordAPICall(config, 1)
+			managed++
+			continue
+		}
+		managed++
+		if !churnDue {
+			continue
+		}
+
+		patch := client.MergeFrom(daemonSet.DeepCopy())
+		if daemonSet.Spec.Template.Annotations == nil {
+			daemonSet.Spec.Template.Annotations = make(map[string]string)
+		}
+		daemonSet.Spec.Template.Annotations[daemonSetRevision] = revision
+		if err := r.Patch(ctx, daemonSet, patch); err != nil {
+			log.Error(err, "Failed to roll out DaemonSet", "daemonSet", name)
+			continue
+		}
+		r.recordAPICall(config, 1)
+	}
+	if churnDue {
+		r.updateLastResourceOperation(namespace, "daemonSets")
+	}
+
+	for name, daemonSet := range existing {
+		if desired[name] {
+			continue
+		}
+		if err := r.Delete(ctx, daemonSet); err != nil && !errors.IsNotFound(err) {
+			log.Error(err, "Failed to delete surplus DaemonSet", "daemonSet", name)
+			continue
+		}
+		r.recordAPICall(config, 1)
+	}
+
+	return managed, nil
+}
+
+// generateDaemonSet builds a DaemonSet selecting the config's KWOK nodes. Like real node agents it
+// tolerates every taint, so its pods stay on tainted and unreachable nodes
+func (r *ScaleLoadConfigReconciler) generateDaemonSet(config *scalev1.ScaleLoadConfig, namespace, name, revision string) *appsv1.DaemonSet {
+	dsConfig := config.Spec.ResourceChurn.DaemonSets
+	image := dsConfig.Image
+	if image == "" {
+		image = "registry.redhat.io/ubi8/ubi-minimal:latest"
+	}
+	maxUnavailable := intstr.Parse(dsConfig.MaxUnavailable)
+	if dsConfig.MaxUnavailable == "" {
+		maxUnavailable = intstr.FromString("10%")
+	}
+	nodeSelector := workloadNodeSelector(config)
+	maps.Copy(nodeSelector, dsConfig.NodeSelector)
+	podLabels := map[string]string{
+		"scale.openshift.io/managed-by": config.Name,
+		"app.kubernetes.io/name":        name,
+	}
+
+	return &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels: map[string]string{
+				"scale.openshift.io/managed-by":    config.Name,
+				"scale.openshift.io/resource-type": daemonSetResourceType,
+				"scale.openshift.io/created-by":    "sim-operator",
+				"app.kubernetes.io/name":           name,
+			},
+		},
+		Spec: appsv1.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app.kubernetes.io/name": name}},
+			UpdateStrategy: appsv1.DaemonSetUpdateStrategy{
+				Type:          appsv1.RollingUpdateDaemonSetStrategyType,
+				RollingUpdate: &appsv1.RollingUpdateDaemonSet{MaxUnavailable: &maxUnavailable},
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      podLabels,
+					Annotations: map[string]string{daemonSetRevision: revision},
+				},
+				Spec: corev1.PodSpec{
+					NodeSelector: nodeSelector,
+					Tolerations:  []corev1.Toleration{{Operator: corev1.TolerationOpExists}},
+					Containers: []corev1.Container{{
+						Name:            "agent",
+						Image:           image,
+						ImagePullPolicy: corev1.PullIfNotPresent,
+						Command:         []string{"sleep", "3600"},
+					}},
+				},
+			},
+		},
+	}
+}
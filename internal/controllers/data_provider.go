@@ -0,0 +1,57 @@
+package controllers
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+	"github.com/jtaleric/sim-operator/pkg/generator"
+)
+
+// dataProviderEntry is the provider built for one resource type of a config. Building a provider
+// may read a sample file, so it is kept until the type's dataProvider changes
+type dataProviderEntry struct {
+	spec     scalev1.DataProviderConfig
+	provider generator.DataProvider
+}
+
+// dataProvider returns the provider that synthesizes the payload of a config's resource type,
+// the synthetic one when the type has no dataProvider
+func (r *ScaleLoadConfigReconciler) dataProvider(config *scalev1.ScaleLoadConfig, resourceType string,
+	spec *scalev1.DataProviderConfig) (generator.DataProvider, error) {
+	if spec == nil {
+		return generator.Synthetic, nil
+	}
+
+	r.dataProviderMutex.Lock()
+	defer r.dataProviderMutex.Unlock()
+
+	key := config.Name + "/" + resourceType
+	if entry, exists := r.dataProviders[key]; exists && equality.Semantic.DeepEqual(entry.spec, *spec) {
+		return entry.provider, nil
+	}
+	provider, err := generator.NewProvider(spec.Name, spec.Options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build %s data provider %q: %w", resourceType, spec.Name, err)
+	}
+	if r.dataProviders == nil {
+		r.dataProviders = make(map[string]dataProviderEntry)
+	}
+	r.dataProviders[key] = dataProviderEntry{spec: *spec.DeepCopy(), provider: provider}
+	r.Log.WithName("data-provider").Info("Built data provider", "config", config.Name,
+		"resourceType", resourceType, "provider", spec.Name)
+	return provider, nil
+}
+
+// forgetDataProviders drops the providers of a deleted config
+func (r *ScaleLoadConfigReconciler) forgetDataProviders(configName string) {
+	r.dataProviderMutex.Lock()
+	defer r.dataProviderMutex.Unlock()
+	for key := range r.dataProviders {
+		if strings.HasPrefix(key, configName+"/") {
+			delete(r.dataProviders, key)
+		}
+	}
+}
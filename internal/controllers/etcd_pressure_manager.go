@@ -0,0 +1,212 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+	"github.com/jtaleric/sim-operator/pkg/generator"
+)
+
+const (
+	etcdPressureResourceType = "etcd-pressure"
+	// etcdObjectOverheadBytes approximates the metadata etcd stores alongside each object's payload
+	etcdObjectOverheadBytes = 512
+)
+
+// etcdPressureTotals accumulates etcd pressure writes for one config
+type etcdPressureTotals struct {
+	status scalev1.EtcdPressureStatus
+	// seeded is set once the totals persisted in status were added, so counts survive operator restarts
+	seeded bool
+	// lastPass and carry per namespace turn RecreatesPerMinute into whole recreates per pass
+	lastPass map[string]time.Time
+	carry    map[string]float64
+}
+
+func init() {
+	RegisterResourceChurner(etcdPressureChurner{})
+}
+
+// etcdPressureChurner keeps mid-size ConfigMaps in each selected namespace and replaces the oldest
+// of them at a steady rate, so every replacement leaves a tombstone for compaction to clear
+type etcdPressureChurner struct{}
+
+func (etcdPressureChurner) Name() string { return "etcdPressure" }
+
+func (etcdPressureChurner) Enabled(config *scalev1.ScaleLoadConfig) bool {
+	return config.Spec.EtcdPressure.Enabled
+}
+
+func (etcdPressureChurner) NamespaceInterval(config *scalev1.ScaleLoadConfig) int32 {
+	return config.Spec.EtcdPressure.NamespaceInterval
+}
+
+func (etcdPressureChurner) NamespaceTargeting(*scalev1.ScaleLoadConfig) *scalev1.NamespaceTargeting {
+	return nil
+}
+
+func (etcdPressureChurner) EnsureCount(ctx context.Context, r *ScaleLoadConfigReconciler,
+	config *scalev1.ScaleLoadConfig, namespace string) (int32, error) {
+	return r.manageEtcdPressure(ctx, config, namespace)
+}
+
+// Churn is a no-op since manageEtcdPressure replaces objects while keeping the count
+func (etcdPressureChurner) Churn(context.Context, *ScaleLoadConfigReconciler, *scalev1.ScaleLoadConfig, string) error {
+	return nil
+}
+
+func (etcdPressureChurner) Cleanup(ctx context.Context, r *ScaleLoadConfigReconciler,
+	config *scalev1.ScaleLoadConfig, namespace string) (int, error) {
+	return r.deleteResourceType(ctx, config, managedResourceType{etcdPressureResourceType,
+		func() client.ObjectList { return &corev1.ConfigMapList{} }}, client.InNamespace(namespace))
+}
+
+// manageEtcdPressure replaces the oldest pressure objects at the configured rate and keeps the
+// namespace at ObjectsPerNamespace, returning the resulting count
+func (r *ScaleLoadConfigReconciler) manageEtcdPressure(ctx context.Context, config *scalev1.ScaleLoadConfig, namespace string) (int32, error) {
+	pressure := config.Spec.EtcdPressure
+
+	list := &corev1.ConfigMapList{}
+	if err := r.List(ctx, list, client.InNamespace(namespace), client.MatchingLabels{
+		"scale.openshift.io/managed-by":    config.Name,
+		"scale.openshift.io/resource-type": etcdPressureResourceType,
+	}); err != nil {
+		return 0, fmt.Errorf("failed to list etcd pressure objects: %w", err)
+	}
+	r.recordAPICall(config, 1)
+
+	existing := list.Items
+	sort.Slice(existing, func(i, j int) bool {
+		return existing[i].CreationTimestamp.Before(&existing[j].CreationTimestamp)
+	})
+
+	// Oldest first: surplus objects, then the objects due for replacement
+	remove := 0
+	if len(existing) > int(pressure.ObjectsPerNamespace) {
+		remove = len(existing) - int(pressure.ObjectsPerNamespace)
+	}
+	recreates := r.etcdPressureRecreatesDue(config, namespace)
+	if remove+recreates > len(existing) {
+		recreates = len(existing) - remove
+	}
+
+	var deleted, written, bytesWritten int64
+	for i := 0; i < remove+recreates; i++ {
+		if err := r.Delete(ctx, &existing[i]); err != nil && !errors.IsNotFound(err) {
+			return int32(len(existing)) - int32(deleted), fmt.Errorf("failed to delete etcd pressure object %s/%s: %w", namespace, existing[i].Name, err)
+		}
+		r.recordAPICall(config, 1)
+		deleted++
+	}
+
+	count := int32(len(existing)) - int32(deleted)
+	for count < pressure.ObjectsPerNamespace {
+		configMap := r.generateEtcdPressureObject(config, namespace)
+		if err := r.createOrAdopt(ctx, config, configMap); err != nil {
+			r.addEtcdPressureWrites(config.Name, written, deleted, bytesWritten)
+			return count, fmt.Errorf("failed to create etcd pressure object in %s: %w", namespace, err)
+		}
+		r.recordAPICall(config, 1)
+		written++
+		bytesWritten += int64(len(configMap.Data["payload"])) + etcdObjectOverheadBytes
+		count++
+	}
+
+	r.addEtcdPressureWrites(config.Name, written, deleted, bytesWritten)
+	return count, nil
+}
+
+// generateEtcdPressureObject builds a ConfigMap carrying an incompressible payload of ObjectSizeKB
+func (r *ScaleLoadConfigReconciler) generateEtcdPressureObject(config *scalev1.ScaleLoadConfig, namespace string) *corev1.ConfigMap {
+	size := int(config.Spec.EtcdPressure.ObjectSizeKB)
+	if size <= 0 {
+		size = 64
+	}
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("sim-etcd-%s-%s", configNameHash(config.Name), generator.RandomString(8)),
+			Namespace: namespace,
+			Labels: map[string]string{
+				"scale.openshift.io/managed-by":    config.Name,
+				"scale.openshift.io/resource-type": etcdPressureResourceType,
+				"scale.openshift.io/created-by":    "sim-operator",
+			},
+		},
+		Data: map[string]string{"payload": generator.RandomString(size * 1024)},
+	}
+}
+
+// etcdPressureRecreatesDue returns how many objects the namespace should replace this pass,
+// carrying fractions over so low rates still produce recreates
+func (r *ScaleLoadConfigReconciler) etcdPressureRecreatesDue(config *scalev1.ScaleLoadConfig, namespace string) int {
+	r.etcdPressureMutex.Lock()
+	defer r.etcdPressureMutex.Unlock()
+
+	totals := r.etcdPressureTotalsFor(config.Name)
+	now := time.Now()
+	last, seen := totals.lastPass[namespace]
+	totals.lastPass[namespace] = now
+	if !seen {
+		return 0
+	}
+
+	due := now.Sub(last).Minutes()*float64(config.Spec.EtcdPressure.RecreatesPerMinute) + totals.carry[namespace]
+	whole := int(due)
+	totals.carry[namespace] = due - float64(whole)
+	return whole
+}
+
+// addEtcdPressureWrites adds the writes of one pass to the config's totals
+func (r *ScaleLoadConfigReconciler) addEtcdPressureWrites(configName string, written, deleted, bytesWritten int64) {
+	r.etcdPressureMutex.Lock()
+	defer r.etcdPressureMutex.Unlock()
+
+	totals := r.etcdPressureTotalsFor(configName)
+	totals.status.ObjectsWritten += written
+	totals.status.ObjectsDeleted += deleted
+	totals.status.BytesWritten += bytesWritten
+}
+
+// etcdPressureStatus returns the cumulative totals to report, adding the totals already in status
+// the first time so they keep growing across operator restarts
+func (r *ScaleLoadConfigReconciler) etcdPressureStatus(config *scalev1.ScaleLoadConfig) *scalev1.EtcdPressureStatus {
+	r.etcdPressureMutex.Lock()
+	defer r.etcdPressureMutex.Unlock()
+
+	totals, ok := r.etcdPressure[config.Name]
+	if !ok {
+		// Nothing written since the operator started; keep what is already reported
+		return config.Status.EtcdPressure
+	}
+	if !totals.seeded {
+		if previous := config.Status.EtcdPressure; previous != nil {
+			totals.status.ObjectsWritten += previous.ObjectsWritten
+			totals.status.ObjectsDeleted += previous.ObjectsDeleted
+			totals.status.BytesWritten += previous.BytesWritten
+		}
+		totals.seeded = true
+	}
+	status := totals.status
+	return &status
+}
+
+// etcdPressureTotalsFor returns the totals for a config, creating them; callers hold etcdPressureMutex
+func (r *ScaleLoadConfigReconciler) etcdPressureTotalsFor(configName string) *etcdPressureTotals {
+	if r.etcdPressure == nil {
+		r.etcdPressure = make(map[string]*etcdPressureTotals)
+	}
+	totals, ok := r.etcdPressure[configName]
+	if !ok {
+		totals = &etcdPressureTotals{lastPass: make(map[string]time.Time), carry: make(map[string]float64)}
+		r.etcdPressure[configName] = totals
+	}
+	return totals
+}
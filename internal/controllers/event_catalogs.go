@@ -0,0 +1,103 @@
+package controllers
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+// Each message takes the container name as its only argument
+
+// genericEventCatalog is the pod lifecycle mix generated when no catalog is selected
+var genericEventCatalog = []scalev1.EventTypeConfig{
+	{Type: corev1.EventTypeNormal, Reason: "Started", Message: "Started container %s", Weight: 30},
+	{Type: corev1.EventTypeNormal, Reason: "Created", Message: "Created container %s", Weight: 25},
+	{Type: corev1.EventTypeNormal, Reason: "Pulled", Message: "Successfully pulled image for container %s", Weight: 20},
+	{Type: corev1.EventTypeWarning, Reason: "FailedMount", Message: "Unable to attach or mount volumes for container %s", Weight: 10},
+	{Type: corev1.EventTypeWarning, Reason: "FailedScheduling", Message: "Pod scheduling failed for container %s", Weight: 8},
+	{Type: corev1.EventTypeNormal, Reason: "Scheduled", Message: "Successfully assigned pod for container %s", Weight: 7},
+}
+
+// awsEventCatalog adds EBS CSI attach and ECR pull events to the pod lifecycle
+var awsEventCatalog = []scalev1.EventTypeConfig{
+	{Type: corev1.EventTypeNormal, Reason: "Started", Message: "Started container %s", Weight: 25},
+	{Type: corev1.EventTypeNormal, Reason: "Created", Message: "Created container %s", Weight: 20},
+	{Type: corev1.EventTypeNormal, Reason: "Pulled", Message: "Container image for %s pulled from 123456789012.dkr.ecr.us-east-1.amazonaws.com", Weight: 15},
+	{Type: corev1.EventTypeNormal, Reason: "SuccessfulAttachVolume", Message: "AttachVolume.Attach succeeded for volume of %s by ebs.csi.aws.com", Weight: 12},
+	{Type: corev1.EventTypeWarning, Reason: "FailedAttachVolume", Message: "AttachVolume.Attach failed for volume of %s: rpc error: code = Internal desc = could not attach volume: IncorrectState", Weight: 6},
+	{Type: corev1.EventTypeWarning, Reason: "FailedMount", Message: "MountVolume.MountDevice failed for volume of %s: ebs.csi.aws.com not found in the list of registered CSI drivers", Weight: 5},
+	{Type: corev1.EventTypeWarning, Reason: "FailedCreatePodSandBox", Message: "Failed to create pod sandbox for %s: failed to assign an IP address to container: no available ENI", Weight: 4},
+	{Type: corev1.EventTypeWarning, Reason: "Failed", Message: "Failed to pull image for %s: toomanyrequests from ECR", Weight: 3},
+}
+
+// azureEventCatalog adds Azure Disk CSI attach and ACR pull events to the pod lifecycle
+var azureEventCatalog = []scalev1.EventTypeConfig{
+	{Type: corev1.EventTypeNormal, Reason: "Started", Message: "Started container %s", Weight: 25},
+	{Type: corev1.EventTypeNormal, Reason: "Created", Message: "Created container %s", Weight: 20},
+	{Type: corev1.EventTypeNormal, Reason: "Pulled", Message: "Container image for %s pulled from simregistry.azurecr.io", Weight: 15},
+	{Type: corev1.EventTypeNormal, Reason: "SuccessfulAttachVolume", Message: "AttachVolume.Attach succeeded for volume of %s by disk.csi.azure.com", Weight: 10},
+	{Type: corev1.EventTypeWarning, Reason: "FailedAttachVolume", Message: "AttachVolume.Attach failed for volume of %s: Retriable: true, RetryAfter: 0s, HTTPStatusCode: 409, AttachDiskWhileBeingDetached", Weight: 8},
+	{Type: corev1.EventTypeWarning, Reason: "FailedMount", Message: "MountVolume.WaitForAttach failed for volume of %s: timed out waiting for the device to be attached", Weight: 5},
+	{Type: corev1.EventTypeWarning, Reason: "Failed", Message: "Failed to pull image for %s: 429 Too Many Requests from simregistry.azurecr.io", Weight: 2},
+}
+
+// bareMetalEventCatalog has local storage and slower, more failure prone image pulls instead of cloud volumes
+var bareMetalEventCatalog = []scalev1.EventTypeConfig{
+	{Type: corev1.EventTypeNormal, Reason: "Started", Message: "Started container %s", Weight: 25},
+	{Type: corev1.EventTypeNormal, Reason: "Created", Message: "Created container %s", Weight: 22},
+	{Type: corev1.EventTypeNormal, Reason: "Pulled", Message: "Container image for %s pulled from the mirror registry", Weight: 18},
+	{Type: corev1.EventTypeNormal, Reason: "Pulling", Message: "Pulling image for container %s", Weight: 12},
+	{Type: corev1.EventTypeWarning, Reason: "FailedMount", Message: "MountVolume.NewMounter initialization failed for local volume of %s: path does not exist", Weight: 8},
+	{Type: corev1.EventTypeWarning, Reason: "Failed", Message: "Failed to pull image for %s: context deadline exceeded", Weight: 6},
+	{Type: corev1.EventTypeWarning, Reason: "BackOff", Message: "Back-off restarting failed container %s", Weight: 6},
+	{Type: corev1.EventTypeWarning, Reason: "Evicted", Message: "The node was low on resource: ephemeral-storage. Container %s was using more than its request", Weight: 3},
+}
+
+// ovnEventCatalog adds OVN-Kubernetes pod networking events to the pod lifecycle
+var ovnEventCatalog = []scalev1.EventTypeConfig{
+	{Type: corev1.EventTypeNormal, Reason: "Started", Message: "Started container %s", Weight: 25},
+	{Type: corev1.EventTypeNormal, Reason: "Created", Message: "Created container %s", Weight: 20},
+	{Type: corev1.EventTypeNormal, Reason: "AddedInterface", Message: "Add eth0 [10.128.2.15/23] from ovn-kubernetes for %s", Weight: 25},
+	{Type: corev1.EventTypeNormal, Reason: "Pulled", Message: "Container image for %s already present on machine", Weight: 15},
+	{Type: corev1.EventTypeWarning, Reason: "ErrorAddingLogicalPort", Message: "failed to ensure logical switch port for %s: context deadline exceeded", Weight: 5},
+	{Type: corev1.EventTypeWarning, Reason: "FailedCreatePodSandBox", Message: "Failed to create pod sandbox for %s: error adding container to network \"ovn-kubernetes\": timed out waiting for OVS port binding", Weight: 10},
+}
+
+// sdnEventCatalog adds OpenShift SDN pod networking events to the pod lifecycle
+var sdnEventCatalog = []scalev1.EventTypeConfig{
+	{Type: corev1.EventTypeNormal, Reason: "Started", Message: "Started container %s", Weight: 25},
+	{Type: corev1.EventTypeNormal, Reason: "Created", Message: "Created container %s", Weight: 20},
+	{Type: corev1.EventTypeNormal, Reason: "AddedInterface", Message: "Add eth0 [10.129.0.42/23] from openshift-sdn for %s", Weight: 25},
+	{Type: corev1.EventTypeNormal, Reason: "Pulled", Message: "Container image for %s already present on machine", Weight: 15},
+	{Type: corev1.EventTypeWarning, Reason: "FailedCreatePodSandBox", Message: "Failed to create pod sandbox for %s: error adding container to network \"openshift-sdn\": CNI request failed with status 400", Weight: 10},
+	{Type: corev1.EventTypeWarning, Reason: "FailedKillPod", Message: "error killing pod of %s: failed to \"KillPodSandbox\": openshift-sdn CNI plugin timed out", Weight: 5},
+}
+
+// namespaceEventTypes returns the event types namespace events are drawn from: the selected catalog
+// plus the config's own types, or only the config's own types when no catalog is selected
+func namespaceEventTypes(events scalev1.EventsConfig) []scalev1.EventTypeConfig {
+	var catalog []scalev1.EventTypeConfig
+	switch events.Catalog {
+	case "":
+		if len(events.EventTypes) > 0 {
+			return events.EventTypes
+		}
+		return genericEventCatalog
+	case scalev1.EventCatalogAWS:
+		catalog = awsEventCatalog
+	case scalev1.EventCatalogAzure:
+		catalog = azureEventCatalog
+	case scalev1.EventCatalogBareMetal:
+		catalog = bareMetalEventCatalog
+	case scalev1.EventCatalogOVN:
+		catalog = ovnEventCatalog
+	case scalev1.EventCatalogSDN:
+		catalog = sdnEventCatalog
+	default:
+		catalog = genericEventCatalog
+	}
+	if len(events.EventTypes) == 0 {
+		return catalog
+	}
+	return append(append([]scalev1.EventTypeConfig{}, catalog...), events.EventTypes...)
+}
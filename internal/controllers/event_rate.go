@@ -0,0 +1,118 @@
+package controllers
+
+import (
+	"time"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+// eventRateWindow is how long events are counted before the achieved rate is reported
+const eventRateWindow = 10 * time.Minute
+
+// Reasons events owed by the configured rate were not created
+const (
+	// eventDropWindowCap marks time between passes beyond maxNodeEventWindow, which is not made up
+	eventDropWindowCap = "window_cap"
+	// eventDropSystemCap marks system events beyond maxSystemEventsPerReconcile
+	eventDropSystemCap = "system_cap"
+	// eventDropCreateFailed marks events the API server refused
+	eventDropCreateFailed = "create_failed"
+	// eventDropNoNamespaces marks events owed while there was no namespace to write them to
+	eventDropNoNamespaces = "no_namespaces"
+)
+
+// eventRateTracker counts one config's created and dropped events over the current window
+type eventRateTracker struct {
+	start   time.Time
+	created int64
+	dropped map[string]int64
+
+	// completed holds the last full window, reported until the current one completes
+	completed *scalev1.EventRateStatus
+}
+
+// recordEventPass adds an event pass to the metrics and to the config's current window
+func (r *ScaleLoadConfigReconciler) recordEventPass(config *scalev1.ScaleLoadConfig, requested, created int, dropped map[string]int) {
+	if r.EventsRequested != nil {
+		r.EventsRequested.Add(float64(requested))
+	}
+	if r.EventsDropped != nil {
+		for reason, count := range dropped {
+			if count > 0 {
+				r.EventsDropped.WithLabelValues(reason).Add(float64(count))
+			}
+		}
+	}
+
+	r.eventRateMutex.Lock()
+	defer r.eventRateMutex.Unlock()
+	if r.eventRates == nil {
+		r.eventRates = make(map[string]*eventRateTracker)
+	}
+	now := time.Now()
+	tracker, ok := r.eventRates[config.Name]
+	if !ok {
+		tracker = &eventRateTracker{start: now, dropped: make(map[string]int64)}
+		r.eventRates[config.Name] = tracker
+	}
+	if elapsed := now.Sub(tracker.start); elapsed >= eventRateWindow {
+		tracker.completed = tracker.summary(elapsed)
+		tracker.start, tracker.created, tracker.dropped = now, 0, make(map[string]int64)
+	}
+
+	tracker.created += int64(created)
+	for reason, count := range dropped {
+		if count > 0 {
+			tracker.dropped[reason] += int64(count)
+		}
+	}
+}
+
+// summary reports the window's achieved hourly rate and drops
+func (t *eventRateTracker) summary(elapsed time.Duration) *scalev1.EventRateStatus {
+	status := &scalev1.EventRateStatus{}
+	if elapsed > 0 {
+		status.AchievedPerHour = int64(float64(t.created) / elapsed.Hours())
+	}
+	if len(t.dropped) > 0 {
+		status.Dropped = make(map[string]int64, len(t.dropped))
+		for reason, count := range t.dropped {
+			status.Dropped[reason] = count
+		}
+	}
+	return status
+}
+
+// eventRateStatus returns the requested and achieved event rates to report, or nil when events are off.
+// Until a full window has passed, the achieved rate covers the partial window
+func (r *ScaleLoadConfigReconciler) eventRateStatus(config *scalev1.ScaleLoadConfig, kwokNodeCount int) *scalev1.EventRateStatus {
+	events := config.Spec.ResourceChurn.Events
+	if !events.Enabled {
+		return nil
+	}
+
+	eventsPerHour := events.EventsPerNodePerHour
+	if eventsPerHour <= 0 {
+		eventsPerHour = 50
+	}
+	requested := float64(eventsPerHour) * float64(kwokNodeCount)
+	if events.SystemEvents.Enabled && !isNamespaceScoped(config) {
+		// Node events are the remaining share once system events take their fraction
+		if fraction, err := parseFloat(events.SystemEvents.Fraction); err == nil && fraction > 0 && fraction < 1 {
+			requested /= 1 - fraction
+		}
+	}
+
+	r.eventRateMutex.Lock()
+	defer r.eventRateMutex.Unlock()
+	status := &scalev1.EventRateStatus{}
+	if tracker, ok := r.eventRates[config.Name]; ok {
+		if tracker.completed != nil {
+			status = tracker.completed.DeepCopy()
+		} else {
+			status = tracker.summary(time.Since(tracker.start))
+		}
+	}
+	status.RequestedPerHour = int64(requested)
+	return status
+}
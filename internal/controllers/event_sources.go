@@ -0,0 +1,59 @@
+package controllers
+
+import (
+	"fmt"
+	"hash/fnv"
+	mathrand "math/rand"
+)
+
+// eventSourceComponents are the controllers tenant namespaces typically run, each under its own ServiceAccount
+var eventSourceComponents = []string{
+	"app-operator",
+	"config-reloader",
+	"cert-rotator",
+	"autoscaler",
+	"backup-agent",
+	"sidecar-injector",
+	"job-dispatcher",
+	"cache-warmer",
+	"log-forwarder",
+	"db-migrator",
+}
+
+// eventSource is a fake controller identity that reports events
+type eventSource struct {
+	// component is reported as the event's source component and reporting controller
+	component string
+	// instance is the replica that reported the event, named like the controller's pod
+	instance string
+}
+
+// defaultEventSource reports events as the operator itself
+var defaultEventSource = eventSource{component: "sim-operator", instance: "sim-operator"}
+
+// eventSourceIdentities returns the namespace's fake controller identities. They derive from a hash
+// of the namespace, so each namespace keeps the same controllers across reconciles and restarts
+func eventSourceIdentities(namespace string, count int32) []eventSource {
+	sources := make([]eventSource, 0, count)
+	for i := int32(0); i < count; i++ {
+		hash := fnv.New64a()
+		hash.Write([]byte(fmt.Sprintf("%s/event-source-%d", namespace, i)))
+		sum := hash.Sum64()
+
+		// Identities that draw the same component act as replicas of one controller
+		component := eventSourceComponents[sum%uint64(len(eventSourceComponents))]
+		sources = append(sources, eventSource{
+			component: component,
+			instance:  fmt.Sprintf("%s-%08x-%05x", component, uint32(sum>>32), uint32(sum)&0xfffff),
+		})
+	}
+	return sources
+}
+
+// pickEventSource chooses the identity reporting the next event, or the operator when there is none
+func pickEventSource(sources []eventSource) eventSource {
+	if len(sources) == 0 {
+		return defaultEventSource
+	}
+	return sources[mathrand.Intn(len(sources))]
+}
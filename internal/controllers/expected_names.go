@@ -0,0 +1,84 @@
+package controllers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// generatedKindPrefix returns the prefix all generated names of a kind share, e.g. sim-configmap-
+func generatedKindPrefix(kind string) string {
+	return fmt.Sprintf("sim-%s-", kind)
+}
+
+// generatedIndex returns the index a generated name carries after its kind prefix, or -1 when the
+// name follows neither sim-<kind>-<config hash>-<index>-<timestamp>-<suffix> nor the unhashed
+// sim-<kind>-<index>-<timestamp>-<suffix> of names from before config hashes. The hash is not
+// compared: lists are filtered by the managed-by label, so an object with another config's hash was
+// adopted from a renamed config and holds its index like an unhashed one, instead of every such
+// object being deleted and recreated in a single pass
+func generatedIndex(kindPrefix, name string) int32 {
+	rest, ok := strings.CutPrefix(name, kindPrefix)
+	if !ok {
+		return -1
+	}
+	var digits string
+	switch segments := strings.Split(rest, "-"); len(segments) {
+	case 3:
+		digits = segments[0]
+	case 4:
+		digits = segments[1]
+	default:
+		return -1
+	}
+	index, err := strconv.ParseInt(digits, 10, 32)
+	if err != nil || index < 0 {
+		return -1
+	}
+	return int32(index)
+}
+
+// expectedIndexDiff compares the listed objects with the expected set of indices 0..target-1. Generated
+// names end in a timestamp and random suffix, so the expected set is keyed by the index each name carries.
+// missing holds the expected indices no object has, in ascending order. surplus holds the objects outside
+// the set: indices at or above target, names without an index, and every object of a duplicated index
+// but the oldest. Objects already being deleted are in neither
+func expectedIndexDiff[T any, PT interface {
+	*T
+	client.Object
+}](items []T, kindPrefix string, target int32) (missing []int32, surplus []T) {
+	kept := make(map[int32]int, len(items))
+	for i := range items {
+		obj := PT(&items[i])
+		if obj.GetDeletionTimestamp() != nil {
+			continue
+		}
+		index := generatedIndex(kindPrefix, obj.GetName())
+		if index < 0 || index >= target {
+			surplus = append(surplus, items[i])
+			continue
+		}
+		j, duplicate := kept[index]
+		if !duplicate {
+			kept[index] = i
+			continue
+		}
+		// Keep the older object so the survivor of a duplicated index is stable across passes
+		created, keptCreated := obj.GetCreationTimestamp(), PT(&items[j]).GetCreationTimestamp()
+		if created.Before(&keptCreated) {
+			kept[index] = i
+			surplus = append(surplus, items[j])
+		} else {
+			surplus = append(surplus, items[i])
+		}
+	}
+
+	for index := int32(0); index < target; index++ {
+		if _, ok := kept[index]; !ok {
+			missing = append(missing, index)
+		}
+	}
+	return missing, surplus
+}
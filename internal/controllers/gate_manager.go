@@ -0,0 +1,255 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+// gateState is the outcome of a config's latest gate evaluation
+type gateState struct {
+	checkedAt time.Time
+	// violations describes each gate that does not hold
+	violations []string
+	// failures describes each gate that could not be evaluated
+	failures []string
+}
+
+// promQueryResponse is the body of a Prometheus instant query
+type promQueryResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+	Data   struct {
+		ResultType string          `json:"resultType"`
+		Result     json.RawMessage `json:"result"`
+	} `json:"data"`
+}
+
+// checkGates reports whether load generation has to pause, and why. Gates are evaluated at most
+// once per gate interval; reconciles in between reuse the last outcome
+func (r *ScaleLoadConfigReconciler) checkGates(ctx context.Context, config *scalev1.ScaleLoadConfig) (bool, string) {
+	gates := config.Spec.Gates
+	if gates == nil {
+		delete(r.gateStates, config.Name)
+		return false, ""
+	}
+
+	state := r.gateStates[config.Name]
+	if state == nil || time.Since(state.checkedAt) >= gateInterval(config) {
+		state = r.evaluateGates(ctx, config)
+		if r.gateStates == nil {
+			r.gateStates = make(map[string]*gateState)
+		}
+		r.gateStates[config.Name] = state
+	}
+	return gatePause(config, state)
+}
+
+// gatePause reports whether an evaluated gate state pauses load generation, and why
+func gatePause(config *scalev1.ScaleLoadConfig, state *gateState) (bool, string) {
+	reasons := state.violations
+	if config.Spec.Gates.PauseOnError {
+		// Copy on append so the state's violations are left alone
+		reasons = append(reasons[:len(reasons):len(reasons)], state.failures...)
+	}
+	return len(reasons) > 0, strings.Join(reasons, "; ")
+}
+
+// evaluateGates runs every gate query against Prometheus
+func (r *ScaleLoadConfigReconciler) evaluateGates(ctx context.Context, config *scalev1.ScaleLoadConfig) *gateState {
+	log := r.Log.WithName("gate-manager")
+	state := &gateState{checkedAt: time.Now()}
+
+	// The URL is chosen by the config's author, so only a token they placed in the operator's
+	// namespace is sent, never the operator's own credentials
+	token, err := r.secretToken(ctx, config, config.Spec.Gates.AuthSecretRef, "prometheus")
+	if err != nil {
+		log.V(1).Info("Failed to read Prometheus token", "config", config.Name, "error", err.Error())
+		for _, rule := range config.Spec.Gates.Rules {
+			state.failures = append(state.failures, fmt.Sprintf("%s: %v", rule.Name, err))
+		}
+		return state
+	}
+
+	httpClient := newSimHTTPClient()
+	for _, rule := range config.Spec.Gates.Rules {
+		values, err := queryPrometheus(ctx, httpClient, config.Spec.Gates.PrometheusURL, token, rule.Query)
+		if err == nil && len(values) == 0 {
+			err = fmt.Errorf("query returned no data")
+		}
+		if err != nil {
+			log.V(1).Info("Failed to evaluate gate", "config", config.Name, "gate", rule.Name, "error", err.Error())
+			state.failures = append(state.failures, fmt.Sprintf("%s: %v", rule.Name, err))
+			continue
+		}
+
+		threshold, _ := strconv.ParseFloat(rule.Threshold, 64)
+		operator := rule.Operator
+		if operator == "" {
+			operator = "<"
+		}
+		for _, value := range values {
+			if !compareGate(value, operator, threshold) {
+				state.violations = append(state.violations,
+					fmt.Sprintf("%s: %s %s %s does not hold", rule.Name, strconv.FormatFloat(value, 'g', 4, 64), operator, rule.Threshold))
+				break
+			}
+		}
+	}
+
+	if len(state.violations) > 0 {
+		log.Info("Gates violated", "config", config.Name, "violations", state.violations)
+	}
+	return state
+}
+
+// queryPrometheus runs an instant query and returns the value of every sample. A non-empty token
+// is sent as a bearer token
+func queryPrometheus(ctx context.Context, httpClient *http.Client, baseURL, token, query string) ([]float64, error) {
+	endpoint := strings.TrimSuffix(baseURL, "/") + "/api/v1/query?" + url.Values{"query": {query}}.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build Prometheus request: %w", err)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query Prometheus: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var body promQueryResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("failed to decode Prometheus response (status %d): %w", resp.StatusCode, err)
+	}
+	if body.Status != "success" {
+		return nil, fmt.Errorf("prometheus query failed: %s", body.Error)
+	}
+
+	// Samples are [timestamp, "value"] pairs
+	var samples [][2]any
+	switch body.Data.ResultType {
+	case "scalar":
+		var sample [2]any
+		if err := json.Unmarshal(body.Data.Result, &sample); err != nil {
+			return nil, fmt.Errorf("failed to decode scalar result: %w", err)
+		}
+		samples = append(samples, sample)
+	case "vector":
+		var vector []struct {
+			Value [2]any `json:"value"`
+		}
+		if err := json.Unmarshal(body.Data.Result, &vector); err != nil {
+			return nil, fmt.Errorf("failed to decode vector result: %w", err)
+		}
+		for _, series := range vector {
+			samples = append(samples, series.Value)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported result type %q, the query must return a scalar or instant vector", body.Data.ResultType)
+	}
+
+	values := make([]float64, 0, len(samples))
+	for _, sample := range samples {
+		raw, ok := sample[1].(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected sample value %v", sample[1])
+		}
+		value, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sample value %q: %w", raw, err)
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+// compareGate reports whether value satisfies the gate's comparison with threshold
+func compareGate(value float64, operator string, threshold float64) bool {
+	switch operator {
+	case "<=":
+		return value <= threshold
+	case ">":
+		return value > threshold
+	case ">=":
+		return value >= threshold
+	default:
+		return value < threshold
+	}
+}
+
+// gateInterval returns how often a config's gates are evaluated
+func gateInterval(config *scalev1.ScaleLoadConfig) time.Duration {
+	if config.Spec.Gates == nil || config.Spec.Gates.IntervalSeconds <= 0 {
+		return 30 * time.Second
+	}
+	return time.Duration(config.Spec.Gates.IntervalSeconds) * time.Second
+}
+
+// gatedCondition reports whether the config's gates hold; ok is false for configs without gates
+func (r *ScaleLoadConfigReconciler) gatedCondition(config *scalev1.ScaleLoadConfig, now metav1.Time) (metav1.Condition, bool) {
+	gates := config.Spec.Gates
+	if gates == nil {
+		return metav1.Condition{}, false
+	}
+
+	condition := metav1.Condition{
+		Type:               "Gated",
+		Status:             metav1.ConditionFalse,
+		LastTransitionTime: now,
+		Reason:             "GatesHold",
+		Message:            fmt.Sprintf("All %d gates hold", len(gates.Rules)),
+	}
+	state := r.gateStates[config.Name]
+	if state == nil {
+		return condition, true
+	}
+	if paused, message := gatePause(config, state); paused {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "GateViolated"
+		condition.Message = "Load generation paused: " + message
+	} else if len(state.failures) > 0 {
+		condition.Message = fmt.Sprintf("%d of %d gates hold, skipped: %s",
+			len(gates.Rules)-len(state.failures), len(gates.Rules), strings.Join(state.failures, "; "))
+	}
+	return condition, true
+}
+
+// setGatedCondition records a pause in status without touching the counts, which stay as they were
+// when generation paused
+func (r *ScaleLoadConfigReconciler) setGatedCondition(ctx context.Context, config *scalev1.ScaleLoadConfig) error {
+	condition, ok := r.gatedCondition(config, metav1.Now())
+	if !ok {
+		return nil
+	}
+
+	latestConfig := &scalev1.ScaleLoadConfig{}
+	if err := r.Get(ctx, types.NamespacedName{Name: config.Name}, latestConfig); err != nil {
+		return fmt.Errorf("failed to fetch latest ScaleLoadConfig: %w", err)
+	}
+	original := latestConfig.DeepCopy()
+	meta.SetStatusCondition(&latestConfig.Status.Conditions, condition)
+	if equality.Semantic.DeepEqual(original.Status, latestConfig.Status) {
+		return nil
+	}
+	if err := r.Status().Patch(ctx, latestConfig, client.MergeFrom(original)); err != nil {
+		return fmt.Errorf("failed to patch Gated condition: %w", err)
+	}
+	return nil
+}
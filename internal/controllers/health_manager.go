@@ -0,0 +1,263 @@
+package controllers
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+const (
+	// stuckTerminatingThreshold is how long a namespace may stay Terminating before it counts as stuck
+	stuckTerminatingThreshold = 10 * time.Minute
+	// sustainedThrottleThreshold is how long throttling must last before the config is degraded
+	sustainedThrottleThreshold = 2 * time.Minute
+	// nodeConflictWindow is how long node update conflicts are counted before the counts start over
+	nodeConflictWindow = 10 * time.Minute
+	// minNodeUpdateAttempts is how many node update attempts a window needs before its conflict rate counts
+	minNodeUpdateAttempts = 10
+	// minContendedNodeAttempts is how many attempts a single node needs before it can count as contended
+	minContendedNodeAttempts = 3
+)
+
+// configHealth holds the health signals observed for a config during recent reconciles
+type configHealth struct {
+	stuckNamespaces  []string
+	nodeVerification scalev1.NodeVerificationStatus
+	unavailableAPIs  map[string]bool
+	throttledSince   time.Time
+
+	// nodeUpdates counts node annotation update attempts and conflicts per node since nodeUpdatesSince
+	nodeUpdates           map[string]*nodeUpdateStats
+	nodeUpdatesSince      time.Time
+	conflictRateThreshold int32
+}
+
+// nodeUpdateStats counts the update attempts made on one node and how many of them conflicted
+type nodeUpdateStats struct {
+	attempts  int
+	conflicts int
+}
+
+// degradedSignal is one reason a config is degraded; the first signal found sets the condition reason
+type degradedSignal struct {
+	reason  string
+	message string
+}
+
+// configHealthFor returns the health record of a config, creating it if needed; callers hold healthMutex
+func (r *ScaleLoadConfigReconciler) configHealthFor(configName string) *configHealth {
+	if r.health == nil {
+		r.health = make(map[string]*configHealth)
+	}
+	health, exists := r.health[configName]
+	if !exists {
+		health = &configHealth{unavailableAPIs: make(map[string]bool)}
+		r.health[configName] = health
+	}
+	return health
+}
+
+// recordStuckNamespaces remembers the terminating namespaces that have been deleting for too long
+func (r *ScaleLoadConfigReconciler) recordStuckNamespaces(configName string, terminating []corev1.Namespace) {
+	var stuck []string
+	for _, ns := range terminating {
+		if ns.DeletionTimestamp != nil && time.Since(ns.DeletionTimestamp.Time) > stuckTerminatingThreshold {
+			stuck = append(stuck, ns.Name)
+		}
+	}
+	sort.Strings(stuck)
+
+	r.healthMutex.Lock()
+	defer r.healthMutex.Unlock()
+	r.configHealthFor(configName).stuckNamespaces = stuck
+}
+
+// recordNodeVerification remembers how many selected nodes carried KWOK markers
+func (r *ScaleLoadConfigReconciler) recordNodeVerification(configName string, verification scalev1.NodeVerificationStatus) {
+	r.healthMutex.Lock()
+	defer r.healthMutex.Unlock()
+	r.configHealthFor(configName).nodeVerification = verification
+}
+
+// nodeVerification returns the latest node verification of a config for status
+func (r *ScaleLoadConfigReconciler) nodeVerification(configName string) scalev1.NodeVerificationStatus {
+	r.healthMutex.Lock()
+	defer r.healthMutex.Unlock()
+	if health := r.health[configName]; health != nil {
+		return health.nodeVerification
+	}
+	return scalev1.NodeVerificationStatus{}
+}
+
+// setAPIAvailable records whether an optional API a feature depends on is served by the cluster.
+// Resource managers run concurrently, so this takes healthMutex
+func (r *ScaleLoadConfigReconciler) setAPIAvailable(configName, api string, available bool) {
+	r.healthMutex.Lock()
+	defer r.healthMutex.Unlock()
+	health := r.configHealthFor(configName)
+	if available {
+		delete(health.unavailableAPIs, api)
+	} else {
+		health.unavailableAPIs[api] = true
+	}
+}
+
+// setThrottled records whether the latest reconcile was throttled, keeping the start of the streak
+func (r *ScaleLoadConfigReconciler) setThrottled(configName string, throttled bool) {
+	r.healthMutex.Lock()
+	defer r.healthMutex.Unlock()
+	health := r.configHealthFor(configName)
+	switch {
+	case !throttled:
+		health.throttledSince = time.Time{}
+	case health.throttledSince.IsZero():
+		health.throttledSince = time.Now()
+	}
+}
+
+// recordNodeUpdateAttempt counts one node annotation update attempt and whether it conflicted
+func (r *ScaleLoadConfigReconciler) recordNodeUpdateAttempt(config *scalev1.ScaleLoadConfig, nodeName string, conflicted bool) {
+	if conflicted && r.NodeUpdateConflicts != nil {
+		r.NodeUpdateConflicts.WithLabelValues(nodeName).Inc()
+	}
+
+	r.healthMutex.Lock()
+	defer r.healthMutex.Unlock()
+	health := r.configHealthFor(config.Name)
+	health.conflictRateThreshold = config.Spec.AnnotationChurn.ConflictRateThreshold
+	if health.nodeUpdates == nil || time.Since(health.nodeUpdatesSince) > nodeConflictWindow {
+		health.nodeUpdates = make(map[string]*nodeUpdateStats)
+		health.nodeUpdatesSince = time.Now()
+	}
+	stats, exists := health.nodeUpdates[nodeName]
+	if !exists {
+		stats = &nodeUpdateStats{}
+		health.nodeUpdates[nodeName] = stats
+	}
+	stats.attempts++
+	if conflicted {
+		stats.conflicts++
+	}
+}
+
+// nodeContended reports whether most recent updates of a node conflicted, so retrying it
+// would only keep fighting whichever controller also writes it
+func (r *ScaleLoadConfigReconciler) nodeContended(configName, nodeName string) bool {
+	r.healthMutex.Lock()
+	defer r.healthMutex.Unlock()
+	health := r.health[configName]
+	if health == nil || time.Since(health.nodeUpdatesSince) > nodeConflictWindow {
+		return false
+	}
+	stats := health.nodeUpdates[nodeName]
+	return stats != nil && stats.attempts >= minContendedNodeAttempts &&
+		conflictRateExceeded(stats.conflicts, stats.attempts, health.conflictRateThreshold)
+}
+
+// conflictRateExceeded reports whether conflicts make up at least threshold percent of attempts
+func conflictRateExceeded(conflicts, attempts int, threshold int32) bool {
+	if threshold <= 0 {
+		threshold = 50
+	}
+	return attempts > 0 && conflicts*100 >= int(threshold)*attempts
+}
+
+// degradedSignals returns the active health problems of a config, most severe first
+func (r *ScaleLoadConfigReconciler) degradedSignals(configName string) []degradedSignal {
+	var signals []degradedSignal
+
+	r.healthMutex.Lock()
+	health := r.health[configName]
+	var stuck, apis []string
+	var verification scalev1.NodeVerificationStatus
+	var throttledSince time.Time
+	var attempts, conflicts int
+	var threshold int32
+	var conflicting []string
+	if health != nil {
+		stuck = health.stuckNamespaces
+		verification = health.nodeVerification
+		for api := range health.unavailableAPIs {
+			apis = append(apis, api)
+		}
+		throttledSince = health.throttledSince
+		if time.Since(health.nodeUpdatesSince) <= nodeConflictWindow {
+			threshold = health.conflictRateThreshold
+			for node, stats := range health.nodeUpdates {
+				attempts += stats.attempts
+				conflicts += stats.conflicts
+				if stats.conflicts > 0 {
+					conflicting = append(conflicting, node)
+				}
+			}
+			sort.Slice(conflicting, func(i, j int) bool {
+				a, b := health.nodeUpdates[conflicting[i]], health.nodeUpdates[conflicting[j]]
+				if a.conflicts != b.conflicts {
+					return a.conflicts > b.conflicts
+				}
+				return conflicting[i] < conflicting[j]
+			})
+		}
+	}
+	r.healthMutex.Unlock()
+
+	if len(stuck) > 0 {
+		signals = append(signals, degradedSignal{
+			reason: "NamespacesStuckTerminating",
+			message: fmt.Sprintf("%d namespaces Terminating for more than %s: %s",
+				len(stuck), stuckTerminatingThreshold, strings.Join(truncateNames(stuck, 5), ", ")),
+		})
+	}
+	if verification.RejectedNodes > 0 {
+		examples := verification.RejectedExamples
+		if more := int(verification.RejectedNodes) - len(examples); more > 0 {
+			examples = append(append([]string{}, examples...), fmt.Sprintf("and %d more", more))
+		}
+		signals = append(signals, degradedSignal{
+			reason: "RealNodesSelected",
+			message: fmt.Sprintf("kwokNodeSelector matches %d of %d nodes without KWOK markers, which are left untouched; narrow the selector or set allowRealNodes: %s",
+				verification.RejectedNodes, verification.MatchedNodes, strings.Join(examples, ", ")),
+		})
+	}
+	if len(apis) > 0 {
+		sort.Strings(apis)
+		signals = append(signals, degradedSignal{
+			reason:  "MissingAPIs",
+			message: fmt.Sprintf("Resource types disabled because their APIs are not served: %s", strings.Join(apis, ", ")),
+		})
+	}
+	if denied := r.deniedFeatures(configName); len(denied) > 0 {
+		signals = append(signals, degradedSignal{
+			reason:  "InsufficientPermissions",
+			message: fmt.Sprintf("Features disabled due to missing RBAC permissions: %s", strings.Join(denied, ", ")),
+		})
+	}
+	if !throttledSince.IsZero() && time.Since(throttledSince) > sustainedThrottleThreshold {
+		signals = append(signals, degradedSignal{
+			reason:  "SustainedThrottling",
+			message: fmt.Sprintf("Reconciles throttled for %s to stay within the target API rate", time.Since(throttledSince).Round(time.Second)),
+		})
+	}
+	if attempts >= minNodeUpdateAttempts && conflictRateExceeded(conflicts, attempts, threshold) {
+		signals = append(signals, degradedSignal{
+			reason: "NodeUpdateConflicts",
+			message: fmt.Sprintf("%d of %d node annotation updates in the last %s hit conflicts, another controller may be writing the same nodes: %s",
+				conflicts, attempts, nodeConflictWindow, strings.Join(truncateNames(conflicting, 5), ", ")),
+		})
+	}
+	return signals
+}
+
+// truncateNames keeps the first limit names, noting how many were left out
+func truncateNames(names []string, limit int) []string {
+	if len(names) <= limit {
+		return names
+	}
+	truncated := append([]string{}, names[:limit]...)
+	return append(truncated, fmt.Sprintf("and %d more", len(names)-limit))
+}
@@ -0,0 +1,67 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// reconcileTimeout ends a reconcile that runs away
+	reconcileTimeout = 5 * time.Minute
+	// stalledReconcileAfter is how long a reconcile may run before it counts as hung; the timeout
+	// should have ended it well before, unless a call ignores its context
+	stalledReconcileAfter = 2 * reconcileTimeout
+	// probeTimeout bounds the work a single probe does
+	probeTimeout = 5 * time.Second
+)
+
+// CheckReconciler is a liveness check that fails while a reconcile has been running for longer than
+// stalledReconcileAfter, so a hung generator is restarted instead of idling through a test window
+func (r *ScaleLoadConfigReconciler) CheckReconciler(_ *http.Request) error {
+	started := r.reconcileStarted.Load()
+	if started == 0 {
+		return nil
+	}
+	if running := time.Since(time.Unix(0, started)); running > stalledReconcileAfter {
+		return fmt.Errorf("reconcile has been running for %s", running.Round(time.Second))
+	}
+	return nil
+}
+
+// CheckPacer is a liveness check that fails when the request pacer cannot be read within the probe
+// timeout, which means a write holds it and every other write is stuck behind it
+func (r *ScaleLoadConfigReconciler) CheckPacer(_ *http.Request) error {
+	if r.pacer == nil {
+		return nil
+	}
+	if !r.pacer.responsive(probeTimeout) {
+		return fmt.Errorf("request pacer did not respond within %s", probeTimeout)
+	}
+	return nil
+}
+
+// CheckNodeAccess is a readiness check that lists a node straight from the apiserver. Deployments
+// without RBAC to list nodes, such as Namespaced ones, do not need them and pass
+func (r *ScaleLoadConfigReconciler) CheckNodeAccess(req *http.Request) error {
+	if r.APIReader == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), probeTimeout)
+	defer cancel()
+	if err := r.APIReader.List(ctx, &corev1.NodeList{}, client.Limit(1)); err != nil && !errors.IsForbidden(err) {
+		return fmt.Errorf("failed to list nodes: %w", err)
+	}
+	return nil
+}
+
+// markReconcileStarted records that a reconcile is running; the returned function marks it finished
+func (r *ScaleLoadConfigReconciler) markReconcileStarted(start time.Time) func() {
+	r.reconcileStarted.Store(start.UnixNano())
+	return func() { r.reconcileStarted.Store(0) }
+}
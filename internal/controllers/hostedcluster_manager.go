@@ -0,0 +1,56 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+var hostedClusterGVK = schema.GroupVersionKind{
+	Group:   "hypershift.openshift.io",
+	Version: "v1beta1",
+	Kind:    "HostedCluster",
+}
+
+// resolveKubeconfigSecretRef returns the kubeconfig secret for a target cluster, looking up
+// the admin kubeconfig published by HyperShift for hosted cluster targets. The admin kubeconfig is
+// published beside the HostedCluster, so its namespace must be one kubeconfigs may be read from
+func (r *ScaleLoadConfigReconciler) resolveKubeconfigSecretRef(ctx context.Context, config *scalev1.ScaleLoadConfig,
+	target scalev1.TargetCluster) (scalev1.KubeconfigSecretReference, error) {
+
+	if target.KubeconfigSecretRef != nil {
+		return *target.KubeconfigSecretRef, nil
+	}
+	if target.HostedClusterRef == nil {
+		return scalev1.KubeconfigSecretReference{}, fmt.Errorf("target cluster %q has no kubeconfigSecretRef or hostedClusterRef", target.Name)
+	}
+
+	ref := target.HostedClusterRef
+	if !r.kubeconfigAllowed(ref.Namespace) {
+		return scalev1.KubeconfigSecretReference{}, fmt.Errorf("HostedCluster %s/%s is outside the operator namespace and --kubeconfig-namespaces",
+			ref.Namespace, ref.Name)
+	}
+	hostedCluster := &unstructured.Unstructured{}
+	hostedCluster.SetGroupVersionKind(hostedClusterGVK)
+	if err := r.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, hostedCluster); err != nil {
+		return scalev1.KubeconfigSecretReference{}, fmt.Errorf("failed to get HostedCluster %s/%s: %w", ref.Namespace, ref.Name, err)
+	}
+	r.recordAPICall(config, 1)
+
+	// HyperShift publishes the admin kubeconfig secret in the HostedCluster namespace once the control plane is up
+	secretName, found, _ := unstructured.NestedString(hostedCluster.Object, "status", "kubeconfig", "name")
+	if !found || secretName == "" {
+		return scalev1.KubeconfigSecretReference{}, fmt.Errorf("HostedCluster %s/%s has not published a kubeconfig yet", ref.Namespace, ref.Name)
+	}
+
+	return scalev1.KubeconfigSecretReference{
+		Namespace: ref.Namespace,
+		Name:      secretName,
+		Key:       "kubeconfig",
+	}, nil
+}
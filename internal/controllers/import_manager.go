@@ -0,0 +1,184 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+// applyImports adds the generation data of the config's imports to its spec. Imports are read on
+// every reconcile, so edits to the referenced objects are picked up without touching the config
+func (r *ScaleLoadConfigReconciler) applyImports(ctx context.Context, config *scalev1.ScaleLoadConfig) error {
+	if len(config.Spec.Imports) == 0 {
+		return nil
+	}
+	log := r.Log.WithName("import-manager").WithValues("config", config.Name)
+
+	var documents int
+	for _, imp := range config.Spec.Imports {
+		if !r.importAllowed(imp.Namespace) {
+			return fmt.Errorf("import %s is outside the operator namespace and the allowed import namespaces", importRef(imp))
+		}
+		data, err := r.importData(ctx, config, imp)
+		if err != nil {
+			return err
+		}
+
+		for _, key := range importKeys(imp, data) {
+			value, ok := data[key]
+			if !ok {
+				if imp.Optional {
+					continue
+				}
+				return fmt.Errorf("import %s has no key %s", importRef(imp), key)
+			}
+			imported, err := decodeImportedData(value)
+			if err != nil {
+				return fmt.Errorf("failed to decode key %s of import %s: %w", key, importRef(imp), err)
+			}
+			for i := range imported {
+				if err := imported[i].Validate(); err != nil {
+					return fmt.Errorf("key %s of import %s: %w", key, importRef(imp), err)
+				}
+				mergeImportedData(&config.Spec, &imported[i])
+			}
+			documents += len(imported)
+		}
+	}
+	if err := config.ValidateGenerationData(); err != nil {
+		return fmt.Errorf("imports leave the config invalid: %w", err)
+	}
+
+	log.V(1).Info("Applied imports", "imports", len(config.Spec.Imports), "documents", documents)
+	return nil
+}
+
+// importAllowed reports whether imports may read from a namespace: the operator's own, or one
+// listed in ImportNamespaces. Configs are cluster-scoped, so reading any namespace would let a
+// config author pull in data they cannot read themselves
+func (r *ScaleLoadConfigReconciler) importAllowed(namespace string) bool {
+	if namespace == r.operatorNamespace() {
+		return true
+	}
+	return slices.Contains(r.ImportNamespaces, namespace)
+}
+
+// importData returns the data of an import's ConfigMap or Secret, or nil for a missing optional import
+func (r *ScaleLoadConfigReconciler) importData(ctx context.Context, config *scalev1.ScaleLoadConfig,
+	imp scalev1.ConfigImport) (map[string][]byte, error) {
+
+	key := types.NamespacedName{Namespace: imp.Namespace, Name: imp.Name}
+	var data map[string][]byte
+	var err error
+	if imp.Kind == "Secret" {
+		secret := &corev1.Secret{}
+		if err = r.Get(ctx, key, secret); err == nil {
+			data = secret.Data
+		}
+	} else {
+		configMap := &corev1.ConfigMap{}
+		if err = r.Get(ctx, key, configMap); err == nil {
+			data = make(map[string][]byte, len(configMap.Data)+len(configMap.BinaryData))
+			for k, v := range configMap.BinaryData {
+				data[k] = v
+			}
+			for k, v := range configMap.Data {
+				data[k] = []byte(v)
+			}
+		}
+	}
+	r.recordAPICall(config, 1)
+
+	if errors.IsNotFound(err) && imp.Optional {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get import %s: %w", importRef(imp), err)
+	}
+	return data, nil
+}
+
+// importKeys returns the keys an import reads: the listed ones, or every key in name order
+func importKeys(imp scalev1.ConfigImport, data map[string][]byte) []string {
+	if len(imp.Keys) > 0 {
+		if data == nil {
+			return nil
+		}
+		return imp.Keys
+	}
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// importRef names an import in errors, e.g. ConfigMap sim-config/event-catalog
+func importRef(imp scalev1.ConfigImport) string {
+	kind := imp.Kind
+	if kind == "" {
+		kind = "ConfigMap"
+	}
+	return kind + " " + imp.Namespace + "/" + imp.Name
+}
+
+// decodeImportedData decodes every YAML or JSON document in value, skipping empty ones
+func decodeImportedData(value []byte) ([]scalev1.ImportedGenerationData, error) {
+	decoder := utilyaml.NewYAMLOrJSONDecoder(bytes.NewReader(value), 4096)
+	var documents []scalev1.ImportedGenerationData
+	for {
+		var document scalev1.ImportedGenerationData
+		if err := decoder.Decode(&document); err != nil {
+			if err == io.EOF {
+				return documents, nil
+			}
+			return nil, fmt.Errorf("document %d: %w", len(documents)+1, err)
+		}
+		documents = append(documents, document)
+	}
+}
+
+// mergeImportedData adds an imported document to the spec. Archetypes and profiles named like one
+// already in the spec, and annotations the spec already sets, are left as the spec has them
+func mergeImportedData(spec *scalev1.ScaleLoadConfigSpec, imported *scalev1.ImportedGenerationData) {
+	events := &spec.ResourceChurn.Events
+	events.EventTypes = append(events.EventTypes, imported.EventTypes...)
+
+	if len(imported.NamespaceAnnotations) > 0 && spec.NamespaceConfig.Annotations == nil {
+		spec.NamespaceConfig.Annotations = make(map[string]string, len(imported.NamespaceAnnotations))
+	}
+	for key, value := range imported.NamespaceAnnotations {
+		if _, exists := spec.NamespaceConfig.Annotations[key]; !exists {
+			spec.NamespaceConfig.Annotations[key] = value
+		}
+	}
+
+	for _, key := range imported.NamespaceAnnotationKeys {
+		if !slices.Contains(spec.NamespaceAnnotationChurn.Keys, key) {
+			spec.NamespaceAnnotationChurn.Keys = append(spec.NamespaceAnnotationChurn.Keys, key)
+		}
+	}
+
+	for _, archetype := range imported.NamespaceArchetypes {
+		if !slices.ContainsFunc(spec.NamespaceArchetypes, func(a scalev1.NamespaceArchetype) bool { return a.Name == archetype.Name }) {
+			spec.NamespaceArchetypes = append(spec.NamespaceArchetypes, archetype)
+		}
+	}
+
+	for _, profile := range imported.CustomProfiles {
+		if !slices.ContainsFunc(spec.CustomProfiles, func(p scalev1.ProfileDefinition) bool { return p.Name == profile.Name }) {
+			spec.CustomProfiles = append(spec.CustomProfiles, profile)
+		}
+	}
+}
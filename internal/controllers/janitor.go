@@ -0,0 +1,361 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"golang.org/x/time/rate"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+// deploymentRevisionAnnotation is the revision the Deployment controller stamps on each ReplicaSet
+const deploymentRevisionAnnotation = "deployment.kubernetes.io/revision"
+
+// janitor prunes one config's expired events, terminated pods and old workload revisions in the
+// background. Like the lease simulator, it outlives the reconcile that started it and is restarted
+// on spec changes
+type janitor struct {
+	cancel context.CancelFunc
+	spec   scalev1.JanitorConfig
+}
+
+// janitorSweep counts what one sweep deleted, by kind
+type janitorSweep struct {
+	events, pods, replicaSets, controllerRevisions int
+}
+
+func (s janitorSweep) total() int {
+	return s.events + s.pods + s.replicaSets + s.controllerRevisions
+}
+
+// syncJanitor starts, restarts or stops the janitor of a config to match its spec
+func (r *ScaleLoadConfigReconciler) syncJanitor(config *scalev1.ScaleLoadConfig) {
+	r.janitorMutex.Lock()
+	defer r.janitorMutex.Unlock()
+
+	current, exists := r.janitors[config.Name]
+	if !config.Spec.Janitor.Enabled {
+		if exists {
+			current.cancel()
+			delete(r.janitors, config.Name)
+		}
+		return
+	}
+	if exists && current.spec == config.Spec.Janitor {
+		return
+	}
+
+	if exists {
+		current.cancel()
+	}
+	if r.janitors == nil {
+		r.janitors = make(map[string]*janitor)
+	}
+	r.janitors[config.Name] = r.startJanitor(config)
+	r.Log.WithName("janitor").Info("Started janitor", "config", config.Name,
+		"interval", config.Spec.Janitor.IntervalSeconds, "deletesPerSecond", config.Spec.Janitor.DeletesPerSecond)
+}
+
+// stopJanitor stops pruning the objects of a config
+func (r *ScaleLoadConfigReconciler) stopJanitor(configName string) {
+	r.janitorMutex.Lock()
+	defer r.janitorMutex.Unlock()
+	if current, exists := r.janitors[configName]; exists {
+		current.cancel()
+		delete(r.janitors, configName)
+	}
+}
+
+// startJanitor launches the sweep loop. Sweeps run back to back with the interval between them,
+// so a sweep held back by the delete rate never overlaps the next one
+func (r *ScaleLoadConfigReconciler) startJanitor(config *scalev1.ScaleLoadConfig) *janitor {
+	// The janitor outlives the reconcile that started it, so it gets its own context
+	ctx, cancel := context.WithCancel(withRunID(context.Background(), config.Status.RunID))
+	snapshot := config.DeepCopy()
+	current := &janitor{cancel: cancel, spec: config.Spec.Janitor}
+
+	interval := time.Duration(snapshot.Spec.Janitor.IntervalSeconds) * time.Second
+	if interval <= 0 {
+		interval = 5 * time.Minute
+	}
+	deletesPerSecond := snapshot.Spec.Janitor.DeletesPerSecond
+	if deletesPerSecond <= 0 {
+		deletesPerSecond = 5
+	}
+	limiter := rate.NewLimiter(rate.Limit(deletesPerSecond), 1)
+
+	go func() {
+		log := r.Log.WithName("janitor").WithValues("config", snapshot.Name)
+		for {
+			sweep, err := r.sweepExpiredObjects(ctx, snapshot, limiter)
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				// A failed sweep is retried on the next interval
+				log.Error(err, "Janitor sweep failed")
+			}
+			if sweep.total() > 0 {
+				log.Info("Pruned expired objects", "events", sweep.events, "pods", sweep.pods,
+					"replicaSets", sweep.replicaSets, "controllerRevisions", sweep.controllerRevisions)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(interval):
+			}
+		}
+	}()
+	return current
+}
+
+// sweepExpiredObjects prunes every kind with a TTL in the config's managed namespaces, and the
+// system events namespace when system events are generated
+func (r *ScaleLoadConfigReconciler) sweepExpiredObjects(ctx context.Context, config *scalev1.ScaleLoadConfig,
+	limiter *rate.Limiter) (janitorSweep, error) {
+
+	var sweep janitorSweep
+	spec := config.Spec.Janitor
+
+	managed, err := r.getManagedNamespaces(ctx, config)
+	if err != nil {
+		return sweep, fmt.Errorf("failed to list managed namespaces: %w", err)
+	}
+	r.recordAPICall(config, 1)
+	namespaces := make([]string, 0, len(managed)+1)
+	for _, namespace := range managed {
+		if !namespaceTerminating(namespace) {
+			namespaces = append(namespaces, namespace.Name)
+		}
+	}
+	if events := config.Spec.ResourceChurn.Events; events.Enabled && events.SystemEvents.Enabled && !isNamespaceScoped(config) {
+		systemNamespace := events.SystemEvents.Namespace
+		if systemNamespace == "" {
+			systemNamespace = "default"
+		}
+		namespaces = append(namespaces, systemNamespace)
+	}
+
+	for _, namespace := range namespaces {
+		if spec.EventTTLSeconds > 0 {
+			deleted, err := r.pruneExpiredEvents(ctx, config, limiter, namespace, time.Duration(spec.EventTTLSeconds)*time.Second)
+			sweep.events += deleted
+			if err != nil {
+				return sweep, err
+			}
+		}
+		if spec.CompletedPodTTLSeconds > 0 {
+			deleted, err := r.pruneTerminatedPods(ctx, config, limiter, namespace, time.Duration(spec.CompletedPodTTLSeconds)*time.Second)
+			sweep.pods += deleted
+			if err != nil {
+				return sweep, err
+			}
+		}
+		if spec.RevisionTTLSeconds > 0 {
+			replicaSets, controllerRevisions, err := r.pruneOldRevisions(ctx, config, limiter, namespace,
+				time.Duration(spec.RevisionTTLSeconds)*time.Second)
+			sweep.replicaSets += replicaSets
+			sweep.controllerRevisions += controllerRevisions
+			if err != nil {
+				return sweep, err
+			}
+		}
+	}
+	return sweep, nil
+}
+
+// janitorReader lists straight from the API server when it can, so the janitor does not start
+// informers for ReplicaSets and ControllerRevisions the controller never watches
+func (r *ScaleLoadConfigReconciler) janitorReader() client.Reader {
+	if r.APIReader != nil {
+		return r.APIReader
+	}
+	return r.Client
+}
+
+// pruneExpiredEvents deletes the config's events in the namespace that were created and last seen before the TTL
+func (r *ScaleLoadConfigReconciler) pruneExpiredEvents(ctx context.Context, config *scalev1.ScaleLoadConfig,
+	limiter *rate.Limiter, namespace string, ttl time.Duration) (int, error) {
+
+	events := &corev1.EventList{}
+	if err := r.janitorReader().List(ctx, events, client.InNamespace(namespace), client.MatchingLabels{
+		"scale.openshift.io/managed-by":    config.Name,
+		"scale.openshift.io/resource-type": "event",
+	}); err != nil {
+		return 0, fmt.Errorf("failed to list events in %s: %w", namespace, err)
+	}
+	r.recordAPICall(config, 1)
+
+	deleted := 0
+	for i := range events.Items {
+		event := &events.Items[i]
+		seen := event.CreationTimestamp.Time
+		if event.LastTimestamp.After(seen) {
+			seen = event.LastTimestamp.Time
+		}
+		if time.Since(seen) < ttl {
+			continue
+		}
+		if err := r.janitorDelete(ctx, config, limiter, event); err != nil {
+			return deleted, err
+		}
+		deleted++
+	}
+	return deleted, nil
+}
+
+// pruneTerminatedPods deletes the config's pods in the namespace that Succeeded or Failed before the TTL.
+// Completed pods are skipped: resourceChurn.completedPods holds their buildup on purpose and clears it itself
+func (r *ScaleLoadConfigReconciler) pruneTerminatedPods(ctx context.Context, config *scalev1.ScaleLoadConfig,
+	limiter *rate.Limiter, namespace string, ttl time.Duration) (int, error) {
+
+	pods := &corev1.PodList{}
+	if err := r.janitorReader().List(ctx, pods, client.InNamespace(namespace),
+		client.MatchingLabels{"scale.openshift.io/managed-by": config.Name}); err != nil {
+		return 0, fmt.Errorf("failed to list pods in %s: %w", namespace, err)
+	}
+	r.recordAPICall(config, 1)
+
+	deleted := 0
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.Labels["scale.openshift.io/resource-type"] == completedPodResourceType || pod.DeletionTimestamp != nil {
+			continue
+		}
+		if pod.Status.Phase != corev1.PodSucceeded && pod.Status.Phase != corev1.PodFailed {
+			continue
+		}
+		if time.Since(podFinishedAt(pod)) < ttl {
+			continue
+		}
+		if err := r.janitorDelete(ctx, config, limiter, pod); err != nil {
+			return deleted, err
+		}
+		deleted++
+	}
+	return deleted, nil
+}
+
+// podFinishedAt returns when the last container of a terminated pod finished, or its creation time
+// when no container reports it
+func podFinishedAt(pod *corev1.Pod) time.Time {
+	finished := pod.CreationTimestamp.Time
+	for _, status := range pod.Status.ContainerStatuses {
+		if terminated := status.State.Terminated; terminated != nil && terminated.FinishedAt.After(finished) {
+			finished = terminated.FinishedAt.Time
+		}
+	}
+	return finished
+}
+
+// pruneOldRevisions deletes the revisions generated Deployments and DaemonSets left behind: ReplicaSets
+// scaled to zero and ControllerRevisions other than the newest of their owner, once older than the TTL.
+// Both carry the managed-by label because their controllers copy the pod template labels onto them
+func (r *ScaleLoadConfigReconciler) pruneOldRevisions(ctx context.Context, config *scalev1.ScaleLoadConfig,
+	limiter *rate.Limiter, namespace string, ttl time.Duration) (int, int, error) {
+
+	labels := client.MatchingLabels{"scale.openshift.io/managed-by": config.Name}
+
+	replicaSets := &appsv1.ReplicaSetList{}
+	if err := r.janitorReader().List(ctx, replicaSets, client.InNamespace(namespace), labels); err != nil {
+		return 0, 0, fmt.Errorf("failed to list ReplicaSets in %s: %w", namespace, err)
+	}
+	r.recordAPICall(config, 1)
+
+	// The Deployment controller scales the newest ReplicaSet up, but a paused or stuck rollout can
+	// leave it at zero too, so the newest revision of each Deployment is kept regardless
+	newestReplicaSet := make(map[types.UID]int64)
+	for i := range replicaSets.Items {
+		owner := controllerUID(&replicaSets.Items[i])
+		newestReplicaSet[owner] = max(newestReplicaSet[owner], replicaSetRevision(&replicaSets.Items[i]))
+	}
+
+	replicaSetsDeleted := 0
+	for i := range replicaSets.Items {
+		replicaSet := &replicaSets.Items[i]
+		if replicaSet.DeletionTimestamp != nil || replicaSetRevision(replicaSet) >= newestReplicaSet[controllerUID(replicaSet)] {
+			continue
+		}
+		if replicaSet.Spec.Replicas == nil || *replicaSet.Spec.Replicas != 0 || replicaSet.Status.Replicas != 0 {
+			continue
+		}
+		if time.Since(replicaSet.CreationTimestamp.Time) < ttl {
+			continue
+		}
+		if err := r.janitorDelete(ctx, config, limiter, replicaSet); err != nil {
+			return replicaSetsDeleted, 0, err
+		}
+		replicaSetsDeleted++
+	}
+
+	revisions := &appsv1.ControllerRevisionList{}
+	if err := r.janitorReader().List(ctx, revisions, client.InNamespace(namespace), labels); err != nil {
+		return replicaSetsDeleted, 0, fmt.Errorf("failed to list ControllerRevisions in %s: %w", namespace, err)
+	}
+	r.recordAPICall(config, 1)
+
+	newestRevision := make(map[types.UID]int64)
+	for i := range revisions.Items {
+		owner := controllerUID(&revisions.Items[i])
+		newestRevision[owner] = max(newestRevision[owner], revisions.Items[i].Revision)
+	}
+
+	revisionsDeleted := 0
+	for i := range revisions.Items {
+		revision := &revisions.Items[i]
+		if revision.DeletionTimestamp != nil || revision.Revision >= newestRevision[controllerUID(revision)] {
+			continue
+		}
+		if time.Since(revision.CreationTimestamp.Time) < ttl {
+			continue
+		}
+		if err := r.janitorDelete(ctx, config, limiter, revision); err != nil {
+			return replicaSetsDeleted, revisionsDeleted, err
+		}
+		revisionsDeleted++
+	}
+	return replicaSetsDeleted, revisionsDeleted, nil
+}
+
+// controllerUID returns the UID of the object's controlling owner, or an empty UID for an unowned object
+func controllerUID(obj client.Object) types.UID {
+	for _, owner := range obj.GetOwnerReferences() {
+		if owner.Controller != nil && *owner.Controller {
+			return owner.UID
+		}
+	}
+	return ""
+}
+
+// replicaSetRevision returns the Deployment revision of a ReplicaSet, or 0 when it carries none
+func replicaSetRevision(replicaSet *appsv1.ReplicaSet) int64 {
+	revision, err := strconv.ParseInt(replicaSet.Annotations[deploymentRevisionAnnotation], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return revision
+}
+
+// janitorDelete waits for the janitor's own rate limit, then deletes the object. An object already
+// gone counts as deleted
+func (r *ScaleLoadConfigReconciler) janitorDelete(ctx context.Context, config *scalev1.ScaleLoadConfig,
+	limiter *rate.Limiter, obj client.Object) error {
+
+	if err := limiter.Wait(ctx); err != nil {
+		return err
+	}
+	if err := r.Delete(ctx, obj); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete expired %T %s/%s: %w", obj, obj.GetNamespace(), obj.GetName(), err)
+	}
+	r.recordAPICall(config, 1)
+	return nil
+}
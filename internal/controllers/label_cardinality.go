@@ -0,0 +1,89 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	mathrand "math/rand"
+	"sync"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+	"github.com/jtaleric/sim-operator/pkg/generator"
+)
+
+// labelStamper adds high-cardinality stress labels to objects created for configs that enable it
+type labelStamper struct {
+	mu      sync.RWMutex
+	configs map[string]scalev1.LabelCardinalityConfig
+}
+
+func newLabelStamper() *labelStamper {
+	return &labelStamper{configs: make(map[string]scalev1.LabelCardinalityConfig)}
+}
+
+// configure records the config's label cardinality settings for the objects created next
+func (s *labelStamper) configure(config *scalev1.ScaleLoadConfig) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !config.Spec.LabelCardinality.Enabled {
+		delete(s.configs, config.Name)
+		return
+	}
+	s.configs[config.Name] = config.Spec.LabelCardinality
+}
+
+// forget drops a deleted config's settings
+func (s *labelStamper) forget(configName string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.configs, configName)
+}
+
+// stamp adds LabelsPerObject stress labels to an object owned by a config that enables them.
+// Each key draws its value from UniqueValues choices, or a fresh value per object when it is 0
+func (s *labelStamper) stamp(obj client.Object) {
+	_, configName := orphanOwner(obj)
+	if configName == "" {
+		return
+	}
+	s.mu.RLock()
+	cardinality, ok := s.configs[configName]
+	s.mu.RUnlock()
+	if !ok {
+		return
+	}
+
+	prefix := cardinality.KeyPrefix
+	if prefix == "" {
+		prefix = "stress.scale.openshift.io/label-"
+	}
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	for i := int32(0); i < cardinality.LabelsPerObject; i++ {
+		value := generator.RandomString(12)
+		if cardinality.UniqueValues > 0 {
+			value = fmt.Sprintf("v%d", mathrand.Int31n(cardinality.UniqueValues))
+		}
+		labels[fmt.Sprintf("%s%d", prefix, i)] = value
+	}
+	obj.SetLabels(labels)
+}
+
+// labelStampingClient stamps stress labels on objects before they are created
+type labelStampingClient struct {
+	client.Client
+	stamper *labelStamper
+}
+
+// withLabelStamping wraps a client so objects it creates carry the stamper's stress labels
+func withLabelStamping(c client.Client, stamper *labelStamper) client.Client {
+	return &labelStampingClient{Client: c, stamper: stamper}
+}
+
+func (c *labelStampingClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	c.stamper.stamp(obj)
+	return c.Client.Create(ctx, obj, opts...)
+}
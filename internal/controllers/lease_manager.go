@@ -0,0 +1,268 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+	"github.com/jtaleric/sim-operator/pkg/generator"
+)
+
+const (
+	controllerLeaseResourceType = "controller-lease"
+	// defaultControllerLeaseNamespace holds the Leases when the spec names no namespace
+	defaultControllerLeaseNamespace = "sim-controller-leases"
+	// controllerLeaseNamespaceLabel marks a Lease namespace the operator created, with the config that created it
+	controllerLeaseNamespaceLabel = "scale.openshift.io/controller-leases"
+)
+
+// controllerLeaseResource describes the simulated controller Leases for teardown
+var controllerLeaseResource = managedResourceType{controllerLeaseResourceType,
+	func() client.ObjectList { return &coordinationv1.LeaseList{} }}
+
+// leaseSimulator renews the Leases of one config's simulated controllers in the background.
+// Like churn workers, it outlives the reconcile that started it and is restarted on spec changes
+type leaseSimulator struct {
+	cancel context.CancelFunc
+	spec   scalev1.ControllerLeasesConfig
+
+	mu sync.Mutex
+	// held is the number of Leases renewed during the last full round
+	held int
+}
+
+// syncControllerLeases starts, restarts or stops the lease simulator of a config to match its spec,
+// returning how many Leases it currently holds
+func (r *ScaleLoadConfigReconciler) syncControllerLeases(config *scalev1.ScaleLoadConfig) int {
+	r.leaseMutex.Lock()
+	defer r.leaseMutex.Unlock()
+
+	simulator, exists := r.leaseSimulators[config.Name]
+	if !config.Spec.ControllerLeases.Enabled {
+		if exists {
+			simulator.cancel()
+			delete(r.leaseSimulators, config.Name)
+		}
+		return 0
+	}
+	if exists && simulator.spec == config.Spec.ControllerLeases {
+		simulator.mu.Lock()
+		defer simulator.mu.Unlock()
+		return simulator.held
+	}
+
+	if exists {
+		simulator.cancel()
+	}
+	if r.leaseSimulators == nil {
+		r.leaseSimulators = make(map[string]*leaseSimulator)
+	}
+	r.leaseSimulators[config.Name] = r.startLeaseSimulator(config)
+	r.Log.WithName("lease-manager").Info("Started controller lease simulation", "config", config.Name,
+		"controllers", config.Spec.ControllerLeases.Controllers, "namespace", config.Spec.ControllerLeases.Namespace)
+	return 0
+}
+
+// stopControllerLeases stops renewing the Leases of a config; the Leases stay until they are deleted
+func (r *ScaleLoadConfigReconciler) stopControllerLeases(configName string) {
+	r.leaseMutex.Lock()
+	defer r.leaseMutex.Unlock()
+	if simulator, exists := r.leaseSimulators[configName]; exists {
+		simulator.cancel()
+		delete(r.leaseSimulators, configName)
+	}
+}
+
+// startLeaseSimulator launches the renewal loop, spacing renewals evenly over the renew interval
+// so the write rate is steady rather than a burst per interval
+func (r *ScaleLoadConfigReconciler) startLeaseSimulator(config *scalev1.ScaleLoadConfig) *leaseSimulator {
+	// The simulator outlives the reconcile that started it, so it gets its own context
+	ctx, cancel := context.WithCancel(withRunID(context.Background(), config.Status.RunID))
+	snapshot := config.DeepCopy()
+	simulator := &leaseSimulator{cancel: cancel, spec: config.Spec.ControllerLeases}
+
+	spec := &snapshot.Spec.ControllerLeases
+	if spec.Namespace == "" {
+		spec.Namespace = defaultControllerLeaseNamespace
+	}
+	if spec.LeaseDurationSeconds <= 0 {
+		spec.LeaseDurationSeconds = 15
+	}
+
+	controllers := int(spec.Controllers)
+	if controllers <= 0 {
+		controllers = 20
+	}
+	interval := time.Duration(spec.RenewIntervalSeconds) * time.Second
+	if interval <= 0 {
+		interval = 2 * time.Second
+	}
+	step := interval / time.Duration(controllers)
+
+	go func() {
+		log := r.Log.WithName("lease-manager").WithValues("config", snapshot.Name)
+		leases := make([]*coordinationv1.Lease, controllers)
+		namespaceReady := isNamespaceScoped(snapshot)
+		for {
+			// The operator may not create namespaces in Namespaced mode, so the namespace must exist there
+			if !namespaceReady {
+				if err := r.ensureControllerLeaseNamespace(ctx, snapshot); err != nil {
+					if ctx.Err() != nil {
+						return
+					}
+					log.V(1).Info("Failed to create controller lease namespace, retrying", "error", err.Error())
+				} else {
+					namespaceReady = true
+				}
+			}
+
+			held := 0
+			for i := range leases {
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(step):
+				}
+
+				lease, err := r.renewControllerLease(ctx, snapshot, i, leases[i])
+				if err != nil {
+					if ctx.Err() != nil {
+						return
+					}
+					// Drop the cached copy so the next round starts from the stored Lease
+					leases[i] = nil
+					log.V(1).Info("Failed to renew controller lease, retrying next round", "index", i, "error", err.Error())
+					continue
+				}
+				leases[i] = lease
+				held++
+			}
+			simulator.mu.Lock()
+			simulator.held = held
+			simulator.mu.Unlock()
+		}
+	}()
+	return simulator
+}
+
+// renewControllerLease renews one simulated controller's Lease, fetching or creating it when there
+// is no cached copy, and returns the stored Lease for the next renewal
+func (r *ScaleLoadConfigReconciler) renewControllerLease(ctx context.Context, config *scalev1.ScaleLoadConfig,
+	index int, cached *coordinationv1.Lease) (*coordinationv1.Lease, error) {
+	spec := config.Spec.ControllerLeases
+	now := metav1.NewMicroTime(time.Now())
+
+	lease := cached
+	if lease == nil {
+		name := fmt.Sprintf("sim-%s-controller-%d", configNameHash(config.Name), index)
+		lease = &coordinationv1.Lease{}
+		err := r.Get(ctx, types.NamespacedName{Namespace: spec.Namespace, Name: name}, lease)
+		r.recordAPICall(config, 1)
+		if errors.IsNotFound(err) {
+			lease = r.generateControllerLease(config, name, now)
+			if err := r.createOrAdopt(ctx, config, lease); err != nil {
+				return nil, fmt.Errorf("failed to create controller lease %s/%s: %w", spec.Namespace, name, err)
+			}
+			r.recordAPICall(config, 1)
+			return lease, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get controller lease %s/%s: %w", spec.Namespace, name, err)
+		}
+	}
+
+	duration := spec.LeaseDurationSeconds
+	lease.Spec.LeaseDurationSeconds = &duration
+	lease.Spec.RenewTime = &now
+	if err := r.Update(ctx, lease); err != nil {
+		return nil, fmt.Errorf("failed to renew controller lease %s/%s: %w", lease.Namespace, lease.Name, err)
+	}
+	r.recordAPICall(config, 1)
+	return lease, nil
+}
+
+// generateControllerLease builds a Lease held by a simulated controller replica
+func (r *ScaleLoadConfigReconciler) generateControllerLease(config *scalev1.ScaleLoadConfig, name string, now metav1.MicroTime) *coordinationv1.Lease {
+	holder := fmt.Sprintf("%s_%s-%s", name, generator.RandomString(8), generator.RandomString(4))
+	duration := config.Spec.ControllerLeases.LeaseDurationSeconds
+	transitions := int32(0)
+	return &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: config.Spec.ControllerLeases.Namespace,
+			Labels: map[string]string{
+				"scale.openshift.io/managed-by":    config.Name,
+				"scale.openshift.io/resource-type": controllerLeaseResourceType,
+				"scale.openshift.io/created-by":    "sim-operator",
+			},
+		},
+		Spec: coordinationv1.LeaseSpec{
+			HolderIdentity:       &holder,
+			LeaseDurationSeconds: &duration,
+			AcquireTime:          &now,
+			RenewTime:            &now,
+			LeaseTransitions:     &transitions,
+		},
+	}
+}
+
+// ensureControllerLeaseNamespace creates the Lease namespace when it does not exist yet
+func (r *ScaleLoadConfigReconciler) ensureControllerLeaseNamespace(ctx context.Context, config *scalev1.ScaleLoadConfig) error {
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: config.Spec.ControllerLeases.Namespace,
+			Labels: map[string]string{
+				"scale.openshift.io/created-by": "sim-operator",
+				controllerLeaseNamespaceLabel:   config.Name,
+			},
+		},
+	}
+	if err := r.createOrAdopt(ctx, config, namespace); err != nil {
+		return fmt.Errorf("failed to create controller lease namespace %s: %w", namespace.Name, err)
+	}
+	r.recordAPICall(config, 1)
+	return nil
+}
+
+// cleanupControllerLeases stops the lease simulator of a config and deletes its Leases, then the
+// Lease namespace when the config created it and no other config keeps Leases there.
+// It returns how many Leases were deleted
+func (r *ScaleLoadConfigReconciler) cleanupControllerLeases(ctx context.Context, config *scalev1.ScaleLoadConfig) (int, error) {
+	r.stopControllerLeases(config.Name)
+	deleted, err := r.deleteResourceType(ctx, config, controllerLeaseResource)
+	if err != nil {
+		return deleted, err
+	}
+
+	namespaces := &corev1.NamespaceList{}
+	if err := r.List(ctx, namespaces, client.MatchingLabels{controllerLeaseNamespaceLabel: config.Name}); err != nil {
+		return deleted, fmt.Errorf("failed to list controller lease namespaces: %w", err)
+	}
+	r.recordAPICall(config, 1)
+	for i := range namespaces.Items {
+		namespace := &namespaces.Items[i]
+		remaining := &coordinationv1.LeaseList{}
+		if err := r.List(ctx, remaining, client.InNamespace(namespace.Name),
+			client.MatchingLabels{"scale.openshift.io/resource-type": controllerLeaseResourceType}); err != nil {
+			return deleted, fmt.Errorf("failed to list controller leases in %s: %w", namespace.Name, err)
+		}
+		r.recordAPICall(config, 1)
+		if len(remaining.Items) > 0 {
+			continue
+		}
+		if err := r.Delete(ctx, namespace); err != nil && !errors.IsNotFound(err) {
+			return deleted, fmt.Errorf("failed to delete controller lease namespace %s: %w", namespace.Name, err)
+		}
+		r.recordAPICall(config, 1)
+	}
+	return deleted, nil
+}
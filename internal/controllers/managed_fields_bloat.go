@@ -0,0 +1,157 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+// bloatFieldManagerPrefix is followed by the manager index to name each bloat field manager
+const bloatFieldManagerPrefix = "sim-bloat-"
+
+func init() {
+	RegisterResourceChurner(managedFieldsBloatChurner{})
+}
+
+// managedFieldsBloatChurner applies generated ConfigMaps and Secrets as a new field manager each
+// pass until every configured manager owns some of their fields. It creates no objects of its own
+type managedFieldsBloatChurner struct{}
+
+func (managedFieldsBloatChurner) Name() string { return "managedFieldsBloat" }
+
+func (managedFieldsBloatChurner) Enabled(config *scalev1.ScaleLoadConfig) bool {
+	return config.Spec.ManagedFieldsBloat.Enabled
+}
+
+func (managedFieldsBloatChurner) NamespaceInterval(config *scalev1.ScaleLoadConfig) int32 {
+	return config.Spec.ManagedFieldsBloat.NamespaceInterval
+}
+
+func (managedFieldsBloatChurner) NamespaceTargeting(*scalev1.ScaleLoadConfig) *scalev1.NamespaceTargeting {
+	return nil
+}
+
+func (managedFieldsBloatChurner) EnsureCount(ctx context.Context, r *ScaleLoadConfigReconciler,
+	config *scalev1.ScaleLoadConfig, namespace string) (int32, error) {
+	return r.bloatManagedFields(ctx, config, namespace)
+}
+
+// Churn is a no-op since bloatManagedFields applies the objects
+func (managedFieldsBloatChurner) Churn(context.Context, *ScaleLoadConfigReconciler, *scalev1.ScaleLoadConfig, string) error {
+	return nil
+}
+
+// Cleanup deletes nothing; the bloat annotations go away with the objects that carry them
+func (managedFieldsBloatChurner) Cleanup(context.Context, *ScaleLoadConfigReconciler, *scalev1.ScaleLoadConfig, string) (int, error) {
+	return 0, nil
+}
+
+// bloatManagedFields applies up to ObjectsPerNamespace generated ConfigMaps and Secrets in the
+// namespace as one more field manager each, returning how many objects were applied
+func (r *ScaleLoadConfigReconciler) bloatManagedFields(ctx context.Context, config *scalev1.ScaleLoadConfig, namespace string) (int32, error) {
+	bloat := config.Spec.ManagedFieldsBloat
+	labels := client.MatchingLabels{"scale.openshift.io/managed-by": config.Name}
+
+	configMaps := &corev1.ConfigMapList{}
+	if err := r.List(ctx, configMaps, client.InNamespace(namespace), labels); err != nil {
+		return 0, fmt.Errorf("failed to list configmaps for managedFields bloat: %w", err)
+	}
+	secrets := &corev1.SecretList{}
+	if err := r.List(ctx, secrets, client.InNamespace(namespace), labels); err != nil {
+		return 0, fmt.Errorf("failed to list secrets for managedFields bloat: %w", err)
+	}
+	r.recordAPICall(config, 2)
+
+	var targets []client.Object
+	for i := range configMaps.Items {
+		targets = append(targets, &configMaps.Items[i])
+	}
+	for i := range secrets.Items {
+		targets = append(targets, &secrets.Items[i])
+	}
+	// Spread passes over all generated objects instead of always applying the same ones
+	rand.Shuffle(len(targets), func(i, j int) { targets[i], targets[j] = targets[j], targets[i] })
+	if len(targets) > int(bloat.ObjectsPerNamespace) {
+		targets = targets[:bloat.ObjectsPerNamespace]
+	}
+
+	var applied int32
+	for _, obj := range targets {
+		if err := r.applyAsBloatManager(ctx, config, obj); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return applied, err
+		}
+		r.recordAPICall(config, 1)
+		applied++
+	}
+	return applied, nil
+}
+
+// applyAsBloatManager applies annotations owned only by the next bloat manager that has no
+// managedFields entry on the object yet, or by a random one once all of them have an entry
+func (r *ScaleLoadConfigReconciler) applyAsBloatManager(ctx context.Context, config *scalev1.ScaleLoadConfig, obj client.Object) error {
+	bloat := config.Spec.ManagedFieldsBloat
+	managers := bloat.FieldManagers
+	if managers <= 0 {
+		managers = 20
+	}
+	fields := bloat.FieldsPerManager
+	if fields <= 0 {
+		fields = 3
+	}
+
+	present := make(map[string]bool)
+	for _, entry := range obj.GetManagedFields() {
+		present[entry.Manager] = true
+	}
+	manager := fmt.Sprintf("%s%d", bloatFieldManagerPrefix, rand.Int31n(managers))
+	for i := int32(0); i < managers; i++ {
+		if name := fmt.Sprintf("%s%d", bloatFieldManagerPrefix, i); !present[name] {
+			manager = name
+			break
+		}
+	}
+
+	annotations := make(map[string]string, fields)
+	now := time.Now().Format(time.RFC3339)
+	for i := int32(0); i < fields; i++ {
+		annotations[fmt.Sprintf("scale.openshift.io/%s-field-%d", manager, i)] = now
+	}
+
+	var apply client.Object
+	objectMeta := metav1.ObjectMeta{Name: obj.GetName(), Namespace: obj.GetNamespace(), Annotations: annotations}
+	switch obj.(type) {
+	case *corev1.Secret:
+		apply = &corev1.Secret{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"}, ObjectMeta: objectMeta}
+	default:
+		apply = &corev1.ConfigMap{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"}, ObjectMeta: objectMeta}
+	}
+	resourceType := obj.GetLabels()["scale.openshift.io/resource-type"]
+	// The owner labels are applied as well so the write meter attributes the write to the config
+	apply.SetLabels(map[string]string{
+		"scale.openshift.io/managed-by":    config.Name,
+		"scale.openshift.io/resource-type": resourceType,
+	})
+
+	if err := r.Patch(ctx, apply, client.Apply, client.FieldOwner(manager)); err != nil {
+		return fmt.Errorf("failed to apply %s/%s as %s: %w", obj.GetNamespace(), obj.GetName(), manager, err)
+	}
+
+	if r.ManagedFieldsBytes != nil {
+		if data, err := json.Marshal(apply.GetManagedFields()); err == nil {
+			r.ManagedFieldsBytes.WithLabelValues(resourceType).Observe(float64(len(data)))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,206 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+const (
+	mirrorPodResourceType = "mirror-pod"
+	// defaultMirrorPodNamespace holds the mirror pods when the spec names no namespace
+	defaultMirrorPodNamespace = "sim-static-pods"
+	// mirrorPodNamespaceLabel marks a mirror pod namespace the operator created, with the config that created it
+	mirrorPodNamespaceLabel = "scale.openshift.io/mirror-pods"
+	// mirrorPodNodeLabel records the node a mirror pod stands for
+	mirrorPodNodeLabel = "scale.openshift.io/mirror-pod-node"
+)
+
+// mirrorPodResource describes the simulated mirror pods for teardown
+var mirrorPodResource = managedResourceType{mirrorPodResourceType,
+	func() client.ObjectList { return &corev1.PodList{} }}
+
+// mirrorPodComponents returns the configured static pod components, or the one OpenShift runs on every node
+func mirrorPodComponents(config *scalev1.ScaleLoadConfig) []string {
+	if len(config.Spec.MirrorPods.Components) > 0 {
+		return config.Spec.MirrorPods.Components
+	}
+	return []string{"kube-rbac-proxy-crio"}
+}
+
+// mirrorPodNamespace returns the namespace holding the config's mirror pods
+func mirrorPodNamespace(config *scalev1.ScaleLoadConfig) string {
+	if config.Spec.MirrorPods.Namespace != "" {
+		return config.Spec.MirrorPods.Namespace
+	}
+	return defaultMirrorPodNamespace
+}
+
+// manageMirrorPods keeps one mirror pod per component on every KWOK node and removes those of nodes
+// that are no longer selected, returning how many mirror pods exist. Mirror pods of deleted nodes
+// are removed by garbage collection, since each pod is owned by its node as the kubelet sets it up
+func (r *ScaleLoadConfigReconciler) manageMirrorPods(ctx context.Context, config *scalev1.ScaleLoadConfig, nodes []corev1.Node) (int, error) {
+	log := r.Log.WithName("mirror-pod-manager")
+	namespace := mirrorPodNamespace(config)
+
+	if err := r.ensureMirrorPodNamespace(ctx, config, namespace); err != nil {
+		return 0, err
+	}
+
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(namespace), client.MatchingLabels{
+		"scale.openshift.io/managed-by":    config.Name,
+		"scale.openshift.io/resource-type": mirrorPodResourceType,
+	}); err != nil {
+		return 0, fmt.Errorf("failed to list mirror pods: %w", err)
+	}
+	r.recordAPICall(config, 1)
+
+	existing := make(map[string]*corev1.Pod, len(pods.Items))
+	for i := range pods.Items {
+		existing[pods.Items[i].Name] = &pods.Items[i]
+	}
+
+	count, created := 0, 0
+	desired := make(map[string]bool)
+	for i := range nodes {
+		for _, component := range mirrorPodComponents(config) {
+			name := fmt.Sprintf("%s-%s", component, nodes[i].Name)
+			desired[name] = true
+			if _, ok := existing[name]; ok {
+				count++
+				continue
+			}
+			if err := r.createOrAdopt(ctx, config, r.generateMirrorPod(config, namespace, component, &nodes[i])); err != nil {
+				return count, fmt.Errorf("failed to create mirror pod %s/%s: %w", namespace, name, err)
+			}
+			r.recordAPICall(config, 1)
+			count++
+			created++
+		}
+	}
+
+	removed := 0
+	for name, pod := range existing {
+		if desired[name] {
+			continue
+		}
+		if err := r.Delete(ctx, pod); err != nil && !errors.IsNotFound(err) {
+			return count, fmt.Errorf("failed to delete mirror pod %s/%s: %w", namespace, name, err)
+		}
+		r.recordAPICall(config, 1)
+		removed++
+	}
+
+	if created > 0 || removed > 0 {
+		log.Info("Synced mirror pods", "namespace", namespace, "created", created, "removed", removed)
+	}
+	return count, nil
+}
+
+// generateMirrorPod builds the mirror pod the kubelet would post for a static pod: bound to its node,
+// owned by the node and carrying the kubernetes.io/config.* annotations that mark it as a mirror pod
+func (r *ScaleLoadConfigReconciler) generateMirrorPod(config *scalev1.ScaleLoadConfig, namespace, component string, node *corev1.Node) *corev1.Pod {
+	image := config.Spec.MirrorPods.Image
+	if image == "" {
+		image = "registry.redhat.io/ubi8/ubi-minimal:latest"
+	}
+	// The kubelet names the manifest hash in both annotations; a hash of the component is stable in the same way
+	hash := fmt.Sprintf("%016x", nodeNameHash(component+"/"+image))
+	controller := true
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%s", component, node.Name),
+			Namespace: namespace,
+			Labels: map[string]string{
+				"scale.openshift.io/managed-by":    config.Name,
+				"scale.openshift.io/resource-type": mirrorPodResourceType,
+				"scale.openshift.io/created-by":    "sim-operator",
+				mirrorPodNodeLabel:                 node.Name,
+				"app":                              component,
+			},
+			Annotations: map[string]string{
+				"kubernetes.io/config.mirror": hash,
+				"kubernetes.io/config.hash":   hash,
+				"kubernetes.io/config.source": "file",
+				"kubernetes.io/config.seen":   time.Now().UTC().Format(time.RFC3339Nano),
+			},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "v1",
+				Kind:       "Node",
+				Name:       node.Name,
+				UID:        node.UID,
+				Controller: &controller,
+			}},
+		},
+		Spec: corev1.PodSpec{
+			NodeName:    node.Name,
+			HostNetwork: true,
+			Tolerations: []corev1.Toleration{{Operator: corev1.TolerationOpExists}},
+			Containers: []corev1.Container{{
+				Name:            component,
+				Image:           image,
+				ImagePullPolicy: corev1.PullIfNotPresent,
+				Command:         []string{"sleep", "3600"},
+			}},
+		},
+	}
+}
+
+// ensureMirrorPodNamespace creates the mirror pod namespace when it does not exist yet
+func (r *ScaleLoadConfigReconciler) ensureMirrorPodNamespace(ctx context.Context, config *scalev1.ScaleLoadConfig, name string) error {
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				"scale.openshift.io/created-by": "sim-operator",
+				mirrorPodNamespaceLabel:         config.Name,
+			},
+		},
+	}
+	if err := r.createOrAdopt(ctx, config, namespace); err != nil {
+		return fmt.Errorf("failed to create mirror pod namespace %s: %w", name, err)
+	}
+	r.recordAPICall(config, 1)
+	return nil
+}
+
+// cleanupMirrorPods deletes a config's mirror pods, then the mirror pod namespace when the config
+// created it and no other config keeps mirror pods there. It returns how many pods were deleted
+func (r *ScaleLoadConfigReconciler) cleanupMirrorPods(ctx context.Context, config *scalev1.ScaleLoadConfig) (int, error) {
+	deleted, err := r.deleteResourceType(ctx, config, mirrorPodResource)
+	if err != nil {
+		return deleted, err
+	}
+
+	namespaces := &corev1.NamespaceList{}
+	if err := r.List(ctx, namespaces, client.MatchingLabels{mirrorPodNamespaceLabel: config.Name}); err != nil {
+		return deleted, fmt.Errorf("failed to list mirror pod namespaces: %w", err)
+	}
+	r.recordAPICall(config, 1)
+	for i := range namespaces.Items {
+		namespace := &namespaces.Items[i]
+		remaining := &corev1.PodList{}
+		if err := r.List(ctx, remaining, client.InNamespace(namespace.Name),
+			client.MatchingLabels{"scale.openshift.io/resource-type": mirrorPodResourceType}); err != nil {
+			return deleted, fmt.Errorf("failed to list mirror pods in %s: %w", namespace.Name, err)
+		}
+		r.recordAPICall(config, 1)
+		if len(remaining.Items) > 0 {
+			continue
+		}
+		if err := r.Delete(ctx, namespace); err != nil && !errors.IsNotFound(err) {
+			return deleted, fmt.Errorf("failed to delete mirror pod namespace %s: %w", namespace.Name, err)
+		}
+		r.recordAPICall(config, 1)
+	}
+	return deleted, nil
+}
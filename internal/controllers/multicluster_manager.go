@@ -0,0 +1,416 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+// remoteCluster holds the reconciler used to drive load into one target cluster
+type remoteCluster struct {
+	// secretVersion is the resourceVersion of the kubeconfig secret the client was built from
+	secretVersion string
+	reconciler    *ScaleLoadConfigReconciler
+}
+
+// manageTargetClusters drives equivalent load into every target cluster in parallel
+// and returns per-cluster status in spec order
+func (r *ScaleLoadConfigReconciler) manageTargetClusters(ctx context.Context, config *scalev1.ScaleLoadConfig) []scalev1.ClusterStatus {
+	statuses := make([]scalev1.ClusterStatus, len(config.Spec.TargetClusters))
+
+	var wg sync.WaitGroup
+	for i, target := range config.Spec.TargetClusters {
+		wg.Add(1)
+		go func(i int, target scalev1.TargetCluster) {
+			defer wg.Done()
+			statuses[i] = r.manageTargetCluster(ctx, config, target)
+		}(i, target)
+	}
+	wg.Wait()
+
+	r.pruneRemoteClusters(config)
+	return statuses
+}
+
+// manageTargetCluster runs one load generation cycle against a single target cluster
+func (r *ScaleLoadConfigReconciler) manageTargetCluster(ctx context.Context, config *scalev1.ScaleLoadConfig,
+	target scalev1.TargetCluster) scalev1.ClusterStatus {
+
+	log := r.Log.WithName("multicluster-manager").WithValues("cluster", target.Name)
+	status := scalev1.ClusterStatus{Name: target.Name}
+	if target.HostedClusterRef != nil {
+		status.HostedCluster = target.HostedClusterRef.Namespace + "/" + target.HostedClusterRef.Name
+	}
+
+	// Carry over the last successful sync time so failures do not hide when load last ran
+	for _, previous := range config.Status.Clusters {
+		if previous.Name == target.Name {
+			status.LastSyncTime = previous.LastSyncTime
+		}
+	}
+
+	remote, err := r.getRemoteReconciler(ctx, config, target)
+	if err != nil {
+		log.Error(err, "Failed to connect to target cluster")
+		status.Error = err.Error()
+		return status
+	}
+
+	// Each cluster gets its own copy since permission checks may disable features per cluster
+	clusterConfig := config.DeepCopy()
+	remote.applyPermissionChecks(ctx, clusterConfig)
+
+	if clusterConfig.Spec.NodeManagement.Enabled && !isNamespaceScoped(clusterConfig) {
+		if _, err := remote.manageKwokNodes(ctx, clusterConfig); err != nil {
+			log.Error(err, "Failed to manage KWOK nodes in target cluster, continuing")
+		}
+	}
+
+	kwokNodes, err := remote.getKwokNodes(ctx, clusterConfig)
+	if err != nil {
+		log.Error(err, "Failed to get KWOK nodes in target cluster")
+		status.Error = fmt.Sprintf("failed to get KWOK nodes: %v", err)
+		return status
+	}
+	remote.recordAPICall(clusterConfig, 1)
+	effectiveRate, _ := remote.getEffectiveAPIRate(clusterConfig, len(kwokNodes))
+	remote.pacer.configure(clusterConfig, effectiveRate)
+	status.KwokNodeCount = int32(len(kwokNodes))
+
+	if clusterConfig.Spec.Topology.Enabled && !isNamespaceScoped(clusterConfig) {
+		if err := remote.applyNodeTopology(ctx, clusterConfig, kwokNodes); err != nil {
+			log.Error(err, "Failed to apply node topology labels in target cluster, continuing")
+		}
+	}
+	if !isNamespaceScoped(clusterConfig) {
+		if _, err := remote.manageZoneOutage(ctx, clusterConfig, kwokNodes); err != nil {
+			log.Error(err, "Failed to manage zone outage in target cluster, continuing")
+		}
+	}
+
+	targetNamespaces := remote.calculateTargetNamespaces(clusterConfig, len(kwokNodes))
+	if err := remote.applyPodDensity(ctx, clusterConfig, kwokNodes, targetNamespaces); err != nil {
+		log.Error(err, "Failed to measure pod density in target cluster, continuing")
+	}
+	// Each cluster converges at maxOperationsPerCycle of its own
+	ctx = withOperationBudget(ctx, clusterConfig)
+	namespaceCount, resourceCounts, err := remote.manageLoadResources(ctx, clusterConfig, kwokNodes, targetNamespaces)
+	status.GeneratedNamespaces = int32(namespaceCount)
+	if isCycleBudgetSpent(err) {
+		log.Info("Cycle budget spent in target cluster, continuing next cycle", "reason", err.Error())
+		status.TotalResources = resourceCountsFromMap(resourceCounts, namespaceCount)
+		return status
+	}
+	if err != nil {
+		log.Error(err, "Failed to manage load resources in target cluster")
+		status.Error = fmt.Sprintf("failed to manage load resources: %v", err)
+		status.TotalResources = resourceCountsFromMap(resourceCounts, namespaceCount)
+		return status
+	}
+
+	if clusterConfig.Spec.AnnotationChurn.Enabled && !isNamespaceScoped(clusterConfig) {
+		if err := remote.updateNodeAnnotations(ctx, clusterConfig, kwokNodes); err != nil {
+			log.Error(err, "Failed to update node annotations in target cluster, continuing")
+		}
+	}
+
+	if clusterConfig.Spec.ResourceChurn.Events.Enabled {
+		events, err := remote.manageNodeEvents(ctx, clusterConfig, kwokNodes)
+		if err != nil {
+			log.Error(err, "Failed to generate node events in target cluster, continuing")
+		}
+		if clusterConfig.Spec.ResourceChurn.Events.SystemEvents.Enabled && !isNamespaceScoped(clusterConfig) {
+			systemEvents, err := remote.manageSystemEvents(ctx, clusterConfig, kwokNodes, events)
+			if err != nil {
+				log.Error(err, "Failed to generate system events in target cluster, continuing")
+			}
+			events += systemEvents
+		}
+		resourceCounts["events"] = events
+	}
+
+	if clusterConfig.Spec.NamespaceAnnotationChurn.Enabled && !isNamespaceScoped(clusterConfig) {
+		if _, err := remote.updateNamespaceAnnotations(ctx, clusterConfig); err != nil {
+			log.Error(err, "Failed to update namespace annotations in target cluster, continuing")
+		}
+	}
+
+	if clusterConfig.Spec.AlertSimulation.Enabled {
+		alertCount, err := remote.manageAlertSimulation(ctx, clusterConfig)
+		if err != nil {
+			log.Error(err, "Failed to manage alert simulation in target cluster, continuing")
+		}
+		resourceCounts["alerts"] = alertCount
+	}
+
+	if clusterConfig.Spec.ArgoCDSimulation.Enabled {
+		appCount, err := remote.manageArgoCDApplications(ctx, clusterConfig)
+		if err != nil {
+			log.Error(err, "Failed to manage Argo CD Applications in target cluster, continuing")
+		}
+		resourceCounts["applications"] = appCount
+	}
+
+	if clusterConfig.Spec.ACMSimulation.Enabled && !isNamespaceScoped(clusterConfig) {
+		acmCounts, err := remote.manageACMSimulation(ctx, clusterConfig)
+		if err != nil {
+			log.Error(err, "Failed to manage ACM simulation in target cluster, continuing")
+		}
+		for resourceType, count := range acmCounts {
+			resourceCounts[resourceType] = count
+		}
+	}
+
+	if clusterConfig.Spec.CleanupConfig.OrphanCleanup {
+		if err := remote.performOrphanCleanup(ctx, clusterConfig); err != nil {
+			log.Error(err, "Failed to perform orphan cleanup in target cluster, continuing")
+		}
+	}
+
+	if clusterConfig.Spec.ResourceChurn.Namespaces.Enabled && !isNamespaceScoped(clusterConfig) {
+		if err := remote.performNamespaceChurn(ctx, clusterConfig); err != nil {
+			log.Error(err, "Failed to perform namespace churn in target cluster")
+		}
+	}
+	remote.lastReconcileTime = time.Now()
+
+	status.TotalResources = resourceCountsFromMap(resourceCounts, namespaceCount)
+	status.LastSyncTime = &metav1.Time{Time: time.Now()}
+	return status
+}
+
+// kubeconfigAllowed reports whether target clusters may read a kubeconfig from a namespace: the
+// operator's own, or one listed in KubeconfigNamespaces. Configs are cluster-scoped, so reading any
+// namespace would let a config author reach clusters through credentials they cannot read themselves
+func (r *ScaleLoadConfigReconciler) kubeconfigAllowed(namespace string) bool {
+	if namespace == r.operatorNamespace() {
+		return true
+	}
+	return slices.Contains(r.KubeconfigNamespaces, namespace)
+}
+
+// getRemoteReconciler returns a reconciler bound to the target cluster, rebuilding it when
+// the kubeconfig secret changes
+func (r *ScaleLoadConfigReconciler) getRemoteReconciler(ctx context.Context, config *scalev1.ScaleLoadConfig,
+	target scalev1.TargetCluster) (*ScaleLoadConfigReconciler, error) {
+
+	ref, err := r.resolveKubeconfigSecretRef(ctx, config, target)
+	if err != nil {
+		return nil, err
+	}
+	if !r.kubeconfigAllowed(ref.Namespace) {
+		return nil, fmt.Errorf("kubeconfig secret %s/%s is outside the operator namespace and --kubeconfig-namespaces",
+			ref.Namespace, ref.Name)
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, secret); err != nil {
+		return nil, fmt.Errorf("failed to get kubeconfig secret %s/%s: %w", ref.Namespace, ref.Name, err)
+	}
+	r.recordAPICall(config, 1)
+
+	key := remoteClusterKey(config.Name, target.Name)
+
+	r.remoteClustersMutex.Lock()
+	defer r.remoteClustersMutex.Unlock()
+
+	existing, ok := r.remoteClusters[key]
+	if ok && existing.secretVersion == secret.ResourceVersion {
+		return existing.reconciler, nil
+	}
+	if ok {
+		// The kubeconfig changed, so workers using the old client are replaced on the next sync
+		existing.reconciler.stopAllChurnWorkers()
+	}
+
+	dataKey := ref.Key
+	if dataKey == "" {
+		dataKey = "kubeconfig"
+	}
+	kubeconfig, ok := secret.Data[dataKey]
+	if !ok {
+		return nil, fmt.Errorf("kubeconfig secret %s/%s has no key %q", ref.Namespace, ref.Name, dataKey)
+	}
+
+	remoteClient, err := newRemoteClient(kubeconfig, r.Scheme)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.writeMeter != nil {
+		remoteClient = withWriteMeter(remoteClient, r.writeMeter)
+	}
+	pacedClient, pacer := withPacing(remoteClient)
+	if r.labelStamper != nil {
+		pacedClient = withLabelStamping(pacedClient, r.labelStamper)
+	}
+	if r.namespaceGuard != nil {
+		pacedClient = withNamespaceGuard(pacedClient, r.namespaceGuard)
+	}
+	remote := &ScaleLoadConfigReconciler{
+		Client:                     pacedClient,
+		pacer:                      pacer,
+		Scheme:                     r.Scheme,
+		Log:                        r.Log.WithValues("cluster", target.Name),
+		APICallRate:                r.APICallRate,
+		ErrorCount:                 r.ErrorCount,
+		ChurnerPasses:              r.ChurnerPasses,
+		ChurnerDuration:            r.ChurnerDuration,
+		BytesWritten:               r.BytesWritten,
+		NodeUpdateConflicts:        r.NodeUpdateConflicts,
+		NodeAnnotationKeysChanged:  r.NodeAnnotationKeysChanged,
+		NodeAnnotationBytesChanged: r.NodeAnnotationBytesChanged,
+		ManagedFieldsBytes:         r.ManagedFieldsBytes,
+		EventsRequested:            r.EventsRequested,
+		EventsDropped:              r.EventsDropped,
+		CreateAlreadyExists:        r.CreateAlreadyExists,
+		NamespacesSkipped:          r.NamespacesSkipped,
+		RunMetrics:                 r.RunMetrics,
+		resourceManagers:           make(map[string]*ResourceManager),
+	}
+	remote.deletionManager = NewDeletionManager(remote)
+
+	if r.remoteClusters == nil {
+		r.remoteClusters = make(map[string]*remoteCluster)
+	}
+	r.remoteClusters[key] = &remoteCluster{secretVersion: secret.ResourceVersion, reconciler: remote}
+	return remote, nil
+}
+
+// newRemoteClient builds an uncached client from a kubeconfig using the same rate limits as the local manager
+func newRemoteClient(kubeconfig []byte, scheme *runtime.Scheme) (client.Client, error) {
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse kubeconfig: %w", err)
+	}
+	restConfig.QPS = 200.0
+	restConfig.Burst = 400
+	WrapRunIDTransport(restConfig)
+
+	remoteClient, err := client.New(restConfig, client.Options{Scheme: scheme})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client: %w", err)
+	}
+	return remoteClient, nil
+}
+
+// cleanupTargetClusters removes generated load from every target cluster of a config being deleted
+func (r *ScaleLoadConfigReconciler) cleanupTargetClusters(ctx context.Context, config *scalev1.ScaleLoadConfig) error {
+	for _, target := range config.Spec.TargetClusters {
+		remote, err := r.getRemoteReconciler(ctx, config, target)
+		if err != nil {
+			// An unreachable cluster must not block deletion of the config forever
+			r.Log.WithName("multicluster-manager").Error(err, "Skipping cleanup of unreachable target cluster",
+				"cluster", target.Name)
+			continue
+		}
+
+		remote.stopChurnWorkers(config.Name)
+		remote.paceCleanup(config)
+		if isNamespaceScoped(config) {
+			err = remote.cleanupScopedResources(ctx, config)
+		} else {
+			// Configs live on this cluster, so nodes shared with other configs are found here
+			var shared []labels.Selector
+			if shared, err = r.sharedNodeSelectors(ctx, config, &target); err == nil {
+				err = remote.cleanupClusterScopedLoad(ctx, config, shared)
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("failed to cleanup target cluster %s: %w", target.Name, err)
+		}
+	}
+
+	// Clients are no longer needed once the config is gone
+	config = config.DeepCopy()
+	config.Spec.TargetClusters = nil
+	r.pruneRemoteClusters(config)
+	return nil
+}
+
+// cleanupClusterScopedLoad removes everything a cluster-mode config created in a target cluster,
+// in the same order as the local deletion path
+func (r *ScaleLoadConfigReconciler) cleanupClusterScopedLoad(ctx context.Context, config *scalev1.ScaleLoadConfig,
+	shared []labels.Selector) error {
+	if err := r.cleanupACMObjects(ctx, config); err != nil {
+		return err
+	}
+	if err := r.removeManagedNamespaces(ctx, config); err != nil {
+		return err
+	}
+	if _, err := r.cleanupMirrorPods(ctx, config); err != nil {
+		return err
+	}
+	if err := r.clearZoneOutage(ctx, config); err != nil {
+		return err
+	}
+	if err := r.removeNodeChurnArtifacts(ctx, config, shared); err != nil {
+		return err
+	}
+	return r.cleanupManagedNodes(ctx, config)
+}
+
+// pruneRemoteClusters drops cached clients for clusters no longer listed in the config
+func (r *ScaleLoadConfigReconciler) pruneRemoteClusters(config *scalev1.ScaleLoadConfig) {
+	wanted := make(map[string]bool, len(config.Spec.TargetClusters))
+	for _, target := range config.Spec.TargetClusters {
+		wanted[remoteClusterKey(config.Name, target.Name)] = true
+	}
+
+	r.remoteClustersMutex.Lock()
+	defer r.remoteClustersMutex.Unlock()
+
+	prefix := config.Name + "/"
+	for key := range r.remoteClusters {
+		if strings.HasPrefix(key, prefix) && !wanted[key] {
+			r.remoteClusters[key].reconciler.stopAllChurnWorkers()
+			delete(r.remoteClusters, key)
+		}
+	}
+}
+
+// remoteClusterKey identifies a target cluster of a specific config
+func remoteClusterKey(configName, clusterName string) string {
+	return configName + "/" + clusterName
+}
+
+// resourceCountsFromMap converts per-type resource counts into the status representation
+func resourceCountsFromMap(resourceCounts map[string]int, namespaceCount int) scalev1.ResourceCounts {
+	return scalev1.ResourceCounts{
+		ConfigMaps:   int32(resourceCounts["configMaps"]),
+		Secrets:      int32(resourceCounts["secrets"]),
+		Routes:       int32(resourceCounts["routes"]),
+		ImageStreams: int32(resourceCounts["imageStreams"]),
+		BuildConfigs: int32(resourceCounts["buildConfigs"]),
+		Events:       int32(resourceCounts["events"]),
+		Pods:         int32(resourceCounts["pods"]),
+		Namespaces:   int32(namespaceCount),
+		Alerts:       int32(resourceCounts["alerts"]),
+
+		ManagedClusters: int32(resourceCounts["managedClusters"]),
+		ManifestWorks:   int32(resourceCounts["manifestWorks"]),
+		Placements:      int32(resourceCounts["placements"]),
+		Applications:    int32(resourceCounts["applications"]),
+		AppBundles:      int32(resourceCounts["appBundles"]),
+		DaemonSets:      int32(resourceCounts["daemonSets"]),
+		CompletedPods:   int32(resourceCounts["completedPods"]),
+		MirrorPods:      int32(resourceCounts["mirrorPods"]),
+		NetworkPolicies: int32(resourceCounts["networkPolicies"]),
+		EtcdPressure:    int32(resourceCounts["etcdPressure"]),
+
+		ControllerLeases: int32(resourceCounts["controllerLeases"]),
+	}
+}
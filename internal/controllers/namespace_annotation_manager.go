@@ -0,0 +1,99 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+	"github.com/jtaleric/sim-operator/pkg/generator"
+)
+
+// sccFirstProjectSlot skips the SCC ranges cluster-policy-controller has handed to the openshift-*
+// namespaces by the time user projects are created
+const sccFirstProjectSlot = 100
+
+// updateNamespaceAnnotations simulates the annotation updates namespaces receive from
+// cluster-policy-controller and scheduler tooling, returning the number of namespaces updated
+func (r *ScaleLoadConfigReconciler) updateNamespaceAnnotations(ctx context.Context, config *scalev1.ScaleLoadConfig) (int, error) {
+	log := r.Log.WithName("namespace-annotation-manager")
+	ctx = withSubsystem(ctx, subsystemAnnotationChurn)
+	churn := config.Spec.NamespaceAnnotationChurn
+
+	namespaces, _, err := r.getManagedNamespacesWithStatus(ctx, config)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list managed namespaces: %w", err)
+	}
+	r.recordAPICall(config, 1)
+
+	updated := 0
+	for i := range namespaces {
+		ns := &namespaces[i]
+		if !r.shouldCreateResourceForNamespace(*ns, churn.NamespaceInterval) {
+			continue
+		}
+		if !r.shouldPerformResourceOperation(ns.Name, "namespace-annotations", churn.UpdateIntervalMin, churn.UpdateIntervalMax) {
+			continue
+		}
+
+		patch := client.MergeFrom(ns.DeepCopy())
+		if ns.Annotations == nil {
+			ns.Annotations = make(map[string]string)
+		}
+		if churn.SCCAnnotations {
+			generator.SCCAnnotations(ns.Annotations)
+		}
+		if churn.SchedulerAnnotations {
+			generator.SchedulerAnnotations(ns.Annotations)
+		}
+		for _, key := range churn.Keys {
+			ns.Annotations[key] = generator.RandomString(12)
+		}
+		ns.Annotations["scale.openshift.io/last-annotation-update"] = time.Now().Format(time.RFC3339)
+
+		if err := r.Patch(ctx, ns, patch); err != nil {
+			if isAPIServerTimeoutError(err) {
+				log.V(1).Info("API server timeout patching namespace annotations, continuing", "namespace", ns.Name)
+				continue
+			}
+			log.Error(err, "Failed to update namespace annotations", "namespace", ns.Name)
+			continue
+		}
+		r.recordAPICall(config, 1)
+		r.updateLastResourceOperation(ns.Name, "namespace-annotations")
+		updated++
+	}
+
+	if updated > 0 {
+		log.V(1).Info("Updated namespace annotations", "namespaces", updated)
+	}
+	return updated, nil
+}
+
+// stampSCCAnnotations gives a namespace being created the SCC ranges of its index when the config
+// asks for them. Namespaces without an index get random ranges. Keys set in namespaceConfig.annotations
+// are left to those
+func stampSCCAnnotations(config *scalev1.ScaleLoadConfig, namespace *corev1.Namespace) {
+	if !config.Spec.NamespaceConfig.SCCAnnotations {
+		return
+	}
+	allocated := make(map[string]string)
+	if index, err := strconv.Atoi(namespace.Labels["scale.openshift.io/namespace-index"]); err == nil {
+		generator.AllocatedSCCAnnotations(allocated, sccFirstProjectSlot+index)
+	} else {
+		generator.SCCAnnotations(allocated)
+	}
+
+	if namespace.Annotations == nil {
+		namespace.Annotations = make(map[string]string)
+	}
+	for key, value := range allocated {
+		if _, set := config.Spec.NamespaceConfig.Annotations[key]; !set {
+			namespace.Annotations[key] = value
+		}
+	}
+}
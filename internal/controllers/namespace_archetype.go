@@ -0,0 +1,85 @@
+package controllers
+
+import (
+	"fmt"
+	"hash/fnv"
+
+	corev1 "k8s.io/api/core/v1"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+// namespaceArchetypeLabel records the archetype a namespace was created as
+const namespaceArchetypeLabel = "scale.openshift.io/archetype"
+
+// archetypeForPosition maps a position onto the archetypes by weight. Positions are hashed first so
+// archetypes do not line up with zones or NamespaceInterval, which also follow the namespace index
+func archetypeForPosition(archetypes []scalev1.NamespaceArchetype, position uint64) string {
+	totalWeight := uint64(0)
+	for _, archetype := range archetypes {
+		if archetype.Weight > 0 {
+			totalWeight += uint64(archetype.Weight)
+		}
+	}
+	if totalWeight == 0 {
+		return ""
+	}
+
+	hash := fnv.New64a()
+	hash.Write([]byte(fmt.Sprintf("archetype/%d", position)))
+	slot := hash.Sum64() % totalWeight
+	for _, archetype := range archetypes {
+		if archetype.Weight <= 0 {
+			continue
+		}
+		if slot < uint64(archetype.Weight) {
+			return archetype.Name
+		}
+		slot -= uint64(archetype.Weight)
+	}
+	return ""
+}
+
+// archetypeForIndex picks the archetype of a generated namespace from its index
+func archetypeForIndex(config *scalev1.ScaleLoadConfig, index int) string {
+	return archetypeForPosition(config.Spec.NamespaceArchetypes, uint64(index))
+}
+
+// namespaceArchetype returns the archetype a namespace belongs to. The label set at creation wins,
+// so a tenant keeps its persona when weights change; namespaces without one, or whose archetype was
+// removed, are placed by index, or by a hash of their name when they have no index
+func namespaceArchetype(config *scalev1.ScaleLoadConfig, namespace corev1.Namespace) *scalev1.NamespaceArchetype {
+	if len(config.Spec.NamespaceArchetypes) == 0 {
+		return nil
+	}
+	if archetype := config.Spec.Archetype(namespace.Labels[namespaceArchetypeLabel]); archetype != nil {
+		return archetype
+	}
+	if index := namespaceIndex(namespace); index >= 0 {
+		return config.Spec.Archetype(archetypeForIndex(config, index))
+	}
+	hash := fnv.New64a()
+	hash.Write([]byte(namespace.Name))
+	return config.Spec.Archetype(archetypeForPosition(config.Spec.NamespaceArchetypes, hash.Sum64()))
+}
+
+// configForNamespace returns the config with the ResourceChurn and node selector of the namespace's
+// pool and then its archetype applied, or the config itself when the namespace has neither
+func configForNamespace(config *scalev1.ScaleLoadConfig, namespace corev1.Namespace) *scalev1.ScaleLoadConfig {
+	pool := namespacePool(config, namespace)
+	archetype := namespaceArchetype(config, namespace)
+	if pool == nil && archetype == nil {
+		return config
+	}
+	var resolved *scalev1.ScaleLoadConfig
+	if pool != nil {
+		resolved = config.WithPoolChurn(pool)
+		resolved.Spec.NamespaceConfig.NodeSelector = config.NamespaceNodeSelector(pool)
+	} else {
+		resolved = config.DeepCopy()
+	}
+	if archetype != nil {
+		archetype.ApplyTo(&resolved.Spec.ResourceChurn)
+	}
+	return resolved
+}
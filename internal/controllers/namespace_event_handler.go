@@ -0,0 +1,76 @@
+package controllers
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// NamespaceEventHandler notices managed namespaces deleted outside the operator, e.g. by hand or by
+// another controller, so their tracking state does not outlive them
+type NamespaceEventHandler struct {
+	Reconciler *ScaleLoadConfigReconciler
+}
+
+// Create ignores namespace creation, the operator creates managed namespaces itself
+func (h *NamespaceEventHandler) Create(ctx context.Context, evt event.CreateEvent, q workqueue.RateLimitingInterface) {
+}
+
+// Update ignores namespace updates, a Terminating namespace is skipped by the churn passes until it is gone
+func (h *NamespaceEventHandler) Update(ctx context.Context, evt event.UpdateEvent, q workqueue.RateLimitingInterface) {
+}
+
+// Delete records a deleted managed namespace and enqueues its config, which drops the namespace's
+// state at the start of its next reconcile
+func (h *NamespaceEventHandler) Delete(ctx context.Context, evt event.DeleteEvent, q workqueue.RateLimitingInterface) {
+	ns, ok := evt.Object.(*corev1.Namespace)
+	if !ok {
+		return
+	}
+	configName := ns.GetLabels()["scale.openshift.io/managed-by"]
+	if configName == "" {
+		return
+	}
+
+	h.Reconciler.deletedNamespacesMutex.Lock()
+	if h.Reconciler.deletedNamespaces == nil {
+		h.Reconciler.deletedNamespaces = make(map[string]string)
+	}
+	h.Reconciler.deletedNamespaces[ns.Name] = configName
+	h.Reconciler.deletedNamespacesMutex.Unlock()
+
+	q.Add(reconcile.Request{NamespacedName: types.NamespacedName{Name: configName}})
+}
+
+// Generic ignores generic events
+func (h *NamespaceEventHandler) Generic(ctx context.Context, evt event.GenericEvent, q workqueue.RateLimitingInterface) {
+}
+
+// forgetDeletedNamespaces drops the resource managers, with their update timers, the churn timing and
+// the churn workers of managed namespaces deleted since the last reconcile, whether the operator or
+// someone else deleted them. It runs in the reconcile, which owns resourceManagers, rather than in
+// the event handler
+func (r *ScaleLoadConfigReconciler) forgetDeletedNamespaces() {
+	r.deletedNamespacesMutex.Lock()
+	deleted := r.deletedNamespaces
+	r.deletedNamespaces = nil
+	r.deletedNamespacesMutex.Unlock()
+	if len(deleted) == 0 {
+		return
+	}
+
+	workerKeys := make(map[string]bool, len(deleted))
+	for namespace, configName := range deleted {
+		if manager, exists := r.resourceManagers[namespace]; exists && manager.configName == configName {
+			delete(r.resourceManagers, namespace)
+		}
+		r.forgetResourceTiming(namespace)
+		workerKeys[configName+"/"+namespace] = true
+	}
+	r.stopChurnWorkersMatching(func(key string) bool { return workerKeys[key] })
+	r.Log.WithName("namespace-manager").V(1).Info("Forgot deleted namespaces", "namespaces", len(deleted))
+}
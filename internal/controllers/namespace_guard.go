@@ -0,0 +1,117 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+// errProtectedNamespace is returned for writes the namespace guard refuses
+var errProtectedNamespace = errors.New("namespace is protected")
+
+// namespaceGuard refuses writes to the protected openshift-* and kube-* namespaces and to the
+// namespaces any config excludes, so a misconfigured prefix or selector cannot touch them
+type namespaceGuard struct {
+	mu       sync.RWMutex
+	excluded map[string][]string
+}
+
+func newNamespaceGuard() *namespaceGuard {
+	return &namespaceGuard{excluded: make(map[string][]string)}
+}
+
+// configure records the config's excluded namespaces; they are protected for every config
+func (g *namespaceGuard) configure(config *scalev1.ScaleLoadConfig) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if len(config.Spec.ExcludedNamespaces) == 0 {
+		delete(g.excluded, config.Name)
+		return
+	}
+	g.excluded[config.Name] = append([]string(nil), config.Spec.ExcludedNamespaces...)
+}
+
+// forget drops a deleted config's excluded namespaces
+func (g *namespaceGuard) forget(configName string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.excluded, configName)
+}
+
+// protected reports whether writes to the namespace are refused
+func (g *namespaceGuard) protected(namespace string) bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	var excluded []string
+	for _, patterns := range g.excluded {
+		excluded = append(excluded, patterns...)
+	}
+	return scalev1.IsProtectedNamespace(namespace, excluded)
+}
+
+// check returns an error when a write of obj would land in a protected namespace.
+// Namespaces themselves are checked by name
+func (g *namespaceGuard) check(verb string, obj client.Object) error {
+	namespace := obj.GetNamespace()
+	if _, isNamespace := obj.(*corev1.Namespace); isNamespace {
+		namespace = obj.GetName()
+	}
+	if g.protected(namespace) {
+		return fmt.Errorf("refusing to %s %s in %s: %w", verb, obj.GetName(), namespace, errProtectedNamespace)
+	}
+	return nil
+}
+
+// guardedClient refuses writes the namespace guard does not allow
+type guardedClient struct {
+	client.Client
+	guard *namespaceGuard
+}
+
+// withNamespaceGuard wraps a client so its writes to protected namespaces fail before reaching the API server
+func withNamespaceGuard(c client.Client, guard *namespaceGuard) client.Client {
+	return &guardedClient{Client: c, guard: guard}
+}
+
+func (c *guardedClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	if err := c.guard.check("create", obj); err != nil {
+		return err
+	}
+	return c.Client.Create(ctx, obj, opts...)
+}
+
+func (c *guardedClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	if err := c.guard.check("update", obj); err != nil {
+		return err
+	}
+	return c.Client.Update(ctx, obj, opts...)
+}
+
+func (c *guardedClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	if err := c.guard.check("patch", obj); err != nil {
+		return err
+	}
+	return c.Client.Patch(ctx, obj, patch, opts...)
+}
+
+func (c *guardedClient) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	if err := c.guard.check("delete", obj); err != nil {
+		return err
+	}
+	return c.Client.Delete(ctx, obj, opts...)
+}
+
+func (c *guardedClient) DeleteAllOf(ctx context.Context, obj client.Object, opts ...client.DeleteAllOfOption) error {
+	deleteOpts := &client.DeleteAllOfOptions{}
+	deleteOpts.ApplyOptions(opts)
+	if c.guard.protected(deleteOpts.Namespace) {
+		return fmt.Errorf("refusing to delete all of %T in %s: %w", obj, deleteOpts.Namespace, errProtectedNamespace)
+	}
+	return c.Client.DeleteAllOf(ctx, obj, opts...)
+}
@@ -0,0 +1,84 @@
+package controllers
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+// namespacePoolLabel records the pool a namespace was created in
+const namespacePoolLabel = "scale.openshift.io/pool"
+
+// namespacePool returns the pool a namespace belongs to, or nil when the config has no pools, the
+// namespace was created before it had any, or its pool was removed
+func namespacePool(config *scalev1.ScaleLoadConfig, namespace corev1.Namespace) *scalev1.NamespacePool {
+	if len(config.Spec.Pools) == 0 {
+		return nil
+	}
+	return config.Spec.Pool(namespace.Labels[namespacePoolLabel])
+}
+
+// namespaceGroup is a set of namespaces scaled toward a target of their own
+type namespaceGroup struct {
+	// pool is nil for the namespaces of a config without pools, and for those outside every pool
+	pool        *scalev1.NamespacePool
+	target      int
+	active      []corev1.Namespace
+	terminating []corev1.Namespace
+}
+
+// namespaceGroups splits the config's namespaces into the groups scaled on their own. Without pools
+// all namespaces are one group with the given target; with pools each pool is a group with its own
+// count, and namespaces outside every pool are a group with no target, so they are scaled away
+func namespaceGroups(config *scalev1.ScaleLoadConfig, target int, active, terminating []corev1.Namespace) []namespaceGroup {
+	pools := config.Spec.Pools
+	if len(pools) == 0 {
+		return []namespaceGroup{{target: target, active: active, terminating: terminating}}
+	}
+
+	groups := make([]namespaceGroup, len(pools)+1)
+	positions := make(map[string]int, len(pools))
+	for i := range pools {
+		groups[i] = namespaceGroup{pool: &pools[i], target: int(pools[i].Namespaces)}
+		positions[pools[i].Name] = i
+	}
+	groupOf := func(ns corev1.Namespace) *namespaceGroup {
+		if i, ok := positions[ns.Labels[namespacePoolLabel]]; ok {
+			return &groups[i]
+		}
+		return &groups[len(pools)]
+	}
+	for _, ns := range active {
+		group := groupOf(ns)
+		group.active = append(group.active, ns)
+	}
+	for _, ns := range terminating {
+		group := groupOf(ns)
+		group.terminating = append(group.terminating, ns)
+	}
+	// The group outside every pool is only kept while it has namespaces
+	if unpooled := groups[len(pools)]; len(unpooled.active)+len(unpooled.terminating) == 0 {
+		groups = groups[:len(pools)]
+	}
+	return groups
+}
+
+// namespaceShortfall returns how many namespaces the groups are missing, counting terminating ones as
+// present so replacements are not created too early, and how many active ones they have too many
+func namespaceShortfall(groups []namespaceGroup) (int, int) {
+	var toCreate, toDelete int
+	for _, group := range groups {
+		toCreate += max(group.target-len(group.active)-len(group.terminating), 0)
+		toDelete += max(len(group.active)-group.target, 0)
+	}
+	return toCreate, toDelete
+}
+
+// groupedActive returns the active namespaces of all groups
+func groupedActive(groups []namespaceGroup) []corev1.Namespace {
+	var active []corev1.Namespace
+	for _, group := range groups {
+		active = append(active, group.active...)
+	}
+	return active
+}
@@ -0,0 +1,148 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	buildv1 "github.com/openshift/api/build/v1"
+	imagev1 "github.com/openshift/api/image/v1"
+	routev1 "github.com/openshift/api/route/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+const scopeModeNamespaced = "Namespaced"
+
+// isNamespaceScoped reports whether the config restricts load generation to pre-existing namespaces
+func isNamespaceScoped(config *scalev1.ScaleLoadConfig) bool {
+	return config.Spec.Scope.Mode == scopeModeNamespaced
+}
+
+// getScopedNamespaces lists the pre-existing namespaces selected for Namespaced mode
+func (r *ScaleLoadConfigReconciler) getScopedNamespaces(ctx context.Context, config *scalev1.ScaleLoadConfig) ([]corev1.Namespace, error) {
+	namespaceList := &corev1.NamespaceList{}
+	if err := r.List(ctx, namespaceList, client.MatchingLabels(config.Spec.Scope.NamespaceSelector)); err != nil {
+		return nil, err
+	}
+
+	prefix := config.Spec.NamespaceConfig.NamespacePrefix
+	if prefix == "" {
+		prefix = "openshift-fake-"
+	}
+
+	var namespaces []corev1.Namespace
+	for _, ns := range namespaceList.Items {
+		// A selector that matches platform or excluded namespaces must not put load into them
+		if scalev1.IsProtectedNamespace(ns.Name, config.Spec.ExcludedNamespaces) {
+			continue
+		}
+		if strings.HasPrefix(ns.Name, prefix) {
+			namespaces = append(namespaces, ns)
+		}
+	}
+	return namespaces, nil
+}
+
+// scopeDisabledFeatures lists the enabled features that cannot run in Namespaced mode
+func scopeDisabledFeatures(config *scalev1.ScaleLoadConfig) []string {
+	if !isNamespaceScoped(config) {
+		return nil
+	}
+
+	disabled := []string{"namespace creation"}
+	if config.Spec.ResourceChurn.Namespaces.Enabled {
+		disabled = append(disabled, "namespace churn")
+	}
+	if config.Spec.AnnotationChurn.Enabled {
+		disabled = append(disabled, "node annotation churn")
+	}
+	if config.Spec.NamespaceAnnotationChurn.Enabled {
+		disabled = append(disabled, "namespace annotation churn")
+	}
+	if config.Spec.ACMSimulation.Enabled {
+		disabled = append(disabled, "ACM simulation")
+	}
+	if config.Spec.NodeManagement.Enabled {
+		disabled = append(disabled, "node management")
+	}
+	if config.Spec.Topology.Enabled {
+		disabled = append(disabled, "node topology")
+	}
+	return disabled
+}
+
+// cleanupScopedResources removes operator-created objects from the selected namespaces,
+// leaving the namespaces themselves in place since the operator does not own them
+func (r *ScaleLoadConfigReconciler) cleanupScopedResources(ctx context.Context, config *scalev1.ScaleLoadConfig) error {
+	log := r.Log.WithName("namespace-cleanup")
+
+	namespaces, err := r.getScopedNamespaces(ctx, config)
+	if err != nil {
+		return fmt.Errorf("failed to list scoped namespaces: %w", err)
+	}
+
+	for _, ns := range namespaces {
+		for _, list := range scopedResourceLists() {
+			if err := r.List(ctx, list, client.InNamespace(ns.Name),
+				client.MatchingLabels{"scale.openshift.io/managed-by": config.Name}); err != nil {
+				if meta.IsNoMatchError(err) || isAPIServerTimeoutError(err) {
+					continue
+				}
+				return fmt.Errorf("failed to list resources in %s: %w", ns.Name, err)
+			}
+
+			items, err := meta.ExtractList(list)
+			if err != nil {
+				return fmt.Errorf("failed to extract resources in %s: %w", ns.Name, err)
+			}
+			for _, item := range items {
+				obj, ok := item.(client.Object)
+				if !ok {
+					continue
+				}
+				if err := r.Delete(ctx, obj); err != nil && !errors.IsNotFound(err) {
+					log.Error(err, "Failed to delete managed resource", "namespace", ns.Name, "name", obj.GetName())
+				}
+			}
+		}
+
+		delete(r.resourceManagers, ns.Name)
+		log.V(1).Info("Cleaned up managed resources", "namespace", ns.Name)
+	}
+
+	return nil
+}
+
+// scopedResourceLists returns empty lists for every namespaced kind the operator creates
+func scopedResourceLists() []client.ObjectList {
+	prometheusRules := &unstructured.UnstructuredList{}
+	prometheusRules.SetGroupVersionKind(prometheusRuleGVK.GroupVersion().WithKind("PrometheusRuleList"))
+	applications := &unstructured.UnstructuredList{}
+	applications.SetGroupVersionKind(applicationGVK.GroupVersion().WithKind("ApplicationList"))
+
+	return []client.ObjectList{
+		&corev1.ConfigMapList{},
+		&corev1.SecretList{},
+		&corev1.PodList{},
+		&corev1.ServiceList{},
+		&corev1.EventList{},
+		&routev1.RouteList{},
+		&imagev1.ImageStreamList{},
+		&buildv1.BuildConfigList{},
+		&appsv1.DeploymentList{},
+		&appsv1.DaemonSetList{},
+		&corev1.ServiceAccountList{},
+		&networkingv1.IngressList{},
+		&networkingv1.NetworkPolicyList{},
+		prometheusRules,
+		applications,
+	}
+}
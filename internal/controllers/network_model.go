@@ -0,0 +1,160 @@
+package controllers
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"net"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+	"github.com/jtaleric/sim-operator/pkg/generator"
+)
+
+// networkSlotAnnotation records the slot a node holds in the cluster network model; every address
+// the node is given is derived from it
+const networkSlotAnnotation = "scale.openshift.io/network-slot"
+
+// networkModel hands out node slots from the configured cluster network. Slot n is the n-th host
+// subnet of the cluster CIDR and the n-th host address of the machine CIDR, past the gateway
+type networkModel struct {
+	clusterNet  *net.IPNet
+	hostPrefix  int
+	machineNet  *net.IPNet
+	reIPChance  float64
+	capacity    int
+	used        map[int]bool
+	machineBits int
+}
+
+// newNetworkModel builds the model for a config and marks the slots the nodes already hold
+func newNetworkModel(model *scalev1.ClusterNetworkModel, nodes []corev1.Node) (*networkModel, error) {
+	clusterCIDR, machineCIDR, hostPrefix := model.ClusterCIDR, model.MachineCIDR, int(model.HostPrefix)
+	if clusterCIDR == "" {
+		clusterCIDR = "10.128.0.0/14"
+	}
+	if machineCIDR == "" {
+		machineCIDR = "10.0.0.0/16"
+	}
+	if hostPrefix == 0 {
+		hostPrefix = 23
+	}
+
+	_, clusterNet, err := net.ParseCIDR(clusterCIDR)
+	if err != nil || clusterNet.IP.To4() == nil {
+		return nil, fmt.Errorf("clusterCIDR %q is not an IPv4 CIDR", clusterCIDR)
+	}
+	_, machineNet, err := net.ParseCIDR(machineCIDR)
+	if err != nil || machineNet.IP.To4() == nil {
+		return nil, fmt.Errorf("machineCIDR %q is not an IPv4 CIDR", machineCIDR)
+	}
+	clusterPrefix, _ := clusterNet.Mask.Size()
+	if hostPrefix <= clusterPrefix || hostPrefix > 30 {
+		return nil, fmt.Errorf("hostPrefix %d does not fit clusterCIDR %s", hostPrefix, clusterCIDR)
+	}
+	machinePrefix, _ := machineNet.Mask.Size()
+
+	// Host addresses of the machine network, less the network, gateway and broadcast addresses
+	machineHosts := (1 << (32 - machinePrefix)) - 3
+	subnets := 1 << min(hostPrefix-clusterPrefix, 30)
+	reIPChance, _ := parseFloat(model.ReIPChance)
+
+	m := &networkModel{
+		clusterNet:  clusterNet,
+		hostPrefix:  hostPrefix,
+		machineNet:  machineNet,
+		reIPChance:  reIPChance,
+		capacity:    max(min(subnets, machineHosts), 1),
+		used:        make(map[int]bool, len(nodes)),
+		machineBits: machinePrefix,
+	}
+	for _, node := range nodes {
+		if slot, ok := m.slotOf(&node); ok {
+			m.used[slot] = true
+		}
+	}
+	return m, nil
+}
+
+// slotOf returns the slot recorded on the node, if it holds a valid one
+func (m *networkModel) slotOf(node *corev1.Node) (int, bool) {
+	slot, err := strconv.Atoi(node.Annotations[networkSlotAnnotation])
+	if err != nil || slot < 0 || slot >= m.capacity {
+		return 0, false
+	}
+	return slot, true
+}
+
+// assign returns the node's addressing, allocating the lowest free slot to a node without one and
+// moving the node to a new slot when a re-IP is simulated. The slot is recorded on the node
+func (m *networkModel) assign(node *corev1.Node) generator.NodeNetwork {
+	slot, ok := m.slotOf(node)
+	if ok && m.reIPChance > 0 && rand.Float64() < m.reIPChance {
+		if next, found := m.freeSlot(); found {
+			delete(m.used, slot)
+			slot = next
+		}
+	}
+	if !ok {
+		next, found := m.freeSlot()
+		if !found {
+			// A full network hands out shared slots rather than none
+			next = int(nodeNameHash(node.Name) % uint64(m.capacity))
+		}
+		slot = next
+	}
+	m.used[slot] = true
+	if node.Annotations == nil {
+		node.Annotations = make(map[string]string)
+	}
+	node.Annotations[networkSlotAnnotation] = strconv.Itoa(slot)
+	return m.network(slot)
+}
+
+// freeSlot returns the lowest slot no node holds
+func (m *networkModel) freeSlot() (int, bool) {
+	for slot := 0; slot < m.capacity; slot++ {
+		if !m.used[slot] {
+			return slot, true
+		}
+	}
+	return 0, false
+}
+
+// network derives a slot's addresses. IPv6 addresses use fixed ULA prefixes with the slot in the
+// same position, so dual-stack nodes agree across families too
+func (m *networkModel) network(slot int) generator.NodeNetwork {
+	subnet := ipv4Add(m.clusterNet.IP, uint32(slot)<<(32-m.hostPrefix))
+	gateway := ipv4Add(m.machineNet.IP, 1)
+	primary := ipv4Add(m.machineNet.IP, uint32(slot)+2)
+
+	return generator.NodeNetwork{
+		PrimaryIPv4: fmt.Sprintf("%s/%d", primary, m.machineBits),
+		PrimaryIPv6: fmt.Sprintf("fd00:10::%x/64", slot+2),
+		SubnetIPv4:  fmt.Sprintf("%s/%d", subnet, m.hostPrefix),
+		SubnetIPv6:  fmt.Sprintf("fd01:0:0:%x::/64", slot),
+		MachineIPv4: m.machineNet.String(),
+		MachineIPv6: "fd00:10::/64",
+		GatewayIPv4: gateway.String(),
+		GatewayIPv6: "fd00:10::1",
+		TransitIPv4: fmt.Sprintf("%s/16", ipv4Add(net.IPv4(100, 88, 0, 0), uint32(slot)+2)),
+		TransitIPv6: fmt.Sprintf("fd97::%x/64", slot+2),
+	}
+}
+
+// ipv4Add returns the IPv4 address offset from base
+func ipv4Add(base net.IP, offset uint32) net.IP {
+	ip := make(net.IP, net.IPv4len)
+	binary.BigEndian.PutUint32(ip, binary.BigEndian.Uint32(base.To4())+offset)
+	return ip
+}
+
+// nodeNameHash is a stable hash of a node name
+func nodeNameHash(name string) uint64 {
+	hash := fnv.New64a()
+	hash.Write([]byte(name))
+	return hash.Sum64()
+}
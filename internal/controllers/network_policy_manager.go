@@ -0,0 +1,139 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+const networkPolicyResourceType = "networkpolicy"
+
+func init() {
+	RegisterResourceChurner(networkPolicyChurner{})
+}
+
+// networkPolicyChurner keeps the default-deny and allow-same-namespace NetworkPolicies in each
+// selected namespace. The policies are static, so they only add to the network plugin's baseline work
+type networkPolicyChurner struct{}
+
+func (networkPolicyChurner) Name() string { return "networkPolicies" }
+
+func (networkPolicyChurner) Enabled(config *scalev1.ScaleLoadConfig) bool {
+	return config.Spec.ResourceChurn.NetworkPolicies.Enabled
+}
+
+func (networkPolicyChurner) NamespaceInterval(config *scalev1.ScaleLoadConfig) int32 {
+	return config.Spec.ResourceChurn.NetworkPolicies.NamespaceInterval
+}
+
+func (networkPolicyChurner) NamespaceTargeting(config *scalev1.ScaleLoadConfig) *scalev1.NamespaceTargeting {
+	return config.Spec.ResourceChurn.NetworkPolicies.NamespaceTargeting
+}
+
+func (networkPolicyChurner) EnsureCount(ctx context.Context, r *ScaleLoadConfigReconciler,
+	config *scalev1.ScaleLoadConfig, namespace string) (int32, error) {
+	return r.manageNetworkPolicies(ctx, config, namespace)
+}
+
+// Churn is a no-op since the baseline policies never change
+func (networkPolicyChurner) Churn(context.Context, *ScaleLoadConfigReconciler, *scalev1.ScaleLoadConfig, string) error {
+	return nil
+}
+
+func (networkPolicyChurner) Cleanup(ctx context.Context, r *ScaleLoadConfigReconciler,
+	config *scalev1.ScaleLoadConfig, namespace string) (int, error) {
+	return r.deleteResourceType(ctx, config, managedResourceType{networkPolicyResourceType,
+		func() client.ObjectList { return &networkingv1.NetworkPolicyList{} }}, client.InNamespace(namespace))
+}
+
+// manageNetworkPolicies creates whichever of the namespace's baseline policies are missing and
+// removes any other policy the config left there
+func (r *ScaleLoadConfigReconciler) manageNetworkPolicies(ctx context.Context, config *scalev1.ScaleLoadConfig, namespace string) (int32, error) {
+	log := r.Log.WithName("network-policy-manager").WithValues("namespace", namespace)
+
+	policies := &networkingv1.NetworkPolicyList{}
+	if err := r.List(ctx, policies, client.InNamespace(namespace), client.MatchingLabels{
+		"scale.openshift.io/managed-by":    config.Name,
+		"scale.openshift.io/resource-type": networkPolicyResourceType,
+	}); err != nil {
+		return 0, fmt.Errorf("failed to list NetworkPolicies: %w", err)
+	}
+	r.recordAPICall(config, 1)
+
+	existing := make(map[string]*networkingv1.NetworkPolicy, len(policies.Items))
+	for i := range policies.Items {
+		existing[policies.Items[i].Name] = &policies.Items[i]
+	}
+
+	var managed int32
+	desired := make(map[string]bool)
+	for _, policy := range r.generateBaselineNetworkPolicies(config, namespace) {
+		desired[policy.Name] = true
+		if _, ok := existing[policy.Name]; ok {
+			managed++
+			continue
+		}
+		if err := r.createOrAdopt(ctx, config, policy); err != nil {
+			return managed, fmt.Errorf("failed to create NetworkPolicy %s/%s: %w", namespace, policy.Name, err)
+		}
+		r.recordAPICall(config, 1)
+		managed++
+	}
+
+	for name, policy := range existing {
+		if desired[name] {
+			continue
+		}
+		if err := r.Delete(ctx, policy); err != nil && !errors.IsNotFound(err) {
+			log.Error(err, "Failed to delete surplus NetworkPolicy", "networkPolicy", name)
+			continue
+		}
+		r.recordAPICall(config, 1)
+	}
+
+	return managed, nil
+}
+
+// generateBaselineNetworkPolicies builds the usual hardened namespace baseline: a policy selecting
+// every pod with no ingress rules, which denies all ingress, and one admitting ingress from any pod
+// of the same namespace
+func (r *ScaleLoadConfigReconciler) generateBaselineNetworkPolicies(config *scalev1.ScaleLoadConfig,
+	namespace string) []*networkingv1.NetworkPolicy {
+	objectMeta := func(name string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{
+			Name:      fmt.Sprintf("sim-netpol-%s-%s", configNameHash(config.Name), name),
+			Namespace: namespace,
+			Labels: map[string]string{
+				"scale.openshift.io/managed-by":    config.Name,
+				"scale.openshift.io/resource-type": networkPolicyResourceType,
+				"scale.openshift.io/created-by":    "sim-operator",
+			},
+		}
+	}
+
+	return []*networkingv1.NetworkPolicy{
+		{
+			ObjectMeta: objectMeta("default-deny"),
+			Spec: networkingv1.NetworkPolicySpec{
+				PodSelector: metav1.LabelSelector{},
+				PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+			},
+		},
+		{
+			ObjectMeta: objectMeta("allow-same-namespace"),
+			Spec: networkingv1.NetworkPolicySpec{
+				PodSelector: metav1.LabelSelector{},
+				Ingress: []networkingv1.NetworkPolicyIngressRule{{
+					From: []networkingv1.NetworkPolicyPeer{{PodSelector: &metav1.LabelSelector{}}},
+				}},
+				PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+			},
+		},
+	}
+}
@@ -4,14 +4,20 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"strings"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+	"github.com/jtaleric/sim-operator/pkg/generator"
 )
 
 // updateNodeAnnotations simulates realistic node annotation churn patterns
@@ -20,11 +26,23 @@ func (r *ScaleLoadConfigReconciler) updateNodeAnnotations(ctx context.Context,
 	config *scalev1.ScaleLoadConfig, kwokNodes []corev1.Node) error {
 
 	log := r.Log.WithName("node-annotation-manager")
+	ctx = withSubsystem(ctx, subsystemAnnotationChurn)
 
 	// Check if enough time has passed since last update
 	minInterval := time.Duration(config.Spec.AnnotationChurn.UpdateIntervalMin) * time.Second
 	maxInterval := time.Duration(config.Spec.AnnotationChurn.UpdateIntervalMax) * time.Second
 
+	var model *networkModel
+	if clusterNetwork := config.Spec.AnnotationChurn.ClusterNetwork; clusterNetwork != nil {
+		var err error
+		if model, err = newNetworkModel(clusterNetwork, kwokNodes); err != nil {
+			// Webhooks may be disabled, so fall back to random addresses
+			log.Error(err, "Ignoring invalid cluster network model")
+		}
+	}
+
+	stability := newAnnotationStability(config.Spec.AnnotationChurn)
+
 	for _, node := range kwokNodes {
 		// Determine if this node should be updated based on timing
 		shouldUpdate := r.shouldUpdateNodeAnnotations(node, minInterval, maxInterval)
@@ -37,28 +55,44 @@ func (r *ScaleLoadConfigReconciler) updateNodeAnnotations(ctx context.Context,
 
 		// Apply networking annotation churn (simulates OVN/networking controllers)
 		if config.Spec.AnnotationChurn.NetworkingAnnotations {
-			if r.updateNetworkingAnnotations(nodeToUpdate) {
+			if r.updateNetworkingAnnotations(nodeToUpdate, config.Spec.AnnotationChurn, model, stability) {
 				updated = true
 			}
 		}
 
 		// Apply machine config annotation churn (simulates machine-config-daemon)
 		if config.Spec.AnnotationChurn.MachineConfigAnnotations {
-			if r.updateMachineConfigAnnotations(nodeToUpdate) {
+			if r.updateMachineConfigAnnotations(nodeToUpdate, stability) {
 				updated = true
 			}
 		}
 
 		// Apply general cluster annotations (always updates)
-		if r.updateClusterAnnotations(nodeToUpdate) {
+		if r.updateClusterAnnotations(nodeToUpdate, config.Spec.AnnotationChurn.Platform, stability) {
 			updated = true
 		}
 
+		// Pad the node to its target object size once the churned annotations are in place
+		if target := config.Spec.AnnotationChurn.TargetNodeObjectSizeKB; target > 0 {
+			if padNodeObject(nodeToUpdate, int(target)*1024) {
+				updated = true
+			}
+		}
+
 		if updated {
-			if err := r.updateNodeWithRetry(ctx, node.Name, nodeToUpdate); err != nil {
+			var err error
+			if config.Spec.AnnotationChurn.ServerSideApply.Enabled {
+				err = r.applyNodeAnnotations(ctx, config, nodeToUpdate)
+			} else {
+				err = r.updateNodeWithRetry(ctx, config, node.Name, nodeToUpdate)
+			}
+			if err != nil {
 				log.Error(err, "Failed to update node annotations", "node", node.Name)
 				continue
 			}
+			if config.Spec.AnnotationChurn.RecordDeltas {
+				r.recordAnnotationDelta(config, &node, nodeToUpdate)
+			}
 			log.V(1).Info("Updated node annotations", "node", node.Name)
 		}
 	}
@@ -88,62 +122,50 @@ func (r *ScaleLoadConfigReconciler) shouldUpdateNodeAnnotations(node corev1.Node
 }
 
 // updateNetworkingAnnotations simulates OVN/networking annotation updates
-// Based on patterns observed in must-gather analysis
-func (r *ScaleLoadConfigReconciler) updateNetworkingAnnotations(node *corev1.Node) bool {
+// Based on patterns observed in must-gather analysis. With a cluster network model, the address
+// annotations are derived from the node's slot and all written on every update, so they always agree
+func (r *ScaleLoadConfigReconciler) updateNetworkingAnnotations(node *corev1.Node, churn scalev1.AnnotationChurnConfig,
+	model *networkModel, stability annotationStability) bool {
 	if node.Annotations == nil {
 		node.Annotations = make(map[string]string)
 	}
 
 	updated := false
 	now := time.Now()
+	stack := churn.ClusterNetworkStack
+	network := generator.RandomNodeNetwork()
+	if model != nil {
+		network = model.assign(node)
+	}
+
+	// Address annotations, all derived from the node's network
+	addressAnnotations := generator.OVNAddressAnnotations(node.Name, stack, network)
 
 	// Simulate OVN annotations (based on must-gather patterns)
-	ovnAnnotations := map[string]func() string{
-		"k8s.ovn.org/host-cidrs": func() string {
-			return fmt.Sprintf("[\"%s/19\"]", generateRandomIP())
-		},
-		"k8s.ovn.org/l3-gateway-config": func() string {
-			return generateL3GatewayConfig(node.Name)
-		},
-		"k8s.ovn.org/node-chassis-id": func() string {
-			return generateRandomUUID()
-		},
-		"k8s.ovn.org/node-encap-ips": func() string {
-			return fmt.Sprintf("[\"%s\"]", generateRandomIP())
-		},
-		"k8s.ovn.org/node-primary-ifaddr": func() string {
-			return fmt.Sprintf("{\"ipv4\":\"%s/19\"}", generateRandomIP())
-		},
-		"k8s.ovn.org/node-subnets": func() string {
-			return fmt.Sprintf("{\"default\":[\"%s/23\"]}", generateRandomSubnet())
-		},
-		"k8s.ovn.org/node-transit-switch-port-ifaddr": func() string {
-			return fmt.Sprintf("{\"ipv4\":\"%s/16\"}", generateTransitIP())
-		},
-		"k8s.ovn.org/zone-name": func() string {
-			return node.Name
-		},
-		"k8s.ovn.org/remote-zone-migrated": func() string {
-			return node.Name
-		},
-		"k8s.ovn.org/layer2-topology-version": func() string {
-			versions := []string{"2.0", "2.1", "2.2"}
-			return versions[rand.Intn(len(versions))]
-		},
-	}
+	ovnAnnotations := generator.OVNNodeAnnotations(node.Name)
 
-	// Update 30% of networking annotations each time
-	for annotation, generator := range ovnAnnotations {
-		if rand.Float64() < 0.3 {
-			node.Annotations[annotation] = generator()
-			updated = true
+	// Update 30% of networking annotations each time; other network plugins do not annotate nodes
+	if churn.NetworkPlugin != scalev1.NetworkPluginOther {
+		for annotation, value := range addressAnnotations {
+			if model != nil || stability.due(node, annotation, 0.3) {
+				node.Annotations[annotation] = value()
+				updated = true
+			}
+		}
+		for annotation, value := range ovnAnnotations {
+			if stability.due(node, annotation, 0.3) {
+				node.Annotations[annotation] = value()
+				updated = true
+			}
 		}
 	}
 
-	// Cloud network annotations
-	if rand.Float64() < 0.2 { // Update less frequently
-		node.Annotations["cloud.network.openshift.io/egress-ipconfig"] = generateEgressIPConfig()
-		updated = true
+	// Cloud network annotations, written by the cloud network config controller on cloud platforms only
+	if stability.due(node, "cloud.network.openshift.io/egress-ipconfig", 0.2) { // Update less frequently
+		if egressConfig := generator.EgressIPConfig(churn.Platform, stack, node.Name, network); egressConfig != "" {
+			node.Annotations["cloud.network.openshift.io/egress-ipconfig"] = egressConfig
+			updated = true
+		}
 	}
 
 	if updated {
@@ -155,7 +177,7 @@ func (r *ScaleLoadConfigReconciler) updateNetworkingAnnotations(node *corev1.Nod
 }
 
 // updateMachineConfigAnnotations simulates machine-config-daemon annotation updates
-func (r *ScaleLoadConfigReconciler) updateMachineConfigAnnotations(node *corev1.Node) bool {
+func (r *ScaleLoadConfigReconciler) updateMachineConfigAnnotations(node *corev1.Node, stability annotationStability) bool {
 	if node.Annotations == nil {
 		node.Annotations = make(map[string]string)
 	}
@@ -164,48 +186,12 @@ func (r *ScaleLoadConfigReconciler) updateMachineConfigAnnotations(node *corev1.
 	now := time.Now()
 
 	// Simulate machine config annotations (based on must-gather patterns)
-	machineConfigAnnotations := map[string]func() string{
-		"machineconfiguration.openshift.io/currentConfig": func() string {
-			return fmt.Sprintf("rendered-worker-%s", generateRandomHash())
-		},
-		"machineconfiguration.openshift.io/desiredConfig": func() string {
-			return fmt.Sprintf("rendered-worker-%s", generateRandomHash())
-		},
-		"machineconfiguration.openshift.io/desiredDrain": func() string {
-			return fmt.Sprintf("uncordon-rendered-worker-%s", generateRandomHash())
-		},
-		"machineconfiguration.openshift.io/lastAppliedDrain": func() string {
-			return fmt.Sprintf("uncordon-rendered-worker-%s", generateRandomHash())
-		},
-		"machineconfiguration.openshift.io/state": func() string {
-			states := []string{"Done", "Working", "Degraded"}
-			return states[rand.Intn(len(states))]
-		},
-		"machineconfiguration.openshift.io/reason": func() string {
-			if rand.Float64() < 0.8 {
-				return "" // Usually empty
-			}
-			reasons := []string{"Updating", "Rebooting", "ConfigChange"}
-			return reasons[rand.Intn(len(reasons))]
-		},
-		"machineconfiguration.openshift.io/lastSyncedControllerConfigResourceVersion": func() string {
-			return fmt.Sprintf("%d", 2900000+rand.Intn(100000))
-		},
-		"machineconfiguration.openshift.io/controlPlaneTopology": func() string {
-			return "HighlyAvailable"
-		},
-		"machineconfiguration.openshift.io/lastObservedServerCAAnnotation": func() string {
-			return "false"
-		},
-		"machineconfiguration.openshift.io/post-config-action": func() string {
-			return ""
-		},
-	}
+	machineConfigAnnotations := generator.MachineConfigAnnotations()
 
 	// Update 40% of machine config annotations each time
-	for annotation, generator := range machineConfigAnnotations {
-		if rand.Float64() < 0.4 {
-			node.Annotations[annotation] = generator()
+	for annotation, value := range machineConfigAnnotations {
+		if stability.due(node, annotation, 0.4) {
+			node.Annotations[annotation] = value()
 			updated = true
 		}
 	}
@@ -218,8 +204,9 @@ func (r *ScaleLoadConfigReconciler) updateMachineConfigAnnotations(node *corev1.
 	return updated
 }
 
-// updateClusterAnnotations simulates other cluster-level annotation updates
-func (r *ScaleLoadConfigReconciler) updateClusterAnnotations(node *corev1.Node) bool {
+// updateClusterAnnotations simulates other cluster-level annotation updates for the given platform
+func (r *ScaleLoadConfigReconciler) updateClusterAnnotations(node *corev1.Node, platform string,
+	stability annotationStability) bool {
 	if node.Annotations == nil {
 		node.Annotations = make(map[string]string)
 	}
@@ -227,13 +214,15 @@ func (r *ScaleLoadConfigReconciler) updateClusterAnnotations(node *corev1.Node)
 	now := time.Now()
 
 	// CSI and volume annotations
-	if rand.Float64() < 0.1 { // Update less frequently
-		node.Annotations["csi.volume.kubernetes.io/nodeid"] = generateCSINodeID()
+	if stability.due(node, "csi.volume.kubernetes.io/nodeid", 0.1) { // Update less frequently
+		if nodeID := generator.CSINodeID(platform, node.Name); nodeID != "" {
+			node.Annotations["csi.volume.kubernetes.io/nodeid"] = nodeID
+		}
 	}
 
 	// Machine API annotations
-	if rand.Float64() < 0.05 { // Update rarely
-		node.Annotations["machine.openshift.io/machine"] = generateMachineReference(node.Name)
+	if stability.due(node, "machine.openshift.io/machine", 0.05) { // Update rarely
+		node.Annotations["machine.openshift.io/machine"] = generator.MachineReference(platform, node.Name)
 	}
 
 	// Custom load generator tracking (always updated)
@@ -243,77 +232,25 @@ func (r *ScaleLoadConfigReconciler) updateClusterAnnotations(node *corev1.Node)
 	return true
 }
 
-// Helper functions for generating realistic annotation values
-
-func generateRandomIP() string {
-	return fmt.Sprintf("10.0.%d.%d", rand.Intn(256), rand.Intn(256))
-}
-
-func generateRandomSubnet() string {
-	return fmt.Sprintf("10.%d.%d.0", 128+rand.Intn(128), rand.Intn(256)&0xFE)
-}
-
-func generateTransitIP() string {
-	return fmt.Sprintf("100.88.0.%d", rand.Intn(256))
-}
-
-func generateRandomHash() string {
-	chars := "abcdefghijklmnopqrstuvwxyz0123456789"
-	result := make([]byte, 32)
-	for i := range result {
-		result[i] = chars[rand.Intn(len(chars))]
+// updateNodeWithRetry implements retry logic with exponential backoff for node updates.
+// Conflicts are retried up to the config's retry budget, or once for nodes that keep conflicting
+func (r *ScaleLoadConfigReconciler) updateNodeWithRetry(ctx context.Context, config *scalev1.ScaleLoadConfig,
+	nodeName string, nodeUpdate *corev1.Node) error {
+	budget := int(config.Spec.AnnotationChurn.ConflictRetryBudget)
+	if budget <= 0 {
+		budget = 5
+	}
+	if r.nodeContended(config.Name, nodeName) {
+		budget = 1
 	}
-	return string(result)
-}
-
-func generateRandomUUID() string {
-	return fmt.Sprintf("%08x-%04x-%04x-%04x-%012x",
-		rand.Uint32(),
-		rand.Uint32()&0xFFFF,
-		rand.Uint32()&0xFFFF,
-		rand.Uint32()&0xFFFF,
-		rand.Uint64()&0xFFFFFFFFFFFF)
-}
-
-func generateL3GatewayConfig(nodeName string) string {
-	ip := generateRandomIP()
-	mac := fmt.Sprintf("%02x:%02x:%02x:%02x:%02x:%02x",
-		rand.Intn(256), rand.Intn(256), rand.Intn(256),
-		rand.Intn(256), rand.Intn(256), rand.Intn(256))
-
-	return fmt.Sprintf(`{"default":{"mode":"shared","bridge-id":"br-ex","interface-id":"br-ex_%s","mac-address":"%s","ip-addresses":["%s/19"],"ip-address":"%s/19","next-hops":["10.0.0.1"],"next-hop":"10.0.0.1","node-port-enable":"true","vlan-id":"0"}}`,
-		nodeName, mac, ip, ip)
-}
-
-func generateEgressIPConfig() string {
-	ip := generateRandomIP()
-	eni := fmt.Sprintf("eni-%012x", rand.Uint64()&0xFFFFFFFFFFFF)
-
-	return fmt.Sprintf(`[{"interface":"%s","ifaddr":{"ipv4":"%s/19"},"capacity":{"ipv4":%d,"ipv6":%d}}]`,
-		eni, ip, 10+rand.Intn(20), 10+rand.Intn(20))
-}
-
-func generateCSINodeID() string {
-	return fmt.Sprintf(`{"ebs.csi.aws.com":"i-%016x"}`, rand.Uint64())
-}
-
-func generateMachineReference(nodeName string) string {
-	// Extract some identifier from node name for consistency
-	suffix := nodeName[len(nodeName)-6:]
-	return fmt.Sprintf("openshift-machine-api/ci-op-%s-worker-us-west-2a-%s",
-		generateRandomString(6), suffix)
-}
-
-// updateNodeWithRetry implements retry logic with exponential backoff for node updates
-func (r *ScaleLoadConfigReconciler) updateNodeWithRetry(ctx context.Context, nodeName string, nodeUpdate *corev1.Node) error {
 	backoff := wait.Backoff{
-		Steps:    5,
+		Steps:    budget,
 		Duration: 100 * time.Millisecond,
 		Factor:   2.0,
 		Jitter:   0.1,
 	}
 
-	return wait.ExponentialBackoff(backoff, func() (bool, error) {
+	err := wait.ExponentialBackoff(backoff, func() (bool, error) {
 		// Get the latest version of the node
 		var currentNode corev1.Node
 		if err := r.Get(ctx, types.NamespacedName{Name: nodeName}, &currentNode); err != nil {
@@ -327,16 +264,183 @@ func (r *ScaleLoadConfigReconciler) updateNodeWithRetry(ctx context.Context, nod
 		for k, v := range nodeUpdate.Annotations {
 			currentNode.Annotations[k] = v
 		}
+		// Padding trimmed towards a lower target is removed rather than left behind
+		for k := range currentNode.Annotations {
+			if _, kept := nodeUpdate.Annotations[k]; !kept && strings.HasPrefix(k, nodePaddingPrefix) {
+				delete(currentNode.Annotations, k)
+			}
+		}
 
 		// Attempt the update
 		if err := r.Update(ctx, &currentNode); err != nil {
 			// If it's a conflict error, retry
 			if errors.IsConflict(err) {
+				r.recordNodeUpdateAttempt(config, nodeName, true)
 				return false, nil // Retry
 			}
 			return false, err // Other errors are not retryable
 		}
 
+		r.recordNodeUpdateAttempt(config, nodeName, false)
 		return true, nil // Success
 	})
+	if wait.Interrupted(err) {
+		return fmt.Errorf("gave up updating node %s after %d conflicting attempts", nodeName, budget)
+	}
+	return err
+}
+
+// applyNodeAnnotations writes the operator's node annotations with Server-Side Apply as one of
+// several rotating field managers. Each manager also applies its own marker annotation so it keeps
+// a managedFields entry, the way independent agents co-owning a node do
+func (r *ScaleLoadConfigReconciler) applyNodeAnnotations(ctx context.Context, config *scalev1.ScaleLoadConfig, nodeUpdate *corev1.Node) error {
+	ssa := config.Spec.AnnotationChurn.ServerSideApply
+	managers := ssa.FieldManagers
+	if managers <= 0 {
+		managers = 5
+	}
+	prefix := ssa.FieldManagerPrefix
+	if prefix == "" {
+		prefix = "sim-agent-"
+	}
+	manager := fmt.Sprintf("%s%d", prefix, rand.Int31n(managers))
+
+	annotations := make(map[string]string)
+	for key, value := range nodeUpdate.Annotations {
+		if isChurnArtifact(key, true) {
+			annotations[key] = value
+		}
+	}
+	annotations["scale.openshift.io/applied-by-"+manager] = time.Now().Format(time.RFC3339)
+
+	apply := &corev1.Node{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Node"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        nodeUpdate.Name,
+			Annotations: annotations,
+		},
+	}
+	if err := r.Patch(ctx, apply, client.Apply, client.FieldOwner(manager), client.ForceOwnership); err != nil {
+		return fmt.Errorf("failed to apply annotations to node %s as %s: %w", nodeUpdate.Name, manager, err)
+	}
+	return nil
+}
+
+// simulatedNodeAnnotationPrefixes match the third-party annotations written by node annotation churn
+var simulatedNodeAnnotationPrefixes = []string{
+	"k8s.ovn.org/",
+	"machineconfiguration.openshift.io/",
+	"cloud.network.openshift.io/egress-ipconfig",
+	"csi.volume.kubernetes.io/nodeid",
+	"machine.openshift.io/machine",
+}
+
+// removeNodeChurnArtifacts strips operator-written annotations from KWOK nodes so the cluster
+// is returned to the state it was in before load generation
+func (r *ScaleLoadConfigReconciler) removeNodeChurnArtifacts(ctx context.Context, config *scalev1.ScaleLoadConfig,
+	shared []labels.Selector) error {
+	log := r.Log.WithName("node-annotation-manager")
+
+	kwokNodes, err := r.getKwokNodes(ctx, config)
+	if err != nil {
+		return fmt.Errorf("failed to get KWOK nodes for annotation cleanup: %w", err)
+	}
+
+	cleaned, kept := 0, 0
+	for i := range kwokNodes {
+		node := &kwokNodes[i]
+		if selectedByAny(shared, node) {
+			kept++
+			continue
+		}
+		patch := client.MergeFrom(node.DeepCopy())
+
+		// Flipped scheduling labels go back to their values from before the churn
+		removed := restoreSchedulingLabels(node)
+		for key := range node.Annotations {
+			if isChurnArtifact(key, config.Spec.CleanupConfig.RemoveChurnArtifacts) {
+				delete(node.Annotations, key)
+				removed = true
+			}
+		}
+		if !removed {
+			continue
+		}
+
+		if err := r.Patch(ctx, node, patch); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to remove churn annotations from node %s: %w", node.Name, err)
+		}
+		cleaned++
+	}
+
+	if cleaned > 0 || kept > 0 {
+		log.Info("Removed churn annotations from KWOK nodes", "nodes", cleaned, "sharedNodesKept", kept)
+	}
+	return nil
+}
+
+// sharedNodeSelectors returns the node selectors of the other live configs whose nodes are in the
+// same cluster as the config's nodes, so cleanup leaves their annotations alone. target is nil for
+// the local cluster, where every config runs; otherwise only configs targeting the same cluster count
+func (r *ScaleLoadConfigReconciler) sharedNodeSelectors(ctx context.Context, config *scalev1.ScaleLoadConfig,
+	target *scalev1.TargetCluster) ([]labels.Selector, error) {
+
+	configList := &scalev1.ScaleLoadConfigList{}
+	if err := r.List(ctx, configList); err != nil {
+		return nil, fmt.Errorf("failed to list ScaleLoadConfigs for shared nodes: %w", err)
+	}
+
+	var selectors []labels.Selector
+	for i := range configList.Items {
+		other := &configList.Items[i]
+		if other.Name == config.Name || !other.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if target != nil && !targetsCluster(other, *target) {
+			continue
+		}
+		selectors = append(selectors, kwokNodeSelector(other))
+	}
+	return selectors, nil
+}
+
+// targetsCluster reports whether a config lists a target cluster reached through the same reference
+func targetsCluster(config *scalev1.ScaleLoadConfig, target scalev1.TargetCluster) bool {
+	for _, candidate := range config.Spec.TargetClusters {
+		if equality.Semantic.DeepEqual(candidate.KubeconfigSecretRef, target.KubeconfigSecretRef) &&
+			equality.Semantic.DeepEqual(candidate.HostedClusterRef, target.HostedClusterRef) {
+			return true
+		}
+	}
+	return false
+}
+
+// selectedByAny reports whether any of the selectors matches the node's labels
+func selectedByAny(selectors []labels.Selector, node *corev1.Node) bool {
+	for _, selector := range selectors {
+		if selector.Matches(labels.Set(node.Labels)) {
+			return true
+		}
+	}
+	return false
+}
+
+// isChurnArtifact reports whether an annotation was written by the operator; the zone outage
+// marker is left for clearZoneOutage, which also has to undo the taints
+func isChurnArtifact(key string, includeSimulated bool) bool {
+	if key == zoneOutageAnnotation {
+		return false
+	}
+	if strings.HasPrefix(key, "scale.openshift.io/") {
+		return true
+	}
+	if !includeSimulated {
+		return false
+	}
+	for _, prefix := range simulatedNodeAnnotationPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,191 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+const nodeIndexLabel = "scale.openshift.io/node-index"
+
+// manageKwokNodes creates or removes operator-owned KWOK nodes to match the current point on the
+// node scale schedule, returning the desired node count
+func (r *ScaleLoadConfigReconciler) manageKwokNodes(ctx context.Context, config *scalev1.ScaleLoadConfig) (int, error) {
+	log := r.Log.WithName("node-manager")
+
+	desired := int(desiredManagedNodeCount(config, time.Now()))
+
+	nodes, err := r.getManagedNodes(ctx, config)
+	if err != nil {
+		return desired, err
+	}
+	r.recordAPICall(config, 1)
+
+	used := make(map[int]bool, len(nodes))
+	for _, node := range nodes {
+		if index, err := strconv.Atoi(node.Labels[nodeIndexLabel]); err == nil {
+			used[index] = true
+		}
+	}
+
+	// Scale up using the lowest free indices
+	for index := 0; len(nodes) < desired; index++ {
+		if used[index] {
+			continue
+		}
+		node := r.generateKwokNode(config, index)
+		if err := r.createOrAdopt(ctx, config, node); err != nil {
+			return desired, fmt.Errorf("failed to create KWOK node %s: %w", node.Name, err)
+		}
+		r.recordAPICall(config, 1)
+		nodes = append(nodes, *node)
+		used[index] = true
+		log.V(1).Info("Created KWOK node", "name", node.Name)
+	}
+
+	// Scale down newest nodes first, like an autoscaler removing the capacity it just added
+	if len(nodes) > desired {
+		sort.Slice(nodes, func(i, j int) bool {
+			return nodeIndex(nodes[i]) > nodeIndex(nodes[j])
+		})
+		for _, node := range nodes[:len(nodes)-desired] {
+			if err := r.Delete(ctx, &node); err != nil && !errors.IsNotFound(err) {
+				return desired, fmt.Errorf("failed to delete KWOK node %s: %w", node.Name, err)
+			}
+			r.recordAPICall(config, 1)
+			log.V(1).Info("Deleted KWOK node", "name", node.Name)
+		}
+	}
+
+	return desired, nil
+}
+
+// desiredManagedNodeCount returns the node pool size for the given time from the scale schedule,
+// falling back to the configured count before the first step
+func desiredManagedNodeCount(config *scalev1.ScaleLoadConfig, now time.Time) int32 {
+	nodeConfig := config.Spec.NodeManagement
+	schedule := nodeConfig.ScaleSchedule
+	if schedule == nil || len(schedule.Steps) == 0 {
+		return nodeConfig.Count
+	}
+
+	elapsed := int64(now.Sub(config.CreationTimestamp.Time) / time.Second)
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	if schedule.PeriodSeconds > 0 {
+		elapsed %= int64(schedule.PeriodSeconds)
+	}
+
+	count := nodeConfig.Count
+	latest := int64(-1)
+	for _, step := range schedule.Steps {
+		at := int64(step.AtSeconds)
+		if at <= elapsed && at > latest {
+			count = step.Count
+			latest = at
+		}
+	}
+	return count
+}
+
+// getManagedNodes lists the KWOK nodes created for a config
+func (r *ScaleLoadConfigReconciler) getManagedNodes(ctx context.Context, config *scalev1.ScaleLoadConfig) ([]corev1.Node, error) {
+	nodeList := &corev1.NodeList{}
+	if err := r.List(ctx, nodeList, client.MatchingLabels{"scale.openshift.io/managed-by": config.Name}); err != nil {
+		return nil, fmt.Errorf("failed to list managed KWOK nodes: %w", err)
+	}
+	return nodeList.Items, nil
+}
+
+// generateKwokNode builds a fake node that the KWOK controller will keep Ready
+func (r *ScaleLoadConfigReconciler) generateKwokNode(config *scalev1.ScaleLoadConfig, index int) *corev1.Node {
+	nodeConfig := config.Spec.NodeManagement
+	prefix := nodeConfig.NodePrefix
+	if prefix == "" {
+		prefix = "sim-kwok-node-"
+	}
+	// The config hash keeps configs sharing a prefix from generating the same node names
+	name := fmt.Sprintf("%s%s-%d", prefix, configNameHash(config.Name), index)
+
+	labels := map[string]string{}
+	for k, v := range config.Spec.KwokNodeSelector {
+		labels[k] = v
+	}
+	labels["kubernetes.io/hostname"] = name
+	labels["scale.openshift.io/managed-by"] = config.Name
+	labels["scale.openshift.io/created-by"] = "sim-operator"
+	labels[nodeIndexLabel] = strconv.Itoa(index)
+	if config.Spec.Topology.Enabled {
+		if zone := zoneForPosition(topologyZones(config), uint64(index)); zone != "" {
+			labels[corev1.LabelTopologyZone] = zone
+			labels[corev1.LabelTopologyRegion] = topologyRegion(config)
+		}
+	}
+
+	allocatable := corev1.ResourceList{}
+	if quantity, err := resource.ParseQuantity(nodeConfig.CPU); err == nil {
+		allocatable[corev1.ResourceCPU] = quantity
+	}
+	if quantity, err := resource.ParseQuantity(nodeConfig.Memory); err == nil {
+		allocatable[corev1.ResourceMemory] = quantity
+	}
+	if nodeConfig.Pods > 0 {
+		allocatable[corev1.ResourcePods] = *resource.NewQuantity(int64(nodeConfig.Pods), resource.DecimalSI)
+	}
+
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: labels,
+			Annotations: map[string]string{
+				"kwok.x-k8s.io/node": "fake",
+			},
+		},
+		Spec: corev1.NodeSpec{
+			ProviderID: "kwok://" + name,
+			Taints: []corev1.Taint{{
+				Key:    "kwok.x-k8s.io/node",
+				Value:  "fake",
+				Effect: corev1.TaintEffectNoSchedule,
+			}},
+		},
+		Status: corev1.NodeStatus{
+			Allocatable: allocatable,
+			Capacity:    allocatable,
+		},
+	}
+}
+
+// cleanupManagedNodes removes every KWOK node created for a config
+func (r *ScaleLoadConfigReconciler) cleanupManagedNodes(ctx context.Context, config *scalev1.ScaleLoadConfig) error {
+	nodes, err := r.getManagedNodes(ctx, config)
+	if err != nil {
+		return err
+	}
+	for _, node := range nodes {
+		if err := r.Delete(ctx, &node); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete KWOK node %s: %w", node.Name, err)
+		}
+	}
+	return nil
+}
+
+// nodeIndex returns the node-index label value, or -1 when it is missing or invalid
+func nodeIndex(node corev1.Node) int {
+	index, err := strconv.Atoi(node.Labels[nodeIndexLabel])
+	if err != nil {
+		return -1
+	}
+	return index
+}
@@ -0,0 +1,92 @@
+package controllers
+
+import (
+	"maps"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/jtaleric/sim-operator/pkg/generator"
+)
+
+const (
+	// nodePaddingPrefix names the annotations that pad nodes to their target object size
+	nodePaddingPrefix = "scale.openshift.io/size-padding-"
+	// nodePaddingChunk is the largest padding annotation value
+	nodePaddingChunk = 16 * 1024
+	// nodePaddingTolerance is how far a node may be from its target size before it is padded again,
+	// so padding is not rewritten on every update
+	nodePaddingTolerance = 1024
+	// maxNodeAnnotationBytes keeps padding under the apiserver's 256KiB limit on all annotations
+	maxNodeAnnotationBytes = 250 * 1024
+)
+
+// padNodeObject adds or trims padding annotations until the node's serialized size is within
+// nodePaddingTolerance of targetBytes. Existing padding is kept as a prefix of the new padding so
+// only the difference is written. It reports whether the annotations changed
+func padNodeObject(node *corev1.Node, targetBytes int) bool {
+	size := serializedSize(node)
+	if abs(size-targetBytes) <= nodePaddingTolerance {
+		return false
+	}
+	if node.Annotations == nil {
+		node.Annotations = make(map[string]string)
+	}
+
+	var existing string
+	previous := make(map[string]string)
+	for i := 0; ; i++ {
+		value, ok := node.Annotations[nodePaddingKey(i)]
+		if !ok {
+			break
+		}
+		existing += value
+		previous[nodePaddingKey(i)] = value
+		delete(node.Annotations, nodePaddingKey(i))
+	}
+
+	// Each padding entry costs its key, value and JSON quoting on top of the bare node
+	need := targetBytes - serializedSize(node)
+	budget := maxNodeAnnotationBytes - annotationBytes(node.Annotations)
+	padding := make(map[string]string)
+	for i := 0; ; i++ {
+		key := nodePaddingKey(i)
+		length := min(nodePaddingChunk, need-len(key)-6, budget-len(key))
+		if length <= 0 {
+			break
+		}
+		var value string
+		if len(existing) > 0 {
+			value = existing[:min(length, len(existing))]
+			existing = existing[len(value):]
+		}
+		value += generator.RandomString(length - len(value))
+		node.Annotations[key] = value
+		padding[key] = value
+		need -= len(key) + len(value) + 6
+		budget -= len(key) + len(value)
+	}
+	// A node that cannot get closer to its target, e.g. one at the annotation limit, keeps its padding
+	return !maps.Equal(previous, padding)
+}
+
+// nodePaddingKey is the key of the i-th padding annotation
+func nodePaddingKey(i int) string {
+	return nodePaddingPrefix + strconv.Itoa(i)
+}
+
+// annotationBytes is the size the apiserver counts against its annotation limit
+func annotationBytes(annotations map[string]string) int {
+	total := 0
+	for key, value := range annotations {
+		total += len(key) + len(value)
+	}
+	return total
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
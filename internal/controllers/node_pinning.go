@@ -0,0 +1,37 @@
+package controllers
+
+import (
+	"maps"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+// nodesMatching returns the nodes carrying every label of selector, or all of them when it is empty
+func nodesMatching(nodes []corev1.Node, selector map[string]string) []corev1.Node {
+	if len(selector) == 0 {
+		return nodes
+	}
+	set := labels.SelectorFromSet(selector)
+	var matching []corev1.Node
+	for _, node := range nodes {
+		if set.Matches(labels.Set(node.Labels)) {
+			matching = append(matching, node)
+		}
+	}
+	return matching
+}
+
+// workloadNodeSelector returns the node selector of the workloads generated in a namespace: the
+// labels selecting KWOK nodes plus those pinning the namespace to a subset of them. Callers see the
+// namespace's pool through configForNamespace
+func workloadNodeSelector(config *scalev1.ScaleLoadConfig) map[string]string {
+	selector := map[string]string{"type": "kwok"}
+	if len(config.Spec.KwokNodeSelector) > 0 {
+		selector = maps.Clone(config.Spec.KwokNodeSelector)
+	}
+	maps.Copy(selector, config.Spec.NamespaceConfig.NodeSelector)
+	return selector
+}
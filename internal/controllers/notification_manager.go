@@ -0,0 +1,178 @@
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+// notificationAttempts is how often a notification is posted before it is given up
+const notificationAttempts = 3
+
+// lifecycleNotification is the JSON body posted to the notifications webhook
+type lifecycleNotification struct {
+	Config              string                    `json:"config"`
+	Event               scalev1.NotificationEvent `json:"event"`
+	Generation          int64                     `json:"generation"`
+	Time                time.Time                 `json:"time"`
+	Reason              string                    `json:"reason,omitempty"`
+	Message             string                    `json:"message,omitempty"`
+	GeneratedNamespaces int32                     `json:"generatedNamespaces"`
+	TotalResources      scalev1.ResourceCounts    `json:"totalResources"`
+	AchievedPercent     string                    `json:"achievedPercent,omitempty"`
+}
+
+// notifyTransitions posts the lifecycle transitions between the stored and the newly written status.
+// Both come from persisted status, so an operator restart does not repeat a notification
+func (r *ScaleLoadConfigReconciler) notifyTransitions(ctx context.Context, config *scalev1.ScaleLoadConfig,
+	stored, updated *scalev1.ScaleLoadConfigStatus) {
+
+	if config.Spec.Notifications == nil {
+		return
+	}
+
+	if condition := becameTrue(stored, updated, "Converged"); condition != nil && config.Spec.Enabled {
+		r.notify(ctx, config, scalev1.NotificationConverged, condition.Reason, condition.Message)
+	}
+	if condition := becameTrue(stored, updated, "Degraded"); condition != nil {
+		r.notify(ctx, config, scalev1.NotificationDegraded, condition.Reason, condition.Message)
+	}
+
+	// Disabling ends the run; a config created disabled never started one
+	before := meta.FindStatusCondition(stored.Conditions, "Ready")
+	after := meta.FindStatusCondition(updated.Conditions, "Ready")
+	if before != nil && after != nil && before.Reason != "LoadGenerationDisabled" && after.Reason == "LoadGenerationDisabled" {
+		r.notify(ctx, config, scalev1.NotificationCompleted, after.Reason, after.Message)
+	}
+}
+
+// becameTrue returns the updated condition of the given type when it turned True
+func becameTrue(stored, updated *scalev1.ScaleLoadConfigStatus, conditionType string) *metav1.Condition {
+	after := meta.FindStatusCondition(updated.Conditions, conditionType)
+	if after == nil || after.Status != metav1.ConditionTrue {
+		return nil
+	}
+	if before := meta.FindStatusCondition(stored.Conditions, conditionType); before != nil && before.Status == metav1.ConditionTrue {
+		return nil
+	}
+	return after
+}
+
+// notify posts a lifecycle transition to the config's notifications webhook. Delivery is best effort:
+// it runs in the background with a few retries, so a slow receiver does not hold up the reconcile
+func (r *ScaleLoadConfigReconciler) notify(ctx context.Context, config *scalev1.ScaleLoadConfig,
+	event scalev1.NotificationEvent, reason, message string) {
+
+	notifications := config.Spec.Notifications
+	if notifications == nil {
+		return
+	}
+	if len(notifications.Events) > 0 && !slices.Contains(notifications.Events, event) {
+		return
+	}
+	log := r.Log.WithName("notification-manager").WithValues("config", config.Name, "event", event)
+
+	token, err := r.secretToken(ctx, config, config.Spec.Notifications.AuthSecretRef, "notifications")
+	if err != nil {
+		log.Error(err, "Failed to read notifications token, not sending")
+		return
+	}
+
+	body, err := json.Marshal(lifecycleNotification{
+		Config:              config.Name,
+		Event:               event,
+		Generation:          config.Generation,
+		Time:                time.Now().UTC(),
+		Reason:              reason,
+		Message:             message,
+		GeneratedNamespaces: config.Status.GeneratedNamespaces,
+		TotalResources:      config.Status.TotalResources,
+		AchievedPercent:     config.Status.Targets.AchievedPercent,
+	})
+	if err != nil {
+		log.Error(err, "Failed to marshal notification")
+		return
+	}
+
+	go postNotification(log, notifications.WebhookURL, token, body)
+}
+
+// secretToken reads the bearer token a config sends to one of its endpoints, or "" when ref is nil.
+// The secret must live in the operator's namespace: the token goes to a URL the config chooses, so any
+// other namespace would let a config send out secrets its author cannot read
+func (r *ScaleLoadConfigReconciler) secretToken(ctx context.Context, config *scalev1.ScaleLoadConfig,
+	ref *scalev1.SecretKeyReference, use string) (string, error) {
+
+	if ref == nil {
+		return "", nil
+	}
+	if operatorNamespace := r.operatorNamespace(); ref.Namespace != operatorNamespace {
+		return "", fmt.Errorf("%s secret %s/%s is outside the operator namespace %q", use, ref.Namespace, ref.Name, operatorNamespace)
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, secret); err != nil {
+		return "", fmt.Errorf("failed to get %s secret %s/%s: %w", use, ref.Namespace, ref.Name, err)
+	}
+	r.recordAPICall(config, 1)
+
+	key := ref.Key
+	if key == "" {
+		key = "token"
+	}
+	token, ok := secret.Data[key]
+	if !ok {
+		return "", fmt.Errorf("%s secret %s/%s has no key %s", use, ref.Namespace, ref.Name, key)
+	}
+	return strings.TrimSpace(string(token)), nil
+}
+
+// postNotification posts body to the webhook, retrying failed attempts with a growing delay
+func postNotification(log logr.Logger, webhookURL, token string, body []byte) {
+	httpClient := newSimHTTPClient()
+
+	var lastErr error
+	for attempt := 0; attempt < notificationAttempts; attempt++ {
+		if attempt > 0 {
+			time.Sleep(time.Duration(attempt) * 2 * time.Second)
+		}
+
+		req, err := http.NewRequest(http.MethodPost, webhookURL, bytes.NewReader(body))
+		if err != nil {
+			log.Error(err, "Failed to build notification request")
+			return
+		}
+		req.Header.Set("Content-Type", "application/json")
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			lastErr = fmt.Errorf("failed to post notification: %w", err)
+			continue
+		}
+		resp.Body.Close()
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			lastErr = fmt.Errorf("notifications webhook returned status %d", resp.StatusCode)
+			continue
+		}
+
+		log.V(1).Info("Sent notification", "attempt", attempt+1)
+		return
+	}
+	log.Error(lastErr, "Failed to send notification", "attempts", notificationAttempts)
+}
@@ -0,0 +1,209 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+// orphanConfirmDelay is the wait before the confirming sweep when the sweep does not repeat
+const orphanConfirmDelay = 2 * time.Minute
+
+// OrphanSweeper finds objects created by the operator whose ScaleLoadConfig no longer exists,
+// for example after a config was renamed or removed without its finalizer running, and
+// deletes them or hands them over to another config
+type OrphanSweeper struct {
+	client.Client
+	// Reader lists objects straight from the API server so the sweep does not start informers
+	Reader client.Reader
+	Log    logr.Logger
+
+	// Interval repeats the sweep periodically; zero sweeps only once at startup
+	Interval time.Duration
+	// AdoptInto relabels orphans to this config instead of deleting them
+	AdoptInto string
+
+	// suspects are the objects found orphaned by the previous sweep. An object is only deleted or
+	// adopted once two consecutive sweeps find it orphaned, so a config briefly missing from a list
+	// does not cost it its load
+	suspects map[types.UID]bool
+}
+
+// Start runs the sweep once and then every Interval until the context is cancelled. Without an
+// interval it sweeps at startup and once more to confirm any suspects
+func (s *OrphanSweeper) Start(ctx context.Context) error {
+	log := s.Log.WithName("orphan-sweeper")
+
+	for pass := 0; ; pass++ {
+		if err := s.Sweep(ctx); err != nil {
+			// A failed sweep is retried on the next interval rather than stopping the manager
+			log.Error(err, "Orphan sweep failed")
+		}
+		if s.Interval <= 0 && (pass > 0 || len(s.suspects) == 0) {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(s.confirmDelay()):
+		}
+	}
+}
+
+// confirmDelay is the time between sweeps, which is also the minimum age of an object the sweep acts on
+func (s *OrphanSweeper) confirmDelay() time.Duration {
+	if s.Interval > 0 {
+		return s.Interval
+	}
+	return orphanConfirmDelay
+}
+
+// Sweep deletes or adopts every operator-created object that belongs to a missing config
+func (s *OrphanSweeper) Sweep(ctx context.Context) error {
+	log := s.Log.WithName("orphan-sweeper")
+
+	configList := &scalev1.ScaleLoadConfigList{}
+	if err := s.Reader.List(ctx, configList); err != nil {
+		return fmt.Errorf("failed to list ScaleLoadConfigs: %w", err)
+	}
+	configs := make(map[string]bool, len(configList.Items))
+	for _, config := range configList.Items {
+		configs[config.Name] = true
+	}
+
+	adoptInto := s.AdoptInto
+	if adoptInto != "" && !configs[adoptInto] {
+		log.Info("Adoption target does not exist, deleting orphans instead", "config", adoptInto)
+		adoptInto = ""
+	}
+
+	createdBy := client.MatchingLabels{"scale.openshift.io/created-by": "sim-operator"}
+	var swept int
+	previous := s.suspects
+	s.suspects = make(map[types.UID]bool)
+
+	// Namespaces first; their contents go with them
+	namespaceList := &corev1.NamespaceList{}
+	if err := s.Reader.List(ctx, namespaceList, createdBy); err != nil {
+		return fmt.Errorf("failed to list operator namespaces: %w", err)
+	}
+	operatorNamespaces := make(map[string]bool, len(namespaceList.Items))
+	for i := range namespaceList.Items {
+		namespace := &namespaceList.Items[i]
+		operatorNamespaces[namespace.Name] = true
+		handled, err := s.sweepObject(ctx, namespace, configs, adoptInto, previous)
+		if err != nil {
+			return err
+		}
+		if handled {
+			swept++
+		}
+	}
+
+	lists := []client.ObjectList{&corev1.NodeList{}}
+	managedClusters := &unstructured.UnstructuredList{}
+	managedClusters.SetGroupVersionKind(managedClusterGVK.GroupVersion().WithKind("ManagedClusterList"))
+	lists = append(lists, managedClusters)
+	lists = append(lists, scopedResourceLists()...)
+
+	for _, list := range lists {
+		if err := s.Reader.List(ctx, list, createdBy); err != nil {
+			if meta.IsNoMatchError(err) {
+				continue
+			}
+			return fmt.Errorf("failed to list operator resources: %w", err)
+		}
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return fmt.Errorf("failed to read operator resources: %w", err)
+		}
+		for _, item := range items {
+			obj, ok := item.(client.Object)
+			if !ok {
+				continue
+			}
+			// Resources inside operator namespaces were handled with the namespace
+			if operatorNamespaces[obj.GetNamespace()] {
+				continue
+			}
+			handled, err := s.sweepObject(ctx, obj, configs, adoptInto, previous)
+			if err != nil {
+				return err
+			}
+			if handled {
+				swept++
+			}
+		}
+	}
+
+	if pending := len(s.suspects) - swept; pending > 0 {
+		log.Info("Found orphaned objects, confirming on the next sweep", "count", pending, "after", s.confirmDelay())
+	}
+	if swept > 0 {
+		if adoptInto != "" {
+			log.Info("Adopted orphaned objects", "count", swept, "config", adoptInto)
+		} else {
+			log.Info("Deleted orphaned objects", "count", swept)
+		}
+	}
+	return nil
+}
+
+// sweepObject deletes or adopts a single object when its owning config is missing and the previous
+// sweep found it orphaned too, reporting whether it acted. Objects younger than the time between
+// sweeps are left alone, since their config may have been created after the config list was read
+func (s *OrphanSweeper) sweepObject(ctx context.Context, obj client.Object, configs map[string]bool, adoptInto string,
+	previous map[types.UID]bool) (bool, error) {
+	ownerLabel, owner := orphanOwner(obj)
+	if owner == "" || configs[owner] {
+		return false, nil
+	}
+	if time.Since(obj.GetCreationTimestamp().Time) < s.confirmDelay() {
+		return false, nil
+	}
+	s.suspects[obj.GetUID()] = true
+	if !previous[obj.GetUID()] {
+		return false, nil
+	}
+
+	if adoptInto != "" {
+		patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+		labels := obj.GetLabels()
+		labels[ownerLabel] = adoptInto
+		obj.SetLabels(labels)
+		if err := s.Patch(ctx, obj, patch); err != nil && !errors.IsNotFound(err) {
+			return false, fmt.Errorf("failed to adopt orphaned %s %s: %w", obj.GetObjectKind().GroupVersionKind().Kind, obj.GetName(), err)
+		}
+		return true, nil
+	}
+
+	propagation := metav1.DeletePropagationBackground
+	if err := s.Delete(ctx, obj, client.PropagationPolicy(propagation)); err != nil && !errors.IsNotFound(err) {
+		return false, fmt.Errorf("failed to delete orphaned %s %s: %w", obj.GetObjectKind().GroupVersionKind().Kind, obj.GetName(), err)
+	}
+	return true, nil
+}
+
+// orphanOwner returns the label naming the owning config and its value; ACM cluster
+// namespaces carry their own owner label
+func orphanOwner(obj client.Object) (string, string) {
+	labels := obj.GetLabels()
+	if owner := labels["scale.openshift.io/managed-by"]; owner != "" {
+		return "scale.openshift.io/managed-by", owner
+	}
+	if owner := labels[acmNamespaceLabel]; owner != "" {
+		return acmNamespaceLabel, owner
+	}
+	return "", ""
+}
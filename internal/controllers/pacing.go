@@ -0,0 +1,151 @@
+package controllers
+
+import (
+	"context"
+	mathrand "math/rand"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+// requestPacer spaces write requests at the effective API call rate so a reconcile produces
+// smooth traffic instead of microbursts that trip API Priority and Fairness
+type requestPacer struct {
+	mu            sync.Mutex
+	limiter       *rate.Limiter
+	jitterPercent int32
+}
+
+// newRequestPacer returns a pacer that lets every request through until it is configured
+func newRequestPacer() *requestPacer {
+	return &requestPacer{limiter: rate.NewLimiter(rate.Inf, 1)}
+}
+
+// configure sets the pace from the config's pacing settings and its effective calls per minute
+func (p *requestPacer) configure(config *scalev1.ScaleLoadConfig, callsPerMinute int32) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	pacing := config.Spec.Pacing
+	if !pacing.Enabled || callsPerMinute <= 0 {
+		p.limiter.SetLimit(rate.Inf)
+		p.jitterPercent = 0
+		return
+	}
+
+	// Writes are only part of the target rate, so pacing them at the full rate never holds
+	// the operator below its target; a burst of a tenth of a second absorbs scheduling noise
+	perSecond := float64(callsPerMinute) / 60
+	burst := int(perSecond / 10)
+	if burst < 1 {
+		burst = 1
+	}
+	p.limiter.SetLimit(rate.Limit(perSecond))
+	p.limiter.SetBurst(burst)
+	p.jitterPercent = pacing.JitterPercent
+}
+
+// configureCleanup paces requests at perSecond while a config's load is removed, even when the
+// config does not pace its load; zero lets them through unpaced
+func (p *requestPacer) configureCleanup(perSecond int32) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.jitterPercent = 0
+	if perSecond <= 0 {
+		p.limiter.SetLimit(rate.Inf)
+		return
+	}
+	p.limiter.SetLimit(rate.Limit(perSecond))
+	p.limiter.SetBurst(max(int(perSecond/10), 1))
+}
+
+// wait blocks until the next request may be sent, adding jitter so requests do not align
+func (p *requestPacer) wait(ctx context.Context) error {
+	if p == nil {
+		return nil
+	}
+	p.mu.Lock()
+	limiter := p.limiter
+	limit := limiter.Limit()
+	jitterPercent := p.jitterPercent
+	p.mu.Unlock()
+
+	if err := limiter.Wait(ctx); err != nil {
+		return err
+	}
+	if limit == rate.Inf || limit <= 0 || jitterPercent <= 0 {
+		return nil
+	}
+
+	gap := time.Duration(float64(time.Second) / float64(limit))
+	jitter := time.Duration(mathrand.Int63n(int64(gap)*int64(jitterPercent)/100 + 1))
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(jitter):
+		return nil
+	}
+}
+
+// responsive reports whether the pacer's settings can be read within timeout. A wait that never
+// returns leaks the goroutine, which matters little once the probe has the process restarted
+func (p *requestPacer) responsive(timeout time.Duration) bool {
+	done := make(chan struct{})
+	go func() {
+		p.mu.Lock()
+		p.limiter.Limit()
+		p.mu.Unlock()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
+
+// pacedClient waits on a requestPacer before every write; reads go straight to the cache
+type pacedClient struct {
+	client.Client
+	pacer *requestPacer
+}
+
+// withPacing wraps a client so its writes are paced, returning the pacer to configure
+func withPacing(c client.Client) (client.Client, *requestPacer) {
+	pacer := newRequestPacer()
+	return &pacedClient{Client: c, pacer: pacer}, pacer
+}
+
+func (c *pacedClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	if err := c.pacer.wait(ctx); err != nil {
+		return err
+	}
+	return c.Client.Create(ctx, obj, opts...)
+}
+
+func (c *pacedClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	if err := c.pacer.wait(ctx); err != nil {
+		return err
+	}
+	return c.Client.Update(ctx, obj, opts...)
+}
+
+func (c *pacedClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	if err := c.pacer.wait(ctx); err != nil {
+		return err
+	}
+	return c.Client.Patch(ctx, obj, patch, opts...)
+}
+
+func (c *pacedClient) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	if err := c.pacer.wait(ctx); err != nil {
+		return err
+	}
+	return c.Client.Delete(ctx, obj, opts...)
+}
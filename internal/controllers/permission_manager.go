@@ -0,0 +1,289 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+// permissionRecheckInterval controls how often cached access review results are refreshed
+const permissionRecheckInterval = 10 * time.Minute
+
+// permissionCheck describes the access a feature needs and how to turn it off when denied
+type permissionCheck struct {
+	feature     string
+	group       string
+	resource    string
+	subresource string
+	verb        string
+	enabled     func(config *scalev1.ScaleLoadConfig) bool
+	disable     func(config *scalev1.ScaleLoadConfig)
+}
+
+// permissionResult caches the outcome of access reviews for one ScaleLoadConfig
+type permissionResult struct {
+	generation int64
+	checkedAt  time.Time
+	denied     []string
+}
+
+var permissionChecks = []permissionCheck{
+	{
+		feature: "configMaps", resource: "configmaps", verb: "create",
+		enabled: func(c *scalev1.ScaleLoadConfig) bool { return c.Spec.ResourceChurn.ConfigMaps.Enabled },
+		disable: func(c *scalev1.ScaleLoadConfig) { c.Spec.ResourceChurn.ConfigMaps.Enabled = false },
+	},
+	{
+		feature: "secrets", resource: "secrets", verb: "create",
+		enabled: func(c *scalev1.ScaleLoadConfig) bool { return c.Spec.ResourceChurn.Secrets.Enabled },
+		disable: func(c *scalev1.ScaleLoadConfig) { c.Spec.ResourceChurn.Secrets.Enabled = false },
+	},
+	{
+		feature: "routes", group: "route.openshift.io", resource: "routes", verb: "create",
+		enabled: func(c *scalev1.ScaleLoadConfig) bool { return c.Spec.ResourceChurn.Routes.Enabled },
+		disable: func(c *scalev1.ScaleLoadConfig) { c.Spec.ResourceChurn.Routes.Enabled = false },
+	},
+	{
+		// Routes are backed by generated Services
+		feature: "routes", resource: "services", verb: "create",
+		enabled: func(c *scalev1.ScaleLoadConfig) bool { return c.Spec.ResourceChurn.Routes.Enabled },
+		disable: func(c *scalev1.ScaleLoadConfig) { c.Spec.ResourceChurn.Routes.Enabled = false },
+	},
+	{
+		feature: "imageStreams", group: "image.openshift.io", resource: "imagestreams", verb: "create",
+		enabled: func(c *scalev1.ScaleLoadConfig) bool { return c.Spec.ResourceChurn.ImageStreams.Enabled },
+		disable: func(c *scalev1.ScaleLoadConfig) { c.Spec.ResourceChurn.ImageStreams.Enabled = false },
+	},
+	{
+		feature: "buildConfigs", group: "build.openshift.io", resource: "buildconfigs", verb: "create",
+		enabled: func(c *scalev1.ScaleLoadConfig) bool { return c.Spec.ResourceChurn.BuildConfigs.Enabled },
+		disable: func(c *scalev1.ScaleLoadConfig) { c.Spec.ResourceChurn.BuildConfigs.Enabled = false },
+	},
+	{
+		// Build pushes write the status of the output ImageStreams
+		feature: "buildPushes", group: "image.openshift.io", resource: "imagestreams", subresource: "status", verb: "patch",
+		enabled: func(c *scalev1.ScaleLoadConfig) bool {
+			churn := c.Spec.ResourceChurn
+			return churn.BuildPushes.Enabled && churn.BuildConfigs.Enabled && churn.ImageStreams.Enabled
+		},
+		disable: func(c *scalev1.ScaleLoadConfig) { c.Spec.ResourceChurn.BuildPushes.Enabled = false },
+	},
+	{
+		feature: "events", resource: "events", verb: "create",
+		enabled: func(c *scalev1.ScaleLoadConfig) bool { return c.Spec.ResourceChurn.Events.Enabled },
+		disable: func(c *scalev1.ScaleLoadConfig) { c.Spec.ResourceChurn.Events.Enabled = false },
+	},
+	{
+		feature: "pods", resource: "pods", verb: "create",
+		enabled: func(c *scalev1.ScaleLoadConfig) bool { return c.Spec.ResourceChurn.Pods.Enabled },
+		disable: func(c *scalev1.ScaleLoadConfig) { c.Spec.ResourceChurn.Pods.Enabled = false },
+	},
+	{
+		feature: "completedPods", resource: "pods", verb: "create",
+		enabled: func(c *scalev1.ScaleLoadConfig) bool { return c.Spec.ResourceChurn.CompletedPods.Enabled },
+		disable: func(c *scalev1.ScaleLoadConfig) { c.Spec.ResourceChurn.CompletedPods.Enabled = false },
+	},
+	{
+		feature: "networkPolicies", group: "networking.k8s.io", resource: "networkpolicies", verb: "create",
+		enabled: func(c *scalev1.ScaleLoadConfig) bool { return c.Spec.ResourceChurn.NetworkPolicies.Enabled },
+		disable: func(c *scalev1.ScaleLoadConfig) { c.Spec.ResourceChurn.NetworkPolicies.Enabled = false },
+	},
+	{
+		feature: "etcdPressure", resource: "configmaps", verb: "create",
+		enabled: func(c *scalev1.ScaleLoadConfig) bool { return c.Spec.EtcdPressure.Enabled },
+		disable: func(c *scalev1.ScaleLoadConfig) { c.Spec.EtcdPressure.Enabled = false },
+	},
+	{
+		feature: "appBundles", group: "apps", resource: "deployments", verb: "create",
+		enabled: func(c *scalev1.ScaleLoadConfig) bool { return c.Spec.ResourceChurn.AppBundles.Enabled },
+		disable: func(c *scalev1.ScaleLoadConfig) { c.Spec.ResourceChurn.AppBundles.Enabled = false },
+	},
+	{
+		// Bundle pods run under their own ServiceAccount
+		feature: "appBundles", resource: "serviceaccounts", verb: "create",
+		enabled: func(c *scalev1.ScaleLoadConfig) bool { return c.Spec.ResourceChurn.AppBundles.Enabled },
+		disable: func(c *scalev1.ScaleLoadConfig) { c.Spec.ResourceChurn.AppBundles.Enabled = false },
+	},
+	{
+		feature: "appBundles", group: "networking.k8s.io", resource: "ingresses", verb: "create",
+		enabled: func(c *scalev1.ScaleLoadConfig) bool {
+			return c.Spec.ResourceChurn.AppBundles.Enabled && c.Spec.ResourceChurn.AppBundles.Exposure == scalev1.AppBundleExposureIngress
+		},
+		disable: func(c *scalev1.ScaleLoadConfig) { c.Spec.ResourceChurn.AppBundles.Enabled = false },
+	},
+	{
+		feature: "daemonSets", group: "apps", resource: "daemonsets", verb: "create",
+		enabled: func(c *scalev1.ScaleLoadConfig) bool { return c.Spec.ResourceChurn.DaemonSets.Enabled },
+		disable: func(c *scalev1.ScaleLoadConfig) { c.Spec.ResourceChurn.DaemonSets.Enabled = false },
+	},
+	{
+		feature: "namespaceChurn", resource: "namespaces", verb: "delete",
+		enabled: func(c *scalev1.ScaleLoadConfig) bool {
+			return c.Spec.ResourceChurn.Namespaces.Enabled && !isNamespaceScoped(c)
+		},
+		disable: func(c *scalev1.ScaleLoadConfig) { c.Spec.ResourceChurn.Namespaces.Enabled = false },
+	},
+	{
+		feature: "annotationChurn", resource: "nodes", verb: "patch",
+		enabled: func(c *scalev1.ScaleLoadConfig) bool {
+			return c.Spec.AnnotationChurn.Enabled && !isNamespaceScoped(c)
+		},
+		disable: func(c *scalev1.ScaleLoadConfig) { c.Spec.AnnotationChurn.Enabled = false },
+	},
+	{
+		feature: "namespaceAnnotationChurn", resource: "namespaces", verb: "patch",
+		enabled: func(c *scalev1.ScaleLoadConfig) bool {
+			return c.Spec.NamespaceAnnotationChurn.Enabled && !isNamespaceScoped(c)
+		},
+		disable: func(c *scalev1.ScaleLoadConfig) { c.Spec.NamespaceAnnotationChurn.Enabled = false },
+	},
+	{
+		feature: "topology", resource: "nodes", verb: "patch",
+		enabled: func(c *scalev1.ScaleLoadConfig) bool {
+			return c.Spec.Topology.Enabled && !isNamespaceScoped(c)
+		},
+		disable: func(c *scalev1.ScaleLoadConfig) { c.Spec.Topology.Enabled = false },
+	},
+	{
+		feature: "nodeManagement", resource: "nodes", verb: "create",
+		enabled: func(c *scalev1.ScaleLoadConfig) bool {
+			return c.Spec.NodeManagement.Enabled && !isNamespaceScoped(c)
+		},
+		disable: func(c *scalev1.ScaleLoadConfig) { c.Spec.NodeManagement.Enabled = false },
+	},
+	{
+		feature: "mirrorPods", resource: "pods", verb: "create",
+		enabled: func(c *scalev1.ScaleLoadConfig) bool {
+			return c.Spec.MirrorPods.Enabled && !isNamespaceScoped(c)
+		},
+		disable: func(c *scalev1.ScaleLoadConfig) { c.Spec.MirrorPods.Enabled = false },
+	},
+	{
+		feature: "controllerLeases", group: "coordination.k8s.io", resource: "leases", verb: "update",
+		enabled: func(c *scalev1.ScaleLoadConfig) bool { return c.Spec.ControllerLeases.Enabled },
+		disable: func(c *scalev1.ScaleLoadConfig) { c.Spec.ControllerLeases.Enabled = false },
+	},
+	{
+		feature: "janitor", group: "apps", resource: "replicasets", verb: "delete",
+		enabled: func(c *scalev1.ScaleLoadConfig) bool { return c.Spec.Janitor.Enabled },
+		disable: func(c *scalev1.ScaleLoadConfig) { c.Spec.Janitor.Enabled = false },
+	},
+	{
+		feature: "argoCDSimulation", group: "argoproj.io", resource: "applications", verb: "create",
+		enabled: func(c *scalev1.ScaleLoadConfig) bool { return c.Spec.ArgoCDSimulation.Enabled },
+		disable: func(c *scalev1.ScaleLoadConfig) { c.Spec.ArgoCDSimulation.Enabled = false },
+	},
+	{
+		feature: "acmSimulation", group: "cluster.open-cluster-management.io", resource: "managedclusters", verb: "create",
+		enabled: func(c *scalev1.ScaleLoadConfig) bool {
+			return c.Spec.ACMSimulation.Enabled && !isNamespaceScoped(c)
+		},
+		disable: func(c *scalev1.ScaleLoadConfig) { c.Spec.ACMSimulation.Enabled = false },
+	},
+	{
+		feature: "alertSimulation", group: "monitoring.coreos.com", resource: "prometheusrules", verb: "create",
+		enabled: func(c *scalev1.ScaleLoadConfig) bool {
+			return c.Spec.AlertSimulation.Enabled && c.Spec.AlertSimulation.Mode != alertModeAlertmanager
+		},
+		disable: func(c *scalev1.ScaleLoadConfig) { c.Spec.AlertSimulation.Enabled = false },
+	},
+}
+
+// applyPermissionChecks disables, on the in-memory config only, every enabled feature the
+// operator lacks RBAC for, so trimmed-down installations do not fail repeatedly
+func (r *ScaleLoadConfigReconciler) applyPermissionChecks(ctx context.Context, config *scalev1.ScaleLoadConfig) {
+	log := r.Log.WithName("permission-manager")
+
+	if r.permissionResults == nil {
+		r.permissionResults = make(map[string]*permissionResult)
+	}
+
+	result, exists := r.permissionResults[config.Name]
+	if !exists || result.generation != config.Generation || time.Since(result.checkedAt) > permissionRecheckInterval {
+		denied, err := r.reviewPermissions(ctx, config)
+		if err != nil {
+			// Leave features enabled and fall back to surfacing errors per operation
+			log.Error(err, "Failed to review operator permissions, continuing with all features enabled")
+			return
+		}
+		result = &permissionResult{generation: config.Generation, checkedAt: time.Now(), denied: denied}
+		r.permissionResults[config.Name] = result
+
+		if len(denied) > 0 {
+			log.Info("Disabling features the operator lacks permissions for", "features", denied)
+		}
+	}
+
+	deniedSet := make(map[string]bool, len(result.denied))
+	for _, feature := range result.denied {
+		deniedSet[feature] = true
+	}
+	for _, check := range permissionChecks {
+		if deniedSet[check.feature] {
+			check.disable(config)
+		}
+	}
+}
+
+// reviewPermissions runs a SelfSubjectAccessReview for each enabled feature and returns the denied features
+func (r *ScaleLoadConfigReconciler) reviewPermissions(ctx context.Context, config *scalev1.ScaleLoadConfig) ([]string, error) {
+	// Namespaced mode only has rights inside the selected namespaces, so check against one of them
+	namespace := ""
+	if isNamespaceScoped(config) {
+		namespaces, err := r.getScopedNamespaces(ctx, config)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list scoped namespaces: %w", err)
+		}
+		if len(namespaces) > 0 {
+			namespace = namespaces[0].Name
+		}
+	}
+
+	var denied []string
+	seen := make(map[string]bool)
+	for _, check := range permissionChecks {
+		if seen[check.feature] || !check.enabled(config) {
+			continue
+		}
+
+		reviewNamespace := namespace
+		if check.resource == "namespaces" || check.resource == "nodes" {
+			reviewNamespace = ""
+		}
+
+		review := &authorizationv1.SelfSubjectAccessReview{
+			Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+				ResourceAttributes: &authorizationv1.ResourceAttributes{
+					Namespace:   reviewNamespace,
+					Verb:        check.verb,
+					Group:       check.group,
+					Resource:    check.resource,
+					Subresource: check.subresource,
+				},
+			},
+		}
+		if err := r.Create(ctx, review); err != nil {
+			return nil, fmt.Errorf("failed to review %s access to %s: %w", check.verb, check.resource, err)
+		}
+		r.recordAPICall(config, 1)
+
+		if !review.Status.Allowed {
+			denied = append(denied, check.feature)
+			seen[check.feature] = true
+		}
+	}
+
+	return denied, nil
+}
+
+// deniedFeatures returns the features disabled for a config by the last permission review
+func (r *ScaleLoadConfigReconciler) deniedFeatures(configName string) []string {
+	if result, exists := r.permissionResults[configName]; exists {
+		return result.denied
+	}
+	return nil
+}
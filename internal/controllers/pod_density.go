@@ -0,0 +1,227 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+// applyPodDensity turns resourceChurn.pods.podsPerNode into a per-namespace pod count and a total
+// cap on the in-memory config, so the resource pass and the status targets size pods by node count.
+// The total never exceeds the pods the KWOK nodes can hold, so a density change cannot flood the
+// scheduler with pods that stay pending. Scheduled and pending pods are recorded for status. Density
+// counts the nodes namespaceConfig.nodeSelector pins the namespaces to; with pools, each pool is
+// sized from the nodes its own nodeSelector pins it to
+func (r *ScaleLoadConfigReconciler) applyPodDensity(ctx context.Context, config *scalev1.ScaleLoadConfig,
+	nodes []corev1.Node, targetNamespaces int) error {
+
+	if len(config.Spec.Pools) > 0 {
+		return r.applyPoolPodDensity(ctx, config, nodes)
+	}
+
+	pods := &config.Spec.ResourceChurn.Pods
+	if !pods.Enabled || pods.PodsPerNode == 0 {
+		delete(r.podDensity, config.Name)
+		return nil
+	}
+
+	status, total := podDensityTarget(pods, nodesMatching(nodes, config.NamespaceNodeSelector(nil)))
+
+	var scoped []corev1.Namespace
+	podNamespaces := 0
+	if isNamespaceScoped(config) {
+		var err error
+		if scoped, err = r.getScopedNamespaces(ctx, config); err != nil {
+			return fmt.Errorf("failed to list scoped namespaces: %w", err)
+		}
+		r.recordAPICall(config, 1)
+		podNamespaces = len(scoped)
+	} else {
+		namespaces := targetNamespaces
+		if churn := config.Spec.ResourceChurn.Namespaces; churn.Enabled && churn.Maximum > 0 {
+			namespaces = min(namespaces, int(churn.Maximum))
+		}
+		podNamespaces = targetedNamespaceCount(namespaces, pods.NamespaceInterval, pods.NamespaceTargeting)
+	}
+	spreadPods(pods, total, podNamespaces)
+	pods.Maximum = podMaximum(total)
+
+	return r.recordPodDensity(ctx, config, scoped, status)
+}
+
+// applyPoolPodDensity sizes the pods of every pool that sets podsPerNode from the nodes the pool is
+// pinned to. Pools without their own resourceChurn get a copy of the config's, so each pool holds
+// its own count. The pod maximum is checked across all of the config's namespaces, so each pool's
+// maximum is set to the sum of the pools' targets. Status sums the pools: their targets, the
+// allocatable pods of every node they are pinned to, and the highest density any of them asks for
+func (r *ScaleLoadConfigReconciler) applyPoolPodDensity(ctx context.Context, config *scalev1.ScaleLoadConfig,
+	nodes []corev1.Node) error {
+
+	status := &scalev1.PodDensityStatus{}
+	pinned := make(map[string]corev1.Node)
+	var sized []*scalev1.PodConfig
+	var total int64
+	for i := range config.Spec.Pools {
+		pool := &config.Spec.Pools[i]
+		if pool.ResourceChurn == nil {
+			pool.ResourceChurn = config.Spec.ResourceChurn.DeepCopy()
+		}
+		pods := &pool.ResourceChurn.Pods
+		if !pods.Enabled || pods.PodsPerNode == 0 {
+			continue
+		}
+
+		poolNodes := nodesMatching(nodes, config.NamespaceNodeSelector(pool))
+		for _, node := range poolNodes {
+			pinned[node.Name] = node
+		}
+		poolStatus, poolTotal := podDensityTarget(pods, poolNodes)
+		status.RequestedPodsPerNode = max(status.RequestedPodsPerNode, poolStatus.RequestedPodsPerNode)
+		if status.CappedBy == "" {
+			status.CappedBy = poolStatus.CappedBy
+		}
+		total += poolTotal
+
+		spreadPods(pods, poolTotal, targetedNamespaceCount(int(pool.Namespaces), pods.NamespaceInterval, pods.NamespaceTargeting))
+		sized = append(sized, pods)
+	}
+	if len(sized) == 0 {
+		delete(r.podDensity, config.Name)
+		return nil
+	}
+
+	for _, pods := range sized {
+		pods.Maximum = podMaximum(total)
+	}
+	pinnedNodes := make([]corev1.Node, 0, len(pinned))
+	for _, node := range pinned {
+		pinnedNodes = append(pinnedNodes, node)
+	}
+	status.AllocatablePods = allocatablePods(pinnedNodes)
+	status.TargetPods = int32(total)
+
+	return r.recordPodDensity(ctx, config, nil, status)
+}
+
+// podDensityTarget returns the density status for the nodes and the pod total it calls for, capped
+// at the nodes' allocatable pods and at the pod maximum
+func podDensityTarget(pods *scalev1.PodConfig, nodes []corev1.Node) (*scalev1.PodDensityStatus, int64) {
+	status := &scalev1.PodDensityStatus{
+		RequestedPodsPerNode: pods.PodsPerNode,
+		AllocatablePods:      allocatablePods(nodes),
+	}
+	total := int64(pods.PodsPerNode) * int64(len(nodes))
+	if total > status.AllocatablePods {
+		total = status.AllocatablePods
+		status.CappedBy = "allocatable"
+	}
+	if pods.Maximum > 0 && total > int64(pods.Maximum) {
+		total = int64(pods.Maximum)
+		status.CappedBy = "maximum"
+	}
+	status.TargetPods = int32(total)
+	return status, total
+}
+
+// spreadPods sets the per-namespace count that spreads total pods evenly over the namespaces.
+// Rounding up fills every namespace; the maximum holds the total at the density target
+func spreadPods(pods *scalev1.PodConfig, total int64, namespaces int) {
+	pods.Count = 0
+	if namespaces > 0 {
+		pods.Count = int32((total + int64(namespaces) - 1) / int64(namespaces))
+	}
+}
+
+// podMaximum returns the pod maximum that holds the pods at total
+func podMaximum(total int64) int32 {
+	if total == 0 {
+		// A zero Maximum means no limit, so keep it at one pod when Count already creates none
+		return 1
+	}
+	return int32(total)
+}
+
+// recordPodDensity counts the scheduled and pending pods and records the density for status
+func (r *ScaleLoadConfigReconciler) recordPodDensity(ctx context.Context, config *scalev1.ScaleLoadConfig,
+	scoped []corev1.Namespace, status *scalev1.PodDensityStatus) error {
+
+	scheduled, pending, err := r.countPodScheduling(ctx, config, scoped)
+	if err != nil {
+		return err
+	}
+	status.ScheduledPods, status.PendingPods = scheduled, pending
+
+	if r.podDensity == nil {
+		r.podDensity = make(map[string]*scalev1.PodDensityStatus)
+	}
+	r.podDensity[config.Name] = status
+	return nil
+}
+
+// allocatablePods sums the pods the nodes can hold, assuming the kubelet default for nodes that report none
+func allocatablePods(nodes []corev1.Node) int64 {
+	var total int64
+	for i := range nodes {
+		pods := nodes[i].Status.Allocatable.Pods().Value()
+		if pods <= 0 {
+			pods = scalev1.DefaultNodeAllocatablePods
+		}
+		total += pods
+	}
+	return total
+}
+
+// countPodScheduling counts the config's generated pods that are bound to a node and those still
+// waiting for the scheduler. Namespaced mode lists each scoped namespace, since the operator may
+// not be allowed to list pods cluster-wide
+func (r *ScaleLoadConfigReconciler) countPodScheduling(ctx context.Context, config *scalev1.ScaleLoadConfig,
+	scoped []corev1.Namespace) (int32, int32, error) {
+
+	labels := client.MatchingLabels{
+		"scale.openshift.io/managed-by":    config.Name,
+		"scale.openshift.io/resource-type": "pod",
+	}
+	var lists []*corev1.PodList
+	if isNamespaceScoped(config) {
+		for _, namespace := range scoped {
+			list := &corev1.PodList{}
+			if err := r.List(ctx, list, client.InNamespace(namespace.Name), labels); err != nil {
+				return 0, 0, fmt.Errorf("failed to list pods in %s: %w", namespace.Name, err)
+			}
+			r.recordAPICall(config, 1)
+			lists = append(lists, list)
+		}
+	} else {
+		list := &corev1.PodList{}
+		if err := r.List(ctx, list, labels); err != nil {
+			return 0, 0, fmt.Errorf("failed to list pods: %w", err)
+		}
+		r.recordAPICall(config, 1)
+		lists = append(lists, list)
+	}
+
+	var scheduled, pending int32
+	for _, list := range lists {
+		for i := range list.Items {
+			if list.Items[i].Spec.NodeName != "" {
+				scheduled++
+			} else if list.Items[i].DeletionTimestamp == nil {
+				pending++
+			}
+		}
+	}
+	return scheduled, pending, nil
+}
+
+// podDensityStatus returns the density recorded for a config, or nil when it sets no podsPerNode
+func (r *ScaleLoadConfigReconciler) podDensityStatus(config *scalev1.ScaleLoadConfig) *scalev1.PodDensityStatus {
+	status, ok := r.podDensity[config.Name]
+	if !ok {
+		return nil
+	}
+	return status.DeepCopy()
+}
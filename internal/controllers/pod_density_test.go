@@ -0,0 +1,92 @@
+package controllers_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+	"github.com/jtaleric/sim-operator/pkg/simtest"
+)
+
+func TestScaleLoadConfigReconciler_PodDensity(t *testing.T) {
+	ctx := context.Background()
+	h := simtest.NewFake(t, simtest.Options{}, simtest.KwokNodes(4)...)
+
+	config := newTestConfig("density")
+	config.Spec.ResourceChurn.Pods = scalev1.PodConfig{Enabled: true, PodsPerNode: 3}
+	if err := h.Apply(ctx, config); err != nil {
+		t.Fatal(err)
+	}
+	// Three namespaces hold four pods each; passes after the first find the target reached and keep it
+	if err := h.ReconcilePasses(ctx, "density", 3); err != nil {
+		t.Fatal(err)
+	}
+
+	set, err := h.Generated(ctx, "density")
+	if err != nil {
+		t.Fatal(err)
+	}
+	simtest.AssertCounts(t, set, map[string]int{"pod": 12})
+
+	if err := h.Client.Get(ctx, client.ObjectKey{Name: "density"}, config); err != nil {
+		t.Fatal(err)
+	}
+	if density := config.Status.PodDensity; density == nil || density.TargetPods != 12 {
+		t.Errorf("Expected a pod density target of 12, got %+v", density)
+	}
+}
+
+func TestScaleLoadConfigReconciler_PoolPodDensity(t *testing.T) {
+	ctx := context.Background()
+	// One infra node and three worker nodes
+	nodes := simtest.KwokNodes(4)
+	for i, node := range nodes {
+		role := "node-role.kubernetes.io/worker"
+		if i == 0 {
+			role = "node-role.kubernetes.io/infra"
+		}
+		node.(*corev1.Node).Labels[role] = ""
+	}
+	h := simtest.NewFake(t, simtest.Options{}, nodes...)
+
+	config := newTestConfig("density")
+	config.Spec.ResourceChurn.Pods = scalev1.PodConfig{Enabled: true, PodsPerNode: 2}
+	config.Spec.Pools = []scalev1.NamespacePool{
+		{Name: "infra", Namespaces: 1, NodeSelector: map[string]string{"node-role.kubernetes.io/infra": ""}},
+		{Name: "worker", Namespaces: 2, NodeSelector: map[string]string{"node-role.kubernetes.io/worker": ""}},
+	}
+	if err := h.Apply(ctx, config); err != nil {
+		t.Fatal(err)
+	}
+	if err := h.ReconcilePasses(ctx, "density", 3); err != nil {
+		t.Fatal(err)
+	}
+
+	// Each pool gets two pods for every node it is pinned to
+	set, err := h.Generated(ctx, "density")
+	if err != nil {
+		t.Fatal(err)
+	}
+	perPool := map[string]int{}
+	for _, pod := range set["pod"] {
+		for _, pool := range []string{"infra", "worker"} {
+			if strings.Contains(pod.GetNamespace(), "-"+pool+"-") {
+				perPool[pool]++
+			}
+		}
+	}
+	if perPool["infra"] != 2 || perPool["worker"] != 6 {
+		t.Errorf("Expected 2 infra and 6 worker pods, got %v", perPool)
+	}
+
+	if err := h.Client.Get(ctx, client.ObjectKey{Name: "density"}, config); err != nil {
+		t.Fatal(err)
+	}
+	if density := config.Status.PodDensity; density == nil || density.TargetPods != 8 || density.AllocatablePods != 1000 {
+		t.Errorf("Expected a pod density target of 8 on 1000 allocatable pods, got %+v", density)
+	}
+}
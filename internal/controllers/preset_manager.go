@@ -0,0 +1,57 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+// applyLoadProfilePreset copies the sections of the referenced LoadProfilePreset onto the in-memory config
+func (r *ScaleLoadConfigReconciler) applyLoadProfilePreset(ctx context.Context, config *scalev1.ScaleLoadConfig) error {
+	if config.Spec.Preset == "" {
+		return nil
+	}
+
+	preset := &scalev1.LoadProfilePreset{}
+	if err := r.Get(ctx, types.NamespacedName{Name: config.Spec.Preset}, preset); err != nil {
+		return fmt.Errorf("failed to get LoadProfilePreset %s: %w", config.Spec.Preset, err)
+	}
+	r.recordAPICall(config, 1)
+
+	if preset.Spec.LoadProfile != nil {
+		config.Spec.LoadProfile = *preset.Spec.LoadProfile.DeepCopy()
+	}
+	if preset.Spec.ResourceChurn != nil {
+		config.Spec.ResourceChurn = *preset.Spec.ResourceChurn.DeepCopy()
+	}
+	if preset.Spec.AnnotationChurn != nil {
+		config.Spec.AnnotationChurn = *preset.Spec.AnnotationChurn.DeepCopy()
+	}
+	if preset.Spec.ReconcileInterval != nil && config.Spec.ReconcileInterval == nil {
+		config.Spec.ReconcileInterval = preset.Spec.ReconcileInterval.DeepCopy()
+	}
+
+	r.Log.WithName("preset-manager").V(1).Info("Applied LoadProfilePreset", "preset", preset.Name)
+	return nil
+}
+
+// configsForPreset maps a LoadProfilePreset change to every ScaleLoadConfig that references it
+func (r *ScaleLoadConfigReconciler) configsForPreset(ctx context.Context, obj client.Object) []reconcile.Request {
+	configList := &scalev1.ScaleLoadConfigList{}
+	if err := r.List(ctx, configList); err != nil {
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, config := range configList.Items {
+		if config.Spec.Preset == obj.GetName() {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: config.Name}})
+		}
+	}
+	return requests
+}
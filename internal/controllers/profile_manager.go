@@ -0,0 +1,144 @@
+package controllers
+
+import (
+	"math"
+	"strconv"
+	"time"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+// applyLoadProfile copies the values of the selected load profile onto the in-memory config,
+// so the rest of the reconcile reads a fully resolved spec
+func (r *ScaleLoadConfigReconciler) applyLoadProfile(config *scalev1.ScaleLoadConfig) {
+	log := r.Log.WithName("profile-manager")
+
+	profile, err := config.ResolveLoadProfile()
+	if err != nil {
+		// Webhooks may be disabled, so fall back to the spec as written
+		log.Error(err, "Failed to resolve load profile, using loadProfile fields as written")
+		return
+	}
+	if profile == nil {
+		return
+	}
+
+	loadProfile := &config.Spec.LoadProfile
+	if profile.NamespacesPerNode != nil {
+		namespacesPerNode := *profile.NamespacesPerNode
+		loadProfile.NamespacesPerNode = &namespacesPerNode
+	}
+	if profile.APICallRatePerNode != nil && loadProfile.APICallRateStatic == nil {
+		ratePerNode := *profile.APICallRatePerNode
+		loadProfile.APICallRatePerNode = &ratePerNode
+	}
+	if profile.ReconcileInterval != nil && config.Spec.ReconcileInterval == nil {
+		config.Spec.ReconcileInterval = profile.ReconcileInterval.DeepCopy()
+	}
+	if profile.ChurnMultiplier != nil {
+		multiplier, err := strconv.ParseFloat(*profile.ChurnMultiplier, 64)
+		if err != nil || multiplier <= 0 {
+			log.Info("Ignoring invalid churn multiplier", "profile", profile.Name, "churnMultiplier", *profile.ChurnMultiplier)
+		} else {
+			scaleChurn(config, multiplier)
+		}
+	}
+
+	log.V(1).Info("Applied load profile", "profile", profile.Name)
+}
+
+// scaleChurn divides churn update intervals, including archetype overrides, and multiplies event rates by the given multiplier
+func scaleChurn(config *scalev1.ScaleLoadConfig, multiplier float64) {
+	if multiplier == 1 {
+		return
+	}
+
+	churn := &config.Spec.ResourceChurn
+	for _, resource := range []*scalev1.ResourceTypeConfig{
+		&churn.ConfigMaps, &churn.Secrets, &churn.Routes, &churn.ImageStreams, &churn.BuildConfigs,
+	} {
+		resource.UpdateFrequencyMin = scaleInterval(resource.UpdateFrequencyMin, multiplier)
+		resource.UpdateFrequencyMax = scaleInterval(resource.UpdateFrequencyMax, multiplier)
+	}
+	churn.Pods.UpdateFrequencyMin = scaleInterval(churn.Pods.UpdateFrequencyMin, multiplier)
+	churn.Pods.UpdateFrequencyMax = scaleInterval(churn.Pods.UpdateFrequencyMax, multiplier)
+	churn.Events.EventsPerNodePerHour = int32(math.Round(float64(churn.Events.EventsPerNodePerHour) * multiplier))
+
+	config.Spec.AnnotationChurn.UpdateIntervalMin = scaleInterval(config.Spec.AnnotationChurn.UpdateIntervalMin, multiplier)
+	config.Spec.AnnotationChurn.UpdateIntervalMax = scaleInterval(config.Spec.AnnotationChurn.UpdateIntervalMax, multiplier)
+
+	namespaceAnnotations := &config.Spec.NamespaceAnnotationChurn
+	namespaceAnnotations.UpdateIntervalMin = scaleInterval(namespaceAnnotations.UpdateIntervalMin, multiplier)
+	namespaceAnnotations.UpdateIntervalMax = scaleInterval(namespaceAnnotations.UpdateIntervalMax, multiplier)
+
+	// Archetype intervals replace the ResourceChurn ones in their namespaces, so they scale too
+	for i := range config.Spec.NamespaceArchetypes {
+		archetype := &config.Spec.NamespaceArchetypes[i]
+		for _, mix := range []*scalev1.ArchetypeResourceMix{
+			archetype.ConfigMaps, archetype.Secrets, archetype.Routes, archetype.ImageStreams,
+			archetype.BuildConfigs, archetype.Pods, archetype.AppBundles,
+		} {
+			if mix == nil {
+				continue
+			}
+			if mix.UpdateFrequencyMin != nil {
+				scaled := scaleInterval(*mix.UpdateFrequencyMin, multiplier)
+				mix.UpdateFrequencyMin = &scaled
+			}
+			if mix.UpdateFrequencyMax != nil {
+				scaled := scaleInterval(*mix.UpdateFrequencyMax, multiplier)
+				mix.UpdateFrequencyMax = &scaled
+			}
+		}
+	}
+}
+
+// scaleInterval shortens an interval in seconds by the multiplier, never going below one second
+func scaleInterval(seconds int32, multiplier float64) int32 {
+	if seconds <= 0 {
+		return seconds
+	}
+	scaled := int32(math.Round(float64(seconds) / multiplier))
+	if scaled < 1 {
+		return 1
+	}
+	return scaled
+}
+
+// effectiveProfile describes the load parameters the resolved config runs with at the given node count
+func (r *ScaleLoadConfigReconciler) effectiveProfile(config *scalev1.ScaleLoadConfig, nodeCount int) scalev1.EffectiveProfile {
+	effective := scalev1.EffectiveProfile{
+		Scenario:                config.Spec.Scenario,
+		Preset:                  config.Spec.Preset,
+		NamespacesPerNode:       "0.6",
+		NamespacesPerNodeSource: "default",
+	}
+
+	if config.Spec.LoadProfile.NamespacesPerNode != nil {
+		effective.NamespacesPerNode = *config.Spec.LoadProfile.NamespacesPerNode
+		effective.NamespacesPerNodeSource = "spec"
+	}
+	if profile, err := config.ResolveLoadProfile(); err == nil && profile != nil {
+		effective.Profile = profile.Name
+		if profile.NamespacesPerNode != nil {
+			effective.NamespacesPerNodeSource = "profile"
+		}
+		if profile.ChurnMultiplier != nil {
+			effective.ChurnMultiplier = *profile.ChurnMultiplier
+		}
+	}
+
+	effective.APICallsPerMinute, effective.APICallRateSource = r.getEffectiveAPIRate(config, nodeCount)
+	switch {
+	case config.Spec.LoadProfile.APICallRateStatic != nil:
+	case config.Spec.LoadProfile.APICallRatePerNode != nil:
+		ratePerNode := *config.Spec.LoadProfile.APICallRatePerNode
+		effective.APICallRatePerNode = &ratePerNode
+	default:
+		ratePerNode := int32(20)
+		effective.APICallRatePerNode = &ratePerNode
+	}
+
+	effective.ReconcileInterval = r.calculateReconcileInterval(config, nodeCount).Round(time.Millisecond).String()
+	return effective
+}
@@ -0,0 +1,224 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	buildv1 "github.com/openshift/api/build/v1"
+	imagev1 "github.com/openshift/api/image/v1"
+	routev1 "github.com/openshift/api/route/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+// ResourceChurner generates and churns one namespaced resource type. New kinds (Deployments,
+// PVCs, custom resources) are added by implementing it in their own file and registering
+// the implementation, without touching the per-namespace management loop
+type ResourceChurner interface {
+	// Name is the key the type is reported under in resource counts and metrics
+	Name() string
+	// Enabled reports whether the config turns the type on
+	Enabled(config *scalev1.ScaleLoadConfig) bool
+	// NamespaceInterval selects every Nth namespace for the type; zero or one selects all of them
+	NamespaceInterval(config *scalev1.ScaleLoadConfig) int32
+	// NamespaceTargeting narrows the selected namespaces further; nil leaves them to NamespaceInterval
+	NamespaceTargeting(config *scalev1.ScaleLoadConfig) *scalev1.NamespaceTargeting
+	// EnsureCount creates or removes objects until the namespace holds the desired count,
+	// returning the resulting count
+	EnsureCount(ctx context.Context, r *ScaleLoadConfigReconciler, config *scalev1.ScaleLoadConfig, namespace string) (int32, error)
+	// Churn updates or replaces existing objects; it runs after EnsureCount succeeds
+	Churn(ctx context.Context, r *ScaleLoadConfigReconciler, config *scalev1.ScaleLoadConfig, namespace string) error
+	// Cleanup deletes the objects created for the config, in every namespace when namespace is empty,
+	// returning how many were deleted
+	Cleanup(ctx context.Context, r *ScaleLoadConfigReconciler, config *scalev1.ScaleLoadConfig, namespace string) (int, error)
+}
+
+// resourceChurners holds the registered churners in the order they were registered
+var resourceChurners []ResourceChurner
+
+// RegisterResourceChurner adds a churner to the registry; it is meant to be called from init
+func RegisterResourceChurner(churner ResourceChurner) {
+	resourceChurners = append(resourceChurners, churner)
+}
+
+func init() {
+	RegisterResourceChurner(&builtinChurner{
+		name:      "configMaps",
+		resources: []managedResourceType{{"configmap", func() client.ObjectList { return &corev1.ConfigMapList{} }}},
+		spec:      func(c *scalev1.ScaleLoadConfig) scalev1.ResourceTypeConfig { return c.Spec.ResourceChurn.ConfigMaps },
+		manage:    (*ScaleLoadConfigReconciler).manageConfigMaps,
+	})
+	RegisterResourceChurner(&builtinChurner{
+		name:      "secrets",
+		resources: []managedResourceType{{"secret", func() client.ObjectList { return &corev1.SecretList{} }}},
+		spec:      func(c *scalev1.ScaleLoadConfig) scalev1.ResourceTypeConfig { return c.Spec.ResourceChurn.Secrets },
+		manage:    (*ScaleLoadConfigReconciler).manageSecrets,
+	})
+	RegisterResourceChurner(&builtinChurner{
+		name: "routes",
+		// Routes are created with a backing Service
+		resources: []managedResourceType{
+			{"route", func() client.ObjectList { return &routev1.RouteList{} }},
+			{"service", func() client.ObjectList { return &corev1.ServiceList{} }},
+		},
+		spec:   func(c *scalev1.ScaleLoadConfig) scalev1.ResourceTypeConfig { return c.Spec.ResourceChurn.Routes },
+		manage: (*ScaleLoadConfigReconciler).manageRoutes,
+	})
+	RegisterResourceChurner(&builtinChurner{
+		name:      "imageStreams",
+		resources: []managedResourceType{{"imagestream", func() client.ObjectList { return &imagev1.ImageStreamList{} }}},
+		spec:      func(c *scalev1.ScaleLoadConfig) scalev1.ResourceTypeConfig { return c.Spec.ResourceChurn.ImageStreams },
+		manage:    (*ScaleLoadConfigReconciler).manageImageStreams,
+	})
+	RegisterResourceChurner(&builtinChurner{
+		name:      "buildConfigs",
+		resources: []managedResourceType{{"buildconfig", func() client.ObjectList { return &buildv1.BuildConfigList{} }}},
+		spec:      func(c *scalev1.ScaleLoadConfig) scalev1.ResourceTypeConfig { return c.Spec.ResourceChurn.BuildConfigs },
+		manage:    (*ScaleLoadConfigReconciler).manageBuildConfigs,
+	})
+	RegisterResourceChurner(&builtinChurner{
+		name:      "pods",
+		resources: []managedResourceType{{"pod", func() client.ObjectList { return &corev1.PodList{} }}},
+		spec: func(c *scalev1.ScaleLoadConfig) scalev1.ResourceTypeConfig {
+			// Only the fields shared with ResourceTypeConfig are read
+			pods := c.Spec.ResourceChurn.Pods
+			return scalev1.ResourceTypeConfig{Enabled: pods.Enabled, Count: pods.Count, Maximum: pods.Maximum,
+				NamespaceInterval: pods.NamespaceInterval, NamespaceTargeting: pods.NamespaceTargeting, TTLSeconds: pods.TTLSeconds}
+		},
+		manage: (*ScaleLoadConfigReconciler).managePods,
+	})
+}
+
+// builtinChurner adapts the original per-type manage functions, which keep their count
+// and apply churn within the type's update window in a single pass
+type builtinChurner struct {
+	name      string
+	resources []managedResourceType
+	spec      func(config *scalev1.ScaleLoadConfig) scalev1.ResourceTypeConfig
+	manage    func(r *ScaleLoadConfigReconciler, ctx context.Context, config *scalev1.ScaleLoadConfig, namespace string, count int32) (int32, error)
+}
+
+func (c *builtinChurner) Name() string { return c.name }
+
+func (c *builtinChurner) Enabled(config *scalev1.ScaleLoadConfig) bool {
+	return c.spec(config).Enabled
+}
+
+func (c *builtinChurner) NamespaceInterval(config *scalev1.ScaleLoadConfig) int32 {
+	return c.spec(config).NamespaceInterval
+}
+
+func (c *builtinChurner) NamespaceTargeting(config *scalev1.ScaleLoadConfig) *scalev1.NamespaceTargeting {
+	return c.spec(config).NamespaceTargeting
+}
+
+func (c *builtinChurner) EnsureCount(ctx context.Context, r *ScaleLoadConfigReconciler,
+	config *scalev1.ScaleLoadConfig, namespace string) (int32, error) {
+	spec := c.spec(config)
+	if spec.TTLSeconds > 0 {
+		expired, err := r.expireResources(ctx, config, namespace, c.resources, time.Duration(spec.TTLSeconds)*time.Second)
+		if err != nil {
+			return 0, err
+		}
+		if expired > 0 {
+			// Run the manage function now rather than at the next update window, so expired objects are replaced right away
+			r.resetResourceOperation(namespace, c.name)
+		}
+	}
+	return c.manage(r, ctx, config, namespace, spec.Count)
+}
+
+// Churn is a no-op since the manage function already churned during EnsureCount
+func (c *builtinChurner) Churn(context.Context, *ScaleLoadConfigReconciler, *scalev1.ScaleLoadConfig, string) error {
+	return nil
+}
+
+func (c *builtinChurner) Cleanup(ctx context.Context, r *ScaleLoadConfigReconciler,
+	config *scalev1.ScaleLoadConfig, namespace string) (int, error) {
+	var deleted int
+	for _, resource := range c.resources {
+		count, err := r.deleteResourceType(ctx, config, resource, client.InNamespace(namespace))
+		deleted += count
+		if err != nil {
+			return deleted, err
+		}
+	}
+	return deleted, nil
+}
+
+// expireResources deletes objects in the namespace that outlived the TTL, returning how many were deleted.
+// Each object's deadline is pulled forward by a stable offset of up to a quarter of the TTL, so objects
+// created together still expire at different times
+func (r *ScaleLoadConfigReconciler) expireResources(ctx context.Context, config *scalev1.ScaleLoadConfig,
+	namespace string, resources []managedResourceType, ttl time.Duration) (int, error) {
+
+	var expired int
+	for _, resource := range resources {
+		list := resource.newList()
+		if err := r.List(ctx, list, client.InNamespace(namespace), client.MatchingLabels{
+			"scale.openshift.io/managed-by":    config.Name,
+			"scale.openshift.io/resource-type": resource.resourceType,
+		}); err != nil {
+			if meta.IsNoMatchError(err) {
+				continue
+			}
+			return expired, fmt.Errorf("failed to list %s objects for TTL expiry: %w", resource.resourceType, err)
+		}
+		r.recordAPICall(config, 1)
+
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return expired, fmt.Errorf("failed to extract %s objects for TTL expiry: %w", resource.resourceType, err)
+		}
+		for _, item := range items {
+			obj, ok := item.(client.Object)
+			if !ok || obj.GetDeletionTimestamp() != nil {
+				continue
+			}
+			deadline := ttl - churnPhaseOffset(obj.GetName(), resource.resourceType, ttl/4)
+			if time.Since(obj.GetCreationTimestamp().Time) < deadline {
+				continue
+			}
+			if err := r.Delete(ctx, obj); err != nil && !errors.IsNotFound(err) {
+				return expired, fmt.Errorf("failed to delete expired %s %s/%s: %w", resource.resourceType, namespace, obj.GetName(), err)
+			}
+			r.recordAPICall(config, 1)
+			expired++
+		}
+	}
+
+	if expired > 0 {
+		r.Log.WithName("ttl-manager").V(1).Info("Deleted objects past their TTL",
+			"namespace", namespace, "count", expired, "ttl", ttl)
+	}
+	return expired, nil
+}
+
+// runChurner runs one churner for a namespace and records its per-type metrics
+func (r *ScaleLoadConfigReconciler) runChurner(ctx context.Context, churner ResourceChurner,
+	config *scalev1.ScaleLoadConfig, namespace string) (int32, error) {
+
+	startTime := time.Now()
+	ctx = withSubsystem(ctx, churnerSubsystem(churner))
+	count, err := churner.EnsureCount(ctx, r, config, namespace)
+	if err == nil {
+		err = churner.Churn(ctx, r, config, namespace)
+	}
+
+	if r.ChurnerDuration != nil {
+		r.ChurnerDuration.WithLabelValues(churner.Name()).Observe(time.Since(startTime).Seconds())
+	}
+	if r.ChurnerPasses != nil {
+		result := "success"
+		if err != nil {
+			result = "error"
+		}
+		r.ChurnerPasses.WithLabelValues(churner.Name(), result).Inc()
+	}
+	return count, err
+}
@@ -2,9 +2,9 @@ package controllers
 
 import (
 	"context"
-	"crypto/rand"
-	"encoding/base64"
 	"fmt"
+	"hash/fnv"
+	"math"
 	mathrand "math/rand"
 	"strconv"
 	"strings"
@@ -17,13 +17,16 @@ import (
 	routev1 "github.com/openshift/api/route/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+	"github.com/jtaleric/sim-operator/pkg/generator"
 )
 
 // manageNamespaceResources creates and manages resources within a namespace
@@ -38,6 +41,12 @@ func (r *ScaleLoadConfigReconciler) manageNamespaceResources(ctx context.Context
 	// Verify namespace exists and is ready before creating any resources
 	ready, phase := r.checkNamespaceStatus(ctx, namespace.Name)
 	if !ready {
+		if namespaceGone(phase) {
+			// Deleted since it was listed, typically by scale down; there is nothing left to manage in it
+			r.recordNamespaceSkipped(phase)
+			log.V(2).Info("Skipping namespace being deleted", "phase", phase)
+			return resourceCounts, nil
+		}
 		log.V(1).Info("Namespace not ready for resource creation", "phase", phase)
 		return resourceCounts, fmt.Errorf("namespace %s is not ready (phase: %s)", namespace.Name, phase)
 	}
@@ -58,13 +67,6 @@ func (r *ScaleLoadConfigReconciler) manageNamespaceResources(ctx context.Context
 
 	duration := time.Since(startTime)
 
-	// Calculate totals from individual resource managers
-	for resourceType, count := range resourceCounts {
-		if resourceType == "events" {
-			totalCreated += int32(count) // Events are only created, not updated
-		}
-	}
-
 	log.V(1).Info("Namespace resource management completed",
 		"duration", duration.String(),
 		"totalApiCalls", totalApiCalls,
@@ -102,6 +104,27 @@ func (r *ScaleLoadConfigReconciler) shouldCreateResourceForNamespace(namespace c
 	return index%int(interval) == 0
 }
 
+// namespaceTargeted checks if a namespace matches a resource type's NamespaceTargeting.
+// A selector the webhook would have rejected matches no namespace
+func namespaceTargeted(namespace corev1.Namespace, targeting *scalev1.NamespaceTargeting) bool {
+	if targeting == nil {
+		return true
+	}
+	if indexRange := targeting.IndexRange; indexRange != nil {
+		index := namespaceIndex(namespace)
+		if index < 0 || index < int(indexRange.From) || index > int(indexRange.To) {
+			return false
+		}
+	}
+	if targeting.Selector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(targeting.Selector)
+		if err != nil || !selector.Matches(labels.Set(namespace.Labels)) {
+			return false
+		}
+	}
+	return true
+}
+
 // manageConfigMaps creates and manages ConfigMap resources
 func (r *ScaleLoadConfigReconciler) manageConfigMaps(ctx context.Context,
 	config *scalev1.ScaleLoadConfig, namespace string, targetCount int32) (int32, error) {
@@ -117,7 +140,7 @@ func (r *ScaleLoadConfigReconciler) manageConfigMaps(ctx context.Context,
 	log.V(1).Info("Performing configmap operations within update frequency window")
 
 	// Check maximum limit and adjust target count if needed
-	effectiveTargetCount, err := r.checkMaximumLimit(ctx, config, "configMaps", targetCount, config.Spec.ResourceChurn.ConfigMaps.Maximum)
+	effectiveTargetCount, err := r.checkMaximumLimit(ctx, config, namespace, "configMaps", targetCount, config.Spec.ResourceChurn.ConfigMaps.Maximum)
 	if err != nil {
 		return 0, fmt.Errorf("failed to check maximum limit for configMaps: %w", err)
 	}
@@ -145,44 +168,54 @@ func (r *ScaleLoadConfigReconciler) manageConfigMaps(ctx context.Context,
 	}
 	r.recordAPICall(config, 1) // List operation
 
-	currentCount := len(configMapList.Items)
+	missing, surplus := expectedIndexDiff(configMapList.Items, generatedKindPrefix("configmap"), targetCount)
+	present := targetCount - int32(len(missing))
+	// maxOperationsPerCycle spreads a large jump in the target over several cycles
+	missing, surplus, offset := limitCycleOperations(ctx, missing, surplus)
 	var created, deleted, apiCalls int32
 	apiCalls++ // List operation
 
 	// Update last operation time for this resource type in this namespace
 	r.updateLastResourceOperation(namespace, "configMaps")
 
-	log.V(1).Info("ConfigMap management starting", "current", currentCount, "target", targetCount)
+	log.V(1).Info("ConfigMap management starting", "current", len(configMapList.Items), "target", targetCount,
+		"missing", len(missing), "surplus", len(surplus))
 
-	// Scale up if needed
-	if int32(currentCount) < targetCount {
-		toCreate := targetCount - int32(currentCount)
-		for i := int32(currentCount); i < targetCount; i++ {
-			configMap := r.generateConfigMap(config, namespace, i)
-			if err := r.Create(ctx, configMap); err != nil {
-				log.Error(err, "Failed to create ConfigMap", "name", configMap.Name, "created", created)
-				return int32(currentCount) + created, fmt.Errorf("failed to create ConfigMap: %w", err)
-			}
-			r.recordAPICall(config, 1) // Create operation
-			created++
-			apiCalls++
+	// Create the expected ConfigMaps that are missing
+	var provider generator.DataProvider
+	if len(missing) > 0 {
+		if provider, err = r.dataProvider(config, "configmap", config.Spec.ResourceChurn.ConfigMaps.DataProvider); err != nil {
+			return present, err
+		}
+	}
+	for _, i := range missing {
+		configMap, err := r.generateConfigMap(config, namespace, i, provider)
+		if err != nil {
+			return present + created, fmt.Errorf("failed to generate ConfigMap: %w", err)
+		}
+		if err := r.createOrAdopt(ctx, config, configMap); err != nil {
+			log.Error(err, "Failed to create ConfigMap", "name", configMap.Name, "created", created)
+			return present + created, fmt.Errorf("failed to create ConfigMap: %w", err)
 		}
-		log.V(1).Info("ConfigMaps created", "count", toCreate, "apiCalls", created)
+		r.recordAPICall(config, 1) // Create operation
+		created++
+		apiCalls++
+	}
+	if created > 0 {
+		log.V(1).Info("ConfigMaps created", "count", created, "apiCalls", created)
 	}
 
-	// Scale down if needed
-	if int32(currentCount) > targetCount {
-		toDelete := int32(currentCount) - targetCount
-		var deleted int32
-		for i := int32(len(configMapList.Items)) - 1; i >= targetCount && deleted < toDelete; i-- {
-			if err := r.Delete(ctx, &configMapList.Items[i]); err != nil {
-				log.Error(err, "Failed to delete ConfigMap", "name", configMapList.Items[i].Name, "deleted", deleted)
-				return int32(currentCount) - deleted, fmt.Errorf("failed to delete ConfigMap: %w", err)
-			}
-			r.recordAPICall(config, 1) // Delete operation
-			deleted++
-			apiCalls++
+	// Delete the ConfigMaps outside the expected set
+	for i := range surplus {
+		if err := r.Delete(ctx, &surplus[i]); err != nil && !errors.IsNotFound(err) {
+			log.Error(err, "Failed to delete ConfigMap", "name", surplus[i].Name, "deleted", deleted)
+			return present + created, fmt.Errorf("failed to delete ConfigMap: %w", err)
 		}
+		r.recordAPICall(config, 1) // Delete operation
+		deleted++
+		apiCalls++
+	}
+	if deleted > 0 {
 		log.V(1).Info("ConfigMaps deleted", "count", deleted, "apiCalls", deleted)
 	}
 
@@ -201,34 +234,21 @@ func (r *ScaleLoadConfigReconciler) manageConfigMaps(ctx context.Context,
 		"updated", updatedCount,
 		"totalApiCalls", apiCalls)
 
-	return targetCount, nil
+	return targetCount + offset, nil
 }
 
 // generateConfigMap creates a realistic ConfigMap resource
-func (r *ScaleLoadConfigReconciler) generateConfigMap(config *scalev1.ScaleLoadConfig, namespace string, index int32) *corev1.ConfigMap {
-	name := r.generateUniqueConfigMapName(namespace, int(index))
-
-	// Generate realistic configuration data
-	configData := map[string]string{
-		"app.properties": generateAppProperties(),
-		"config.yaml":    generateConfigYAML(),
-		"settings.json":  generateSettingsJSON(),
-	}
-
-	return &corev1.ConfigMap{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      name,
-			Namespace: namespace,
-			Labels: map[string]string{
-				"scale.openshift.io/managed-by":    config.Name,
-				"scale.openshift.io/resource-type": "configmap",
-				"scale.openshift.io/created-by":    "sim-operator",
-				"app.kubernetes.io/name":           fmt.Sprintf("sim-app-%d", index),
-				"app.kubernetes.io/component":      "configuration",
-			},
+func (r *ScaleLoadConfigReconciler) generateConfigMap(config *scalev1.ScaleLoadConfig, namespace string, index int32,
+	provider generator.DataProvider) (*corev1.ConfigMap, error) {
+	return generator.ConfigMapFrom(provider, generator.Meta{
+		Name:      r.generateUniqueConfigMapName(config, int(index)),
+		Namespace: namespace,
+		Labels: map[string]string{
+			"scale.openshift.io/managed-by":    config.Name,
+			"scale.openshift.io/resource-type": "configmap",
+			"scale.openshift.io/created-by":    "sim-operator",
 		},
-		Data: configData,
-	}
+	}, index)
 }
 
 // manageSecrets creates and manages Secret resources
@@ -246,7 +266,7 @@ func (r *ScaleLoadConfigReconciler) manageSecrets(ctx context.Context,
 	log.V(1).Info("Performing secret operations within update frequency window")
 
 	// Check maximum limit and adjust target count if needed
-	effectiveTargetCount, err := r.checkMaximumLimit(ctx, config, "secrets", targetCount, config.Spec.ResourceChurn.Secrets.Maximum)
+	effectiveTargetCount, err := r.checkMaximumLimit(ctx, config, namespace, "secrets", targetCount, config.Spec.ResourceChurn.Secrets.Maximum)
 	if err != nil {
 		return 0, fmt.Errorf("failed to check maximum limit for secrets: %w", err)
 	}
@@ -273,44 +293,54 @@ func (r *ScaleLoadConfigReconciler) manageSecrets(ctx context.Context,
 	}
 	r.recordAPICall(config, 1) // List operation
 
-	currentCount := len(secretList.Items)
+	missing, surplus := expectedIndexDiff(secretList.Items, generatedKindPrefix("secret"), targetCount)
+	present := targetCount - int32(len(missing))
+	// maxOperationsPerCycle spreads a large jump in the target over several cycles
+	missing, surplus, offset := limitCycleOperations(ctx, missing, surplus)
 	var created, deleted, apiCalls int32
 	apiCalls++ // List operation
 
 	// Update last operation time for this resource type in this namespace
 	r.updateLastResourceOperation(namespace, "secrets")
 
-	log.V(1).Info("Secret management starting", "current", currentCount, "target", targetCount)
+	log.V(1).Info("Secret management starting", "current", len(secretList.Items), "target", targetCount,
+		"missing", len(missing), "surplus", len(surplus))
 
-	// Scale up if needed
-	if int32(currentCount) < targetCount {
-		toCreate := targetCount - int32(currentCount)
-		for i := int32(currentCount); i < targetCount; i++ {
-			secret := r.generateSecret(config, namespace, i)
-			if err := r.Create(ctx, secret); err != nil {
-				log.Error(err, "Failed to create Secret", "name", secret.Name, "created", created)
-				return int32(currentCount) + created, fmt.Errorf("failed to create Secret: %w", err)
-			}
-			r.recordAPICall(config, 1) // Create operation
-			created++
-			apiCalls++
+	// Create the expected Secrets that are missing
+	var provider generator.DataProvider
+	if len(missing) > 0 {
+		if provider, err = r.dataProvider(config, "secret", config.Spec.ResourceChurn.Secrets.DataProvider); err != nil {
+			return present, err
 		}
-		log.V(1).Info("Secrets created", "count", toCreate, "apiCalls", created)
+	}
+	for _, i := range missing {
+		secret, err := r.generateSecret(config, namespace, i, provider)
+		if err != nil {
+			return present + created, fmt.Errorf("failed to generate Secret: %w", err)
+		}
+		if err := r.createOrAdopt(ctx, config, secret); err != nil {
+			log.Error(err, "Failed to create Secret", "name", secret.Name, "created", created)
+			return present + created, fmt.Errorf("failed to create Secret: %w", err)
+		}
+		r.recordAPICall(config, 1) // Create operation
+		created++
+		apiCalls++
+	}
+	if created > 0 {
+		log.V(1).Info("Secrets created", "count", created, "apiCalls", created)
 	}
 
-	// Scale down if needed
-	if int32(currentCount) > targetCount {
-		toDelete := int32(currentCount) - targetCount
-		var deleted int32
-		for i := int32(len(secretList.Items)) - 1; i >= targetCount && deleted < toDelete; i-- {
-			if err := r.Delete(ctx, &secretList.Items[i]); err != nil {
-				log.Error(err, "Failed to delete Secret", "name", secretList.Items[i].Name, "deleted", deleted)
-				return int32(currentCount) - deleted, fmt.Errorf("failed to delete Secret: %w", err)
-			}
-			r.recordAPICall(config, 1) // Delete operation
-			deleted++
-			apiCalls++
+	// Delete the Secrets outside the expected set
+	for i := range surplus {
+		if err := r.Delete(ctx, &surplus[i]); err != nil && !errors.IsNotFound(err) {
+			log.Error(err, "Failed to delete Secret", "name", surplus[i].Name, "deleted", deleted)
+			return present + created, fmt.Errorf("failed to delete Secret: %w", err)
 		}
+		r.recordAPICall(config, 1) // Delete operation
+		deleted++
+		apiCalls++
+	}
+	if deleted > 0 {
 		log.V(1).Info("Secrets deleted", "count", deleted, "apiCalls", deleted)
 	}
 
@@ -329,35 +359,21 @@ func (r *ScaleLoadConfigReconciler) manageSecrets(ctx context.Context,
 		"updated", updatedCount,
 		"totalApiCalls", apiCalls)
 
-	return targetCount, nil
+	return targetCount + offset, nil
 }
 
 // generateSecret creates a realistic Secret resource
-func (r *ScaleLoadConfigReconciler) generateSecret(config *scalev1.ScaleLoadConfig, namespace string, index int32) *corev1.Secret {
-	name := r.generateUniqueSecretName(namespace, int(index))
-
-	secretData := map[string][]byte{
-		"username":    []byte(fmt.Sprintf("user-%d", index)),
-		"password":    []byte(generateRandomPassword(32)),
-		"api-key":     []byte(generateRandomAPIKey()),
-		"config.yaml": []byte(generateSecretConfig()),
-	}
-
-	return &corev1.Secret{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      name,
-			Namespace: namespace,
-			Labels: map[string]string{
-				"scale.openshift.io/managed-by":    config.Name,
-				"scale.openshift.io/resource-type": "secret",
-				"scale.openshift.io/created-by":    "sim-operator",
-				"app.kubernetes.io/name":           fmt.Sprintf("sim-app-%d", index),
-				"app.kubernetes.io/component":      "credentials",
-			},
+func (r *ScaleLoadConfigReconciler) generateSecret(config *scalev1.ScaleLoadConfig, namespace string, index int32,
+	provider generator.DataProvider) (*corev1.Secret, error) {
+	return generator.SecretFrom(provider, generator.Meta{
+		Name:      r.generateUniqueSecretName(config, int(index)),
+		Namespace: namespace,
+		Labels: map[string]string{
+			"scale.openshift.io/managed-by":    config.Name,
+			"scale.openshift.io/resource-type": "secret",
+			"scale.openshift.io/created-by":    "sim-operator",
 		},
-		Type: corev1.SecretTypeOpaque,
-		Data: secretData,
-	}
+	}, index)
 }
 
 // manageRoutes creates and manages Route resources (OpenShift specific)
@@ -383,7 +399,7 @@ func (r *ScaleLoadConfigReconciler) manageRoutes(ctx context.Context,
 	log.V(1).Info("Performing route operations within update frequency window")
 
 	// Check maximum limit and adjust target count if needed
-	effectiveTargetCount, err := r.checkMaximumLimit(ctx, config, "routes", targetCount, config.Spec.ResourceChurn.Routes.Maximum)
+	effectiveTargetCount, err := r.checkMaximumLimit(ctx, config, namespace, "routes", targetCount, config.Spec.ResourceChurn.Routes.Maximum)
 	if err != nil {
 		return 0, fmt.Errorf("failed to check maximum limit for routes: %w", err)
 	}
@@ -412,47 +428,53 @@ func (r *ScaleLoadConfigReconciler) manageRoutes(ctx context.Context,
 				"namespace", namespace)
 			return 0, nil // Return 0 count but no error to continue with other resources
 		}
+		if meta.IsNoMatchError(err) {
+			log.V(1).Info("Route API not available, skipping routes")
+			r.setAPIAvailable(config.Name, "Route", false)
+			return 0, nil
+		}
 		return 0, fmt.Errorf("failed to list Routes: %w", err)
 	}
+	r.setAPIAvailable(config.Name, "Route", true)
 	r.recordAPICall(config, 1) // List operation
 
 	currentCount := len(routeList.Items)
+	missing, surplus := expectedIndexDiff(routeList.Items, generatedKindPrefix("route"), targetCount)
+	present := targetCount - int32(len(missing))
+	// maxOperationsPerCycle spreads a large jump in the target over several cycles
+	missing, surplus, offset := limitCycleOperations(ctx, missing, surplus)
 
-	log.V(1).Info("Route management starting", "current", currentCount, "target", targetCount)
+	log.V(1).Info("Route management starting", "current", currentCount, "target", targetCount,
+		"missing", len(missing), "surplus", len(surplus))
 
 	// Update last operation time for this resource type in this namespace
 	r.updateLastResourceOperation(namespace, "routes")
 
-	// Scale up if needed
-	if int32(currentCount) < targetCount {
-		toCreate := targetCount - int32(currentCount)
+	// Create the expected Routes that are missing
+	if len(missing) > 0 {
 		var created int32
-		for i := int32(currentCount); i < targetCount; i++ {
+		for _, i := range missing {
 			// First create the Service that the Route will reference
 			service := r.generateService(config, namespace, i)
-			if err := r.Create(ctx, service); err != nil {
-				// Check if the service already exists
-				if !errors.IsAlreadyExists(err) {
-					return int32(currentCount) + created, fmt.Errorf("failed to create Service: %w", err)
-				}
-			} else {
-				r.recordAPICall(config, 1) // Service create operation
+			if err := r.createOrAdopt(ctx, config, service); err != nil {
+				return present + created, fmt.Errorf("failed to create Service: %w", err)
 			}
+			r.recordAPICall(config, 1) // Service create operation
 
 			// Then create the Route that references the service by name
 			route := r.generateRouteForService(config, namespace, i, service.Name)
-			if err := r.Create(ctx, route); err != nil {
-				return int32(currentCount) + created, fmt.Errorf("failed to create Route: %w", err)
+			if err := r.createOrAdopt(ctx, config, route); err != nil {
+				return present + created, fmt.Errorf("failed to create Route: %w", err)
 			}
 			r.recordAPICall(config, 1) // Route create operation
 			created++
 		}
-		log.V(1).Info("Routes created", "count", toCreate, "apiCalls", created*2) // *2 for service+route
+		log.V(1).Info("Routes created", "count", created, "apiCalls", created*2) // *2 for service+route
 	}
 
-	// Scale down if needed
-	if int32(currentCount) > targetCount {
-		toDelete := int32(currentCount) - targetCount
+	// Delete the Routes outside the expected set
+	if len(surplus) > 0 {
+		toDelete := int32(len(surplus))
 
 		// Check if we can perform deletion safely
 		if !r.deletionManager.CanPerformDeletion("routes", config) {
@@ -464,9 +486,9 @@ func (r *ScaleLoadConfigReconciler) manageRoutes(ctx context.Context,
 		// Use enhanced deletion if safe deletion is enabled
 		if config.Spec.ResourceChurn.Routes.SafeDeletionEnabled {
 			// Convert routes to client.Object slice
-			routeObjects := make([]client.Object, len(routeList.Items))
-			for i := range routeList.Items {
-				routeObjects[i] = &routeList.Items[i]
+			routeObjects := make([]client.Object, len(surplus))
+			for i := range surplus {
+				routeObjects[i] = &surplus[i]
 			}
 
 			if err := r.deletionManager.DeleteResourcesBatched(ctx, config, routeObjects, "routes", toDelete); err != nil {
@@ -476,12 +498,12 @@ func (r *ScaleLoadConfigReconciler) manageRoutes(ctx context.Context,
 			log.V(1).Info("Routes deleted with enhanced batching", "targetDeleted", toDelete)
 		} else {
 			// Use legacy deletion for backward compatibility
-			deleted := r.deleteRoutesLegacy(ctx, config, routeList.Items, toDelete, log)
+			deleted := r.deleteRoutesLegacy(ctx, config, surplus, toDelete, log)
 			log.V(1).Info("Routes deleted", "count", deleted, "apiCalls", deleted*2) // *2 for service+route
 		}
 	}
 
-	return targetCount, nil
+	return targetCount + offset, nil
 }
 
 // deleteRoutesLegacy provides backward compatibility for route deletion
@@ -526,7 +548,7 @@ func (r *ScaleLoadConfigReconciler) deleteRoutesLegacy(ctx context.Context, conf
 
 // generateRouteForService creates a realistic Route resource that references a specific service
 func (r *ScaleLoadConfigReconciler) generateRouteForService(config *scalev1.ScaleLoadConfig, namespace string, index int32, serviceName string) *routev1.Route {
-	name := r.generateUniqueRouteName(namespace, int(index))
+	name := r.generateUniqueRouteName(config, int(index))
 
 	return &routev1.Route{
 		ObjectMeta: metav1.ObjectMeta{
@@ -557,7 +579,7 @@ func (r *ScaleLoadConfigReconciler) generateRouteForService(config *scalev1.Scal
 
 // generateService creates a Service resource for the Route to reference
 func (r *ScaleLoadConfigReconciler) generateService(config *scalev1.ScaleLoadConfig, namespace string, index int32) *corev1.Service {
-	name := r.generateUniqueServiceName(namespace, int(index))
+	name := r.generateUniqueServiceName(config, int(index))
 
 	return &corev1.Service{
 		ObjectMeta: metav1.ObjectMeta{
@@ -603,7 +625,7 @@ func (r *ScaleLoadConfigReconciler) manageImageStreams(ctx context.Context,
 	log.V(1).Info("Performing imagestream operations within update frequency window")
 
 	// Check maximum limit and adjust target count if needed
-	effectiveTargetCount, err := r.checkMaximumLimit(ctx, config, "imageStreams", targetCount, config.Spec.ResourceChurn.ImageStreams.Maximum)
+	effectiveTargetCount, err := r.checkMaximumLimit(ctx, config, namespace, "imageStreams", targetCount, config.Spec.ResourceChurn.ImageStreams.Maximum)
 	if err != nil {
 		return 0, fmt.Errorf("failed to check maximum limit for imageStreams: %w", err)
 	}
@@ -632,32 +654,42 @@ func (r *ScaleLoadConfigReconciler) manageImageStreams(ctx context.Context,
 				"namespace", namespace)
 			return 0, nil // Return 0 count but no error to continue with other resources
 		}
+		if meta.IsNoMatchError(err) {
+			log.V(1).Info("ImageStream API not available, skipping imagestreams")
+			r.setAPIAvailable(config.Name, "ImageStream", false)
+			return 0, nil
+		}
 		return 0, fmt.Errorf("failed to list ImageStreams: %w", err)
 	}
+	r.setAPIAvailable(config.Name, "ImageStream", true)
 	r.recordAPICall(config, 1) // List operation
 
 	currentCount := len(imageStreamList.Items)
+	missing, surplus := expectedIndexDiff(imageStreamList.Items, generatedKindPrefix("imagestream"), targetCount)
+	present := targetCount - int32(len(missing))
+	// maxOperationsPerCycle spreads a large jump in the target over several cycles
+	missing, surplus, offset := limitCycleOperations(ctx, missing, surplus)
 
-	log.V(1).Info("ImageStream management starting", "current", currentCount, "target", targetCount)
+	log.V(1).Info("ImageStream management starting", "current", currentCount, "target", targetCount,
+		"missing", len(missing), "surplus", len(surplus))
 
-	// Scale up if needed
-	if int32(currentCount) < targetCount {
-		toCreate := targetCount - int32(currentCount)
+	// Create the expected ImageStreams that are missing
+	if len(missing) > 0 {
 		var created int32
-		for i := int32(currentCount); i < targetCount; i++ {
+		for _, i := range missing {
 			imageStream := r.generateImageStream(config, namespace, i)
-			if err := r.Create(ctx, imageStream); err != nil {
-				return int32(currentCount) + created, fmt.Errorf("failed to create ImageStream: %w", err)
+			if err := r.createOrAdopt(ctx, config, imageStream); err != nil {
+				return present + created, fmt.Errorf("failed to create ImageStream: %w", err)
 			}
 			r.recordAPICall(config, 1) // Create operation
 			created++
 		}
-		log.V(1).Info("ImageStreams created", "count", toCreate, "apiCalls", created)
+		log.V(1).Info("ImageStreams created", "count", created, "apiCalls", created)
 	}
 
-	// Scale down if needed
-	if int32(currentCount) > targetCount {
-		toDelete := int32(currentCount) - targetCount
+	// Delete the ImageStreams outside the expected set
+	if len(surplus) > 0 {
+		toDelete := int32(len(surplus))
 
 		// Check if we can perform deletion safely
 		if !r.deletionManager.CanPerformDeletion("imageStreams", config) {
@@ -669,9 +701,9 @@ func (r *ScaleLoadConfigReconciler) manageImageStreams(ctx context.Context,
 		// Use enhanced deletion if safe deletion is enabled
 		if config.Spec.ResourceChurn.ImageStreams.SafeDeletionEnabled {
 			// Convert imagestreams to client.Object slice
-			imageStreamObjects := make([]client.Object, len(imageStreamList.Items))
-			for i := range imageStreamList.Items {
-				imageStreamObjects[i] = &imageStreamList.Items[i]
+			imageStreamObjects := make([]client.Object, len(surplus))
+			for i := range surplus {
+				imageStreamObjects[i] = &surplus[i]
 			}
 
 			if err := r.deletionManager.DeleteResourcesBatched(ctx, config, imageStreamObjects, "imageStreams", toDelete); err != nil {
@@ -681,12 +713,12 @@ func (r *ScaleLoadConfigReconciler) manageImageStreams(ctx context.Context,
 			log.V(1).Info("ImageStreams deleted with enhanced batching", "targetDeleted", toDelete)
 		} else {
 			// Use legacy deletion for backward compatibility
-			deleted := r.deleteImageStreamsLegacy(ctx, config, imageStreamList.Items, toDelete, log)
+			deleted := r.deleteImageStreamsLegacy(ctx, config, surplus, toDelete, log)
 			log.V(1).Info("ImageStreams deleted", "count", deleted, "apiCalls", deleted)
 		}
 	}
 
-	return targetCount, nil
+	return targetCount + offset, nil
 }
 
 // deleteImageStreamsLegacy provides backward compatibility for imagestream deletion
@@ -710,7 +742,7 @@ func (r *ScaleLoadConfigReconciler) deleteImageStreamsLegacy(ctx context.Context
 
 // generateImageStream creates a realistic ImageStream resource
 func (r *ScaleLoadConfigReconciler) generateImageStream(config *scalev1.ScaleLoadConfig, namespace string, index int32) *imagev1.ImageStream {
-	name := r.generateUniqueImageStreamName(namespace, int(index))
+	name := r.generateUniqueImageStreamName(config, int(index))
 
 	return &imagev1.ImageStream{
 		ObjectMeta: metav1.ObjectMeta{
@@ -763,7 +795,7 @@ func (r *ScaleLoadConfigReconciler) manageBuildConfigs(ctx context.Context,
 	log.V(1).Info("Performing buildconfig operations within update frequency window")
 
 	// Check maximum limit and adjust target count if needed
-	effectiveTargetCount, err := r.checkMaximumLimit(ctx, config, "buildConfigs", targetCount, config.Spec.ResourceChurn.BuildConfigs.Maximum)
+	effectiveTargetCount, err := r.checkMaximumLimit(ctx, config, namespace, "buildConfigs", targetCount, config.Spec.ResourceChurn.BuildConfigs.Maximum)
 	if err != nil {
 		return 0, fmt.Errorf("failed to check maximum limit for buildConfigs: %w", err)
 	}
@@ -792,32 +824,62 @@ func (r *ScaleLoadConfigReconciler) manageBuildConfigs(ctx context.Context,
 				"namespace", namespace)
 			return 0, nil // Return 0 count but no error to continue with other resources
 		}
+		if meta.IsNoMatchError(err) {
+			log.V(1).Info("BuildConfig API not available, skipping buildconfigs")
+			r.setAPIAvailable(config.Name, "BuildConfig", false)
+			return 0, nil
+		}
 		return 0, fmt.Errorf("failed to list BuildConfigs: %w", err)
 	}
+	r.setAPIAvailable(config.Name, "BuildConfig", true)
 	r.recordAPICall(config, 1) // List operation
 
 	currentCount := len(buildConfigList.Items)
+	missing, surplus := expectedIndexDiff(buildConfigList.Items, generatedKindPrefix("buildconfig"), targetCount)
+	present := targetCount - int32(len(missing))
+	// maxOperationsPerCycle spreads a large jump in the target over several cycles
+	missing, surplus, offset := limitCycleOperations(ctx, missing, surplus)
 
-	log.V(1).Info("BuildConfig management starting", "current", currentCount, "target", targetCount)
+	log.V(1).Info("BuildConfig management starting", "current", currentCount, "target", targetCount,
+		"missing", len(missing), "surplus", len(surplus))
 
-	// Scale up if needed
-	if int32(currentCount) < targetCount {
-		toCreate := targetCount - int32(currentCount)
+	// Create the expected BuildConfigs that are missing
+	if len(missing) > 0 {
 		var created int32
-		for i := int32(currentCount); i < targetCount; i++ {
+		for _, i := range missing {
 			buildConfig := r.generateBuildConfig(config, namespace, i)
-			if err := r.Create(ctx, buildConfig); err != nil {
-				return int32(currentCount) + created, fmt.Errorf("failed to create BuildConfig: %w", err)
+			if err := r.createOrAdopt(ctx, config, buildConfig); err != nil {
+				return present + created, fmt.Errorf("failed to create BuildConfig: %w", err)
 			}
 			r.recordAPICall(config, 1) // Create operation
 			created++
 		}
-		log.V(1).Info("BuildConfigs created", "count", toCreate, "apiCalls", created)
+		log.V(1).Info("BuildConfigs created", "count", created, "apiCalls", created)
+	}
+
+	// Builds of the BuildConfigs that are kept push new images to their ImageStreams
+	if config.Spec.ResourceChurn.BuildPushes.Enabled {
+		removed := make(map[string]bool, len(surplus))
+		for _, buildConfig := range surplus {
+			removed[buildConfig.Name] = true
+		}
+		var built []buildv1.BuildConfig
+		for _, buildConfig := range buildConfigList.Items {
+			if !removed[buildConfig.Name] {
+				built = append(built, buildConfig)
+			}
+		}
+		pushed, err := r.simulateBuildPushes(ctx, config, namespace, built)
+		if err != nil {
+			log.Error(err, "Failed to simulate build pushes")
+		} else if pushed > 0 {
+			log.V(1).Info("Build images pushed", "count", pushed, "apiCalls", pushed)
+		}
 	}
 
-	// Scale down if needed
-	if int32(currentCount) > targetCount {
-		toDelete := int32(currentCount) - targetCount
+	// Delete the BuildConfigs outside the expected set
+	if len(surplus) > 0 {
+		toDelete := int32(len(surplus))
 
 		// Check if we can perform deletion safely
 		if !r.deletionManager.CanPerformDeletion("buildConfigs", config) {
@@ -829,9 +891,9 @@ func (r *ScaleLoadConfigReconciler) manageBuildConfigs(ctx context.Context,
 		// Use enhanced deletion if safe deletion is enabled
 		if config.Spec.ResourceChurn.BuildConfigs.SafeDeletionEnabled {
 			// Convert buildconfigs to client.Object slice
-			buildConfigObjects := make([]client.Object, len(buildConfigList.Items))
-			for i := range buildConfigList.Items {
-				buildConfigObjects[i] = &buildConfigList.Items[i]
+			buildConfigObjects := make([]client.Object, len(surplus))
+			for i := range surplus {
+				buildConfigObjects[i] = &surplus[i]
 			}
 
 			if err := r.deletionManager.DeleteResourcesBatched(ctx, config, buildConfigObjects, "buildConfigs", toDelete); err != nil {
@@ -841,12 +903,12 @@ func (r *ScaleLoadConfigReconciler) manageBuildConfigs(ctx context.Context,
 			log.V(1).Info("BuildConfigs deleted with enhanced batching", "targetDeleted", toDelete)
 		} else {
 			// Use legacy deletion for backward compatibility
-			deleted := r.deleteBuildConfigsLegacy(ctx, config, buildConfigList.Items, toDelete, log)
+			deleted := r.deleteBuildConfigsLegacy(ctx, config, surplus, toDelete, log)
 			log.V(1).Info("BuildConfigs deleted", "count", deleted, "apiCalls", deleted)
 		}
 	}
 
-	return targetCount, nil
+	return targetCount + offset, nil
 }
 
 // deleteBuildConfigsLegacy provides backward compatibility for buildconfig deletion
@@ -870,8 +932,8 @@ func (r *ScaleLoadConfigReconciler) deleteBuildConfigsLegacy(ctx context.Context
 
 // generateBuildConfig creates a realistic BuildConfig resource
 func (r *ScaleLoadConfigReconciler) generateBuildConfig(config *scalev1.ScaleLoadConfig, namespace string, index int32) *buildv1.BuildConfig {
-	name := r.generateUniqueBuildConfigName(namespace, int(index))
-	imageStreamName := r.generateUniqueImageStreamName(namespace, int(index))
+	name := r.generateUniqueBuildConfigName(config, int(index))
+	imageStreamName := r.generateUniqueImageStreamName(config, int(index))
 
 	return &buildv1.BuildConfig{
 		ObjectMeta: metav1.ObjectMeta{
@@ -913,83 +975,118 @@ func (r *ScaleLoadConfigReconciler) generateBuildConfig(config *scalev1.ScaleLoa
 	}
 }
 
-// manageEvents creates realistic Event resources to simulate cluster activity
-func (r *ScaleLoadConfigReconciler) manageEvents(ctx context.Context,
-	config *scalev1.ScaleLoadConfig, namespace string) (int32, error) {
+// manageNodeEvents emits EventsPerNodePerHour events for every KWOK node. Each event is attributed to
+// its node through source.host and written to a namespace associated with the node, so the per-node
+// rate holds however far the node pool scales
+func (r *ScaleLoadConfigReconciler) manageNodeEvents(ctx context.Context, config *scalev1.ScaleLoadConfig,
+	kwokNodes []corev1.Node) (int, error) {
 
-	log := r.Log.WithName("event-manager").WithValues("namespace", namespace)
+	log := r.Log.WithName("event-manager")
+	ctx = withSubsystem(ctx, subsystemEventGen)
 
-	// Calculate how many events to generate based on time and rate
 	eventsPerHour := config.Spec.ResourceChurn.Events.EventsPerNodePerHour
 	if eventsPerHour <= 0 {
 		eventsPerHour = 50 // Default from analysis
 	}
-
-	// Generate events at the calculated rate
-	timeSinceLastReconcile := time.Since(r.lastReconcileTime)
-	if r.lastReconcileTime.IsZero() {
-		timeSinceLastReconcile = 1 * time.Minute // Default for first run
+	elapsed, skipped := r.nodeEventWindow(config.Name)
+	if len(kwokNodes) == 0 || elapsed <= 0 {
+		return 0, nil
 	}
+	perHour := float64(eventsPerHour) * float64(len(kwokNodes))
+	// Events owed for time beyond the window cap are never made up
+	capDropped := int(math.Round(perHour * skipped.Hours()))
 
-	eventsToCreate := int32(float64(eventsPerHour) * timeSinceLastReconcile.Hours())
-	if eventsToCreate > 10 {
-		eventsToCreate = 10 // Cap to prevent spam
+	namespaces, err := r.getManagedNamespaces(ctx, config)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list namespaces for node events: %w", err)
 	}
+	r.recordAPICall(config, 1)
 
-	log.V(1).Info("Event generation starting",
-		"eventsPerHour", eventsPerHour,
-		"timeSinceLastReconcile", timeSinceLastReconcile.String(),
-		"targetEvents", eventsToCreate)
-
-	var createdCount, failedCount, apiCalls int32
-	for i := int32(0); i < eventsToCreate; i++ {
-		event := r.generateEvent(config, namespace, i)
-		if err := r.Create(ctx, event); err != nil {
-			// Events often conflict on creation, which is normal
-			failedCount++
-			log.V(2).Info("Event creation failed (normal)", "error", err.Error())
-		} else {
-			createdCount++
+	// Events go to the namespaces associated with their node, or any namespace when the node has none
+	var allNamespaces []string
+	namespacesByNode := make(map[string][]string)
+	for _, ns := range namespaces {
+		if namespaceTerminating(ns) {
+			continue
+		}
+		allNamespaces = append(allNamespaces, ns.Name)
+		if node := ns.Labels["scale.openshift.io/associated-node"]; node != "" {
+			namespacesByNode[node] = append(namespacesByNode[node], ns.Name)
 		}
-		apiCalls++
+	}
+	if len(allNamespaces) == 0 {
+		owed := int(math.Round(perHour * elapsed.Hours()))
+		r.recordEventPass(config, owed+capDropped, 0, map[string]int{eventDropWindowCap: capDropped, eventDropNoNamespaces: owed})
+		return 0, nil
 	}
 
-	// Calculate success rate safely to avoid division by zero
-	var successRate string
-	if eventsToCreate > 0 {
-		successRate = fmt.Sprintf("%.1f%%", float64(createdCount)/float64(eventsToCreate)*100)
-	} else {
-		successRate = "N/A (no events to create)"
+	// Fractional expectations are rounded up at random, so slow rates still average out per node
+	expected := float64(eventsPerHour) * elapsed.Hours()
+	var requested, created, failed int
+	for _, node := range kwokNodes {
+		count := int(expected)
+		if mathrand.Float64() < expected-float64(count) {
+			count++
+		}
+		requested += count
+		targets := namespacesByNode[node.Name]
+		if len(targets) == 0 {
+			targets = allNamespaces
+		}
+		for i := 0; i < count; i++ {
+			event := r.generateEvent(config, targets[mathrand.Intn(len(targets))], node.Name, int32(i))
+			if err := r.createOrAdopt(ctx, config, event); err != nil {
+				// Events often conflict on creation, which is normal
+				failed++
+				log.V(2).Info("Event creation failed (normal)", "node", node.Name, "error", err.Error())
+				continue
+			}
+			r.recordAPICall(config, 1)
+			created++
+		}
 	}
 
-	log.V(1).Info("Event generation completed",
-		"attempted", eventsToCreate,
-		"created", createdCount,
-		"failed", failedCount,
-		"successRate", successRate,
-		"apiCalls", apiCalls)
+	r.recordEventPass(config, requested+capDropped, created, map[string]int{eventDropWindowCap: capDropped, eventDropCreateFailed: failed})
 
-	return createdCount, nil
+	log.V(1).Info("Node event generation completed",
+		"nodes", len(kwokNodes),
+		"eventsPerNodePerHour", eventsPerHour,
+		"window", elapsed.String(),
+		"created", created,
+		"failed", failed,
+		"droppedByWindowCap", capDropped)
+	return created, nil
 }
 
-// generateEvent creates realistic Event resources
-func (r *ScaleLoadConfigReconciler) generateEvent(config *scalev1.ScaleLoadConfig, namespace string, index int32) *corev1.Event {
-	eventTypes := []scalev1.EventTypeConfig{
-		{Type: "Normal", Reason: "Started", Message: "Container started successfully", Weight: 30},
-		{Type: "Normal", Reason: "Created", Message: "Created container %s", Weight: 25},
-		{Type: "Normal", Reason: "Pulled", Message: "Successfully pulled image", Weight: 20},
-		{Type: "Warning", Reason: "FailedMount", Message: "Unable to mount volumes", Weight: 10},
-		{Type: "Warning", Reason: "FailedScheduling", Message: "Pod scheduling failed", Weight: 8},
-		{Type: "Normal", Reason: "Scheduled", Message: "Successfully assigned pod", Weight: 7},
-	}
+// maxNodeEventWindow caps the time a single node event pass makes up for
+const maxNodeEventWindow = 5 * time.Minute
 
-	// Override with custom event types if provided
-	if len(config.Spec.ResourceChurn.Events.EventTypes) > 0 {
-		eventTypes = config.Spec.ResourceChurn.Events.EventTypes
+// nodeEventWindow returns the time since the config's previous node event pass and starts the next one.
+// The first pass covers a minute, and long gaps are capped so a stalled operator does not burst on
+// recovery; the time cut off by the cap is returned as skipped
+func (r *ScaleLoadConfigReconciler) nodeEventWindow(configName string) (window, skipped time.Duration) {
+	now := time.Now()
+	if r.lastNodeEventTime == nil {
+		r.lastNodeEventTime = make(map[string]time.Time)
+	}
+	last, seen := r.lastNodeEventTime[configName]
+	r.lastNodeEventTime[configName] = now
+	if !seen {
+		return time.Minute, 0
+	}
+	window = now.Sub(last)
+	if window > maxNodeEventWindow {
+		return maxNodeEventWindow, window - maxNodeEventWindow
 	}
+	return window, 0
+}
 
+// generateEvent creates realistic Event resources about a pod on the given node
+func (r *ScaleLoadConfigReconciler) generateEvent(config *scalev1.ScaleLoadConfig, namespace, nodeName string, index int32) *corev1.Event {
 	// Select random event type based on weights
-	selectedEvent := selectWeightedEventType(eventTypes)
+	selectedEvent := selectWeightedEventType(namespaceEventTypes(config.Spec.ResourceChurn.Events))
+	source := pickEventSource(eventSourceIdentities(namespace, config.Spec.ResourceChurn.Events.SourceIdentities))
+	firstTimestamp, lastTimestamp, count := eventOccurrences(config.Spec.ResourceChurn.Events, time.Now())
 
 	involvedObject := corev1.ObjectReference{
 		Kind:       "Pod",
@@ -1000,7 +1097,7 @@ func (r *ScaleLoadConfigReconciler) generateEvent(config *scalev1.ScaleLoadConfi
 
 	return &corev1.Event{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      fmt.Sprintf("sim-event-%d-%d", index, time.Now().Unix()),
+			Name:      fmt.Sprintf("sim-event-%s-%d-%d", configNameHash(config.Name), index, time.Now().Unix()),
 			Namespace: namespace,
 			Labels: map[string]string{
 				"scale.openshift.io/managed-by":    config.Name,
@@ -1013,160 +1110,88 @@ func (r *ScaleLoadConfigReconciler) generateEvent(config *scalev1.ScaleLoadConfi
 		Message:        fmt.Sprintf(selectedEvent.Message, fmt.Sprintf("container-%d", index)),
 		Type:           selectedEvent.Type,
 		Source: corev1.EventSource{
-			Component: "sim-operator",
+			Component: source.component,
+			Host:      nodeName,
 		},
-		FirstTimestamp: metav1.NewTime(time.Now()),
-		LastTimestamp:  metav1.NewTime(time.Now()),
-		Count:          1,
-	}
-}
-
-// Helper functions for generating realistic data
-
-func generateAppProperties() string {
-	return fmt.Sprintf(`# Application Configuration
-app.name=sim-generator-app
-app.version=1.0.%d
-app.debug=false
-app.port=8080
-app.threads=%d
-app.memory.max=512m
-database.url=jdbc:postgresql://db:5432/app
-database.pool.size=%d`,
-		mathrand.Intn(100), mathrand.Intn(10)+1, mathrand.Intn(20)+5)
-}
-
-func generateConfigYAML() string {
-	return fmt.Sprintf(`apiVersion: v1
-kind: Config
-metadata:
-  name: app-config
-spec:
-  replicas: %d
-  resources:
-    requests:
-      cpu: %dm
-      memory: %dMi
-  environment:
-    - name: LOG_LEVEL
-      value: INFO
-    - name: INSTANCE_ID
-      value: "%s"`,
-		mathrand.Intn(5)+1, mathrand.Intn(500)+100, mathrand.Intn(512)+128, generateRandomString(8))
-}
-
-func generateSettingsJSON() string {
-	return fmt.Sprintf(`{
-  "app": {
-    "name": "sim-generator",
-    "version": "1.0.%d",
-    "environment": "production"
-  },
-  "features": {
-    "enableMetrics": true,
-    "enableTracing": %t,
-    "cacheSize": %d
-  },
-  "networking": {
-    "timeout": %d,
-    "retries": %d
-  }
-}`, mathrand.Intn(100), mathrand.Intn(2) == 1, mathrand.Intn(1000)+100, mathrand.Intn(30)+5, mathrand.Intn(5)+1)
-}
-
-func generateRandomString(length int) string {
-	const charset = "abcdefghijklmnopqrstuvwxyz0123456789"
-	result := make([]byte, length)
-	for i := range result {
-		result[i] = charset[mathrand.Intn(len(charset))]
-	}
-	return string(result)
-}
-
-// generateUniquePodName creates a unique pod name to avoid conflicts
-func (r *ScaleLoadConfigReconciler) generateUniquePodName(namespace string, index int) string {
+		ReportingController: source.component,
+		ReportingInstance:   source.instance,
+		FirstTimestamp:      metav1.NewTime(firstTimestamp),
+		LastTimestamp:       metav1.NewTime(lastTimestamp),
+		Count:               count,
+	}
+}
+
+// eventOccurrences spreads an event's first occurrence over the configured window and, for the
+// share of events that form a series, picks a count and a last occurrence between the first one and now
+func eventOccurrences(events scalev1.EventsConfig, now time.Time) (first, last time.Time, count int32) {
+	first = now
+	if events.TimestampSpreadSeconds > 0 {
+		first = now.Add(-time.Duration(mathrand.Int63n(int64(events.TimestampSpreadSeconds)*int64(time.Second) + 1)))
+	}
+
+	chance, err := parseFloat(events.SeriesChance)
+	if err != nil || chance <= 0 || mathrand.Float64() >= chance {
+		return first, first, 1
+	}
+	maxCount := events.MaxSeriesCount
+	if maxCount < 2 {
+		maxCount = 20
+	}
+	count = 2 + mathrand.Int31n(maxCount-1)
+	// The series was last seen somewhere between its first occurrence and now
+	last = first.Add(time.Duration(mathrand.Int63n(int64(now.Sub(first)) + 1)))
+	return first, last, count
+}
+
+// generateUniquePodName creates a unique pod name to avoid conflicts; like the other generated
+// names it carries a hash of the config name so configs sharing a namespace never collide
+func (r *ScaleLoadConfigReconciler) generateUniquePodName(config *scalev1.ScaleLoadConfig, index int) string {
 	// Include timestamp and random suffix to ensure uniqueness
 	timestamp := time.Now().Unix()
-	randomSuffix := generateRandomString(4)
-	return fmt.Sprintf("sim-pod-%d-%d-%s", index, timestamp, randomSuffix)
+	randomSuffix := generator.RandomString(4)
+	return fmt.Sprintf("sim-pod-%s-%d-%d-%s", configNameHash(config.Name), index, timestamp, randomSuffix)
 }
 
 // generateUniqueConfigMapName creates a unique configmap name to avoid conflicts
-func (r *ScaleLoadConfigReconciler) generateUniqueConfigMapName(namespace string, index int) string {
+func (r *ScaleLoadConfigReconciler) generateUniqueConfigMapName(config *scalev1.ScaleLoadConfig, index int) string {
 	timestamp := time.Now().Unix()
-	randomSuffix := generateRandomString(4)
-	return fmt.Sprintf("sim-configmap-%d-%d-%s", index, timestamp, randomSuffix)
+	randomSuffix := generator.RandomString(4)
+	return fmt.Sprintf("sim-configmap-%s-%d-%d-%s", configNameHash(config.Name), index, timestamp, randomSuffix)
 }
 
 // generateUniqueSecretName creates a unique secret name to avoid conflicts
-func (r *ScaleLoadConfigReconciler) generateUniqueSecretName(namespace string, index int) string {
+func (r *ScaleLoadConfigReconciler) generateUniqueSecretName(config *scalev1.ScaleLoadConfig, index int) string {
 	timestamp := time.Now().Unix()
-	randomSuffix := generateRandomString(4)
-	return fmt.Sprintf("sim-secret-%d-%d-%s", index, timestamp, randomSuffix)
+	randomSuffix := generator.RandomString(4)
+	return fmt.Sprintf("sim-secret-%s-%d-%d-%s", configNameHash(config.Name), index, timestamp, randomSuffix)
 }
 
 // generateUniqueRouteName creates a unique route name to avoid conflicts
-func (r *ScaleLoadConfigReconciler) generateUniqueRouteName(namespace string, index int) string {
+func (r *ScaleLoadConfigReconciler) generateUniqueRouteName(config *scalev1.ScaleLoadConfig, index int) string {
 	timestamp := time.Now().Unix()
-	randomSuffix := generateRandomString(4)
-	return fmt.Sprintf("sim-route-%d-%d-%s", index, timestamp, randomSuffix)
+	randomSuffix := generator.RandomString(4)
+	return fmt.Sprintf("sim-route-%s-%d-%d-%s", configNameHash(config.Name), index, timestamp, randomSuffix)
 }
 
 // generateUniqueServiceName creates a unique service name to avoid conflicts
-func (r *ScaleLoadConfigReconciler) generateUniqueServiceName(namespace string, index int) string {
+func (r *ScaleLoadConfigReconciler) generateUniqueServiceName(config *scalev1.ScaleLoadConfig, index int) string {
 	timestamp := time.Now().Unix()
-	randomSuffix := generateRandomString(4)
-	return fmt.Sprintf("sim-service-%d-%d-%s", index, timestamp, randomSuffix)
+	randomSuffix := generator.RandomString(4)
+	return fmt.Sprintf("sim-service-%s-%d-%d-%s", configNameHash(config.Name), index, timestamp, randomSuffix)
 }
 
 // generateUniqueImageStreamName creates a unique imagestream name to avoid conflicts
-func (r *ScaleLoadConfigReconciler) generateUniqueImageStreamName(namespace string, index int) string {
+func (r *ScaleLoadConfigReconciler) generateUniqueImageStreamName(config *scalev1.ScaleLoadConfig, index int) string {
 	timestamp := time.Now().Unix()
-	randomSuffix := generateRandomString(4)
-	return fmt.Sprintf("sim-imagestream-%d-%d-%s", index, timestamp, randomSuffix)
+	randomSuffix := generator.RandomString(4)
+	return fmt.Sprintf("sim-imagestream-%s-%d-%d-%s", configNameHash(config.Name), index, timestamp, randomSuffix)
 }
 
 // generateUniqueBuildConfigName creates a unique buildconfig name to avoid conflicts
-func (r *ScaleLoadConfigReconciler) generateUniqueBuildConfigName(namespace string, index int) string {
+func (r *ScaleLoadConfigReconciler) generateUniqueBuildConfigName(config *scalev1.ScaleLoadConfig, index int) string {
 	timestamp := time.Now().Unix()
-	randomSuffix := generateRandomString(4)
-	return fmt.Sprintf("sim-buildconfig-%d-%d-%s", index, timestamp, randomSuffix)
-}
-
-func generateRandomPassword(length int) string {
-	bytes := make([]byte, length)
-	if _, err := rand.Read(bytes); err != nil {
-		// Fallback to math/rand if crypto/rand fails
-		for i := range bytes {
-			bytes[i] = byte(mathrand.Intn(256))
-		}
-	}
-	return base64.URLEncoding.EncodeToString(bytes)[:length]
-}
-
-func generateRandomAPIKey() string {
-	bytes := make([]byte, 32)
-	if _, err := rand.Read(bytes); err != nil {
-		// Fallback to math/rand if crypto/rand fails
-		for i := range bytes {
-			bytes[i] = byte(mathrand.Intn(256))
-		}
-	}
-	return base64.URLEncoding.EncodeToString(bytes)
-}
-
-func generateSecretConfig() string {
-	return fmt.Sprintf(`apiVersion: v1
-secret:
-  database:
-    username: user_%s
-    password: %s
-    host: db.internal
-    port: 5432
-  api:
-    key: %s
-    endpoint: https://api.example.com`,
-		generateRandomString(6), generateRandomPassword(16), generateRandomAPIKey())
+	randomSuffix := generator.RandomString(4)
+	return fmt.Sprintf("sim-buildconfig-%s-%d-%d-%s", configNameHash(config.Name), index, timestamp, randomSuffix)
 }
 
 func selectWeightedEventType(events []scalev1.EventTypeConfig) scalev1.EventTypeConfig {
@@ -1201,16 +1226,31 @@ func (r *ScaleLoadConfigReconciler) checkNamespaceStatus(ctx context.Context, na
 	if phase == "" {
 		phase = "Unknown"
 	}
+	if namespaceTerminating(*namespace) {
+		// The deletion timestamp is set before the namespace controller moves the phase to Terminating
+		return false, string(corev1.NamespaceTerminating)
+	}
 
 	// Namespace is ready if it's Active or if phase is empty (newly created)
 	ready := namespace.Status.Phase == corev1.NamespaceActive || namespace.Status.Phase == ""
 	return ready, phase
 }
 
-// isNamespaceReady checks if a namespace exists and is in Active phase
-func (r *ScaleLoadConfigReconciler) isNamespaceReady(ctx context.Context, namespaceName string) bool {
-	ready, _ := r.checkNamespaceStatus(ctx, namespaceName)
-	return ready
+// namespaceTerminating reports whether a namespace is being deleted
+func namespaceTerminating(namespace corev1.Namespace) bool {
+	return namespace.DeletionTimestamp != nil || namespace.Status.Phase == corev1.NamespaceTerminating
+}
+
+// namespaceGone reports whether a phase from checkNamespaceStatus means the namespace is being or has been deleted
+func namespaceGone(phase string) bool {
+	return phase == string(corev1.NamespaceTerminating) || phase == "NotFound"
+}
+
+// recordNamespaceSkipped counts a namespace left out of a churn pass because it is being deleted
+func (r *ScaleLoadConfigReconciler) recordNamespaceSkipped(phase string) {
+	if r.NamespacesSkipped != nil {
+		r.NamespacesSkipped.WithLabelValues(phase).Inc()
+	}
 }
 
 // performResourceChurn simulates realistic resource update patterns
@@ -1261,9 +1301,10 @@ func (r *ScaleLoadConfigReconciler) performResourceChurn(ctx context.Context,
 }
 
 // checkMaximumLimit checks if we've reached the maximum limit for a resource type
-// Returns the effective target count (may be less than requested if at limit)
+// Returns the effective target count of the namespace (may be less than requested if at limit). The
+// namespace's own resources are left out of the existing count, since the target replaces them
 func (r *ScaleLoadConfigReconciler) checkMaximumLimit(ctx context.Context,
-	config *scalev1.ScaleLoadConfig, resourceType string, requestedCount int32,
+	config *scalev1.ScaleLoadConfig, namespace string, resourceType string, requestedCount int32,
 	maximumLimit int32) (int32, error) {
 
 	// If maximum is 0, no limit
@@ -1284,8 +1325,11 @@ func (r *ScaleLoadConfigReconciler) checkMaximumLimit(ctx context.Context,
 		}
 	}
 
-	// Count resources across all namespaces
+	// Count resources across all other namespaces
 	for _, ns := range namespaces {
+		if ns.Name == namespace {
+			continue
+		}
 		var count int32
 		switch resourceType {
 		case "pods":
@@ -1399,47 +1443,83 @@ func (r *ScaleLoadConfigReconciler) countExistingBuildConfigs(ctx context.Contex
 
 // recordAPICall records API calls for simplified rate tracking and metrics
 func (r *ScaleLoadConfigReconciler) recordAPICall(config *scalev1.ScaleLoadConfig, callCount int32) {
-	now := time.Now()
-
-	// Simplified rate tracking (resets every minute)
-	if r.lastRateReset.IsZero() || now.Sub(r.lastRateReset) >= time.Minute {
-		r.apiCallsThisMinute = 0
-		r.lastRateReset = now
-	}
-	r.apiCallsThisMinute += callCount
-
-	// Cumulative metrics counter (for accurate reporting)
-	r.totalAPICallsMade += int64(callCount)
+	// Simplified rate tracking (resets every minute) and the cumulative count for reporting
+	callsThisMinute, totalCalls := r.apiCalls.add(callCount, time.Now())
+	r.RunMetrics.addAPICalls(config, callCount)
 
 	// Debug logging every 5000 calls to reduce spam at scale
-	if r.totalAPICallsMade%5000 == 0 {
+	if totalCalls%5000 == 0 {
 		log := r.Log.WithName("api-call-tracker")
 		log.Info("API calls milestone",
-			"totalAPICallsMade", r.totalAPICallsMade,
-			"apiCallsThisMinute", r.apiCallsThisMinute)
+			"totalAPICallsMade", totalCalls,
+			"apiCallsThisMinute", callsThisMinute)
 	}
 
 	// Record prometheus metrics
 	r.APICallRate.Observe(float64(callCount))
 }
 
-// Resource timing tracking for frequency-based operations
-var resourceLastOperationTimes = make(map[string]map[string]time.Time) // namespace -> resourceType -> lastTime
-var resourceTimingMutex sync.RWMutex
+// apiCallTracker counts the API calls of the current one-minute window and in total. Background
+// churn records calls from its parallel namespace workers, so it is guarded by a mutex
+type apiCallTracker struct {
+	mu          sync.Mutex
+	thisMinute  int32
+	windowStart time.Time
+	total       int64
+}
+
+// roll starts a new window once a minute has passed since the current one started, reporting
+// whether it did. Callers hold the mutex
+func (t *apiCallTracker) roll(now time.Time) bool {
+	if !t.windowStart.IsZero() && now.Sub(t.windowStart) < time.Minute {
+		return false
+	}
+	t.thisMinute = 0
+	t.windowStart = now
+	return true
+}
+
+// add counts calls, returning the calls of the current window and in total after them
+func (t *apiCallTracker) add(calls int32, now time.Time) (int32, int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.roll(now)
+	t.thisMinute += calls
+	t.total += int64(calls)
+	return t.thisMinute, t.total
+}
+
+// window returns the calls of the current window, when it started and whether it just started
+func (t *apiCallTracker) window(now time.Time) (int32, time.Time, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	fresh := t.roll(now)
+	return t.thisMinute, t.windowStart, fresh
+}
+
+// resourceTiming tracks when each resource type was last operated on per namespace, for frequency-based
+// operations. Each reconciler has its own, since target clusters reuse the same namespace names
+type resourceTiming struct {
+	mu             sync.Mutex
+	lastOperation  map[string]map[string]time.Time // namespace -> resourceType -> lastTime
+	phaseIntervals map[string]time.Duration        // namespace/resourceType -> interval to phase the first operation over
+}
 
 // shouldPerformResourceOperation checks if enough time has passed since last operation for this resource type
 func (r *ScaleLoadConfigReconciler) shouldPerformResourceOperation(namespace, resourceType string, minFrequency, maxFrequency int32) bool {
-	resourceTimingMutex.RLock()
-	defer resourceTimingMutex.RUnlock()
-
-	// Initialize namespace map if it doesn't exist
-	if resourceLastOperationTimes[namespace] == nil {
-		return true // First time, always perform operation
-	}
+	timing := &r.resourceTiming
+	timing.mu.Lock()
+	defer timing.mu.Unlock()
 
-	lastTime, exists := resourceLastOperationTimes[namespace][resourceType]
+	lastTime, exists := timing.lastOperation[namespace][resourceType]
 	if !exists {
-		return true // First time for this resource type, always perform operation
+		// First time, always perform operation; remember the interval so the timestamp
+		// recorded afterwards can be given this namespace's phase offset
+		if timing.phaseIntervals == nil {
+			timing.phaseIntervals = make(map[string]time.Duration)
+		}
+		timing.phaseIntervals[namespace+"/"+resourceType] = time.Duration(minFrequency) * time.Second
+		return true
 	}
 
 	// Calculate random interval within the specified range
@@ -1468,17 +1548,52 @@ func (r *ScaleLoadConfigReconciler) shouldPerformResourceOperation(namespace, re
 	return shouldPerform
 }
 
+// resetResourceOperation forgets the last operation time, so the next check for the resource type performs the operation
+func (r *ScaleLoadConfigReconciler) resetResourceOperation(namespace, resourceType string) {
+	timing := &r.resourceTiming
+	timing.mu.Lock()
+	defer timing.mu.Unlock()
+
+	delete(timing.lastOperation[namespace], resourceType)
+}
+
+// forgetResourceTiming drops the operation times and pending phase intervals of a deleted namespace
+func (r *ScaleLoadConfigReconciler) forgetResourceTiming(namespace string) {
+	timing := &r.resourceTiming
+	timing.mu.Lock()
+	defer timing.mu.Unlock()
+
+	delete(timing.lastOperation, namespace)
+	for key := range timing.phaseIntervals {
+		if strings.HasPrefix(key, namespace+"/") {
+			delete(timing.phaseIntervals, key)
+		}
+	}
+}
+
 // updateLastResourceOperation updates the last operation time for a resource type in a namespace
 func (r *ScaleLoadConfigReconciler) updateLastResourceOperation(namespace, resourceType string) {
-	resourceTimingMutex.Lock()
-	defer resourceTimingMutex.Unlock()
+	timing := &r.resourceTiming
+	timing.mu.Lock()
+	defer timing.mu.Unlock()
 
 	// Initialize namespace map if it doesn't exist
-	if resourceLastOperationTimes[namespace] == nil {
-		resourceLastOperationTimes[namespace] = make(map[string]time.Time)
+	if timing.lastOperation == nil {
+		timing.lastOperation = make(map[string]map[string]time.Time)
+	}
+	if timing.lastOperation[namespace] == nil {
+		timing.lastOperation[namespace] = make(map[string]time.Time)
 	}
 
-	resourceLastOperationTimes[namespace][resourceType] = time.Now()
+	now := time.Now()
+	key := namespace + "/" + resourceType
+	if interval, first := timing.phaseIntervals[key]; first {
+		// Backdate the first operation by a stable per-namespace offset, so namespaces created in the
+		// same reconcile churn at different points of the interval instead of in synchronized waves
+		now = now.Add(-churnPhaseOffset(namespace, resourceType, interval))
+		delete(timing.phaseIntervals, key)
+	}
+	timing.lastOperation[namespace][resourceType] = now
 
 	r.Log.V(1).Info("Updated resource operation timestamp",
 		"namespace", namespace,
@@ -1486,6 +1601,23 @@ func (r *ScaleLoadConfigReconciler) updateLastResourceOperation(namespace, resou
 		"timestamp", time.Now().Format(time.RFC3339))
 }
 
+// churnPhaseOffset returns a stable offset within the interval derived from the namespace and resource type
+func churnPhaseOffset(namespace, resourceType string, interval time.Duration) time.Duration {
+	if interval <= 0 {
+		return 0
+	}
+	hash := fnv.New64a()
+	hash.Write([]byte(namespace + "/" + resourceType))
+	return time.Duration(hash.Sum64() % uint64(interval))
+}
+
+// configNameHash returns a short stable hash of a config name for use in generated object names
+func configNameHash(configName string) string {
+	hash := fnv.New32a()
+	hash.Write([]byte(configName))
+	return fmt.Sprintf("%06x", hash.Sum32()&0xffffff)
+}
+
 // getCurrentResourceCount gets the current count of resources without performing any operations
 func (r *ScaleLoadConfigReconciler) getCurrentResourceCount(ctx context.Context, config *scalev1.ScaleLoadConfig, namespace, resourceType string) (int32, error) {
 	switch resourceType {
@@ -1601,7 +1733,7 @@ func (r *ScaleLoadConfigReconciler) managePods(ctx context.Context,
 	log.V(1).Info("Performing pod operations within update frequency window")
 
 	// Check maximum limit and adjust target count if needed
-	effectiveTargetCount, err := r.checkMaximumLimit(ctx, config, "pods", targetCount, config.Spec.ResourceChurn.Pods.Maximum)
+	effectiveTargetCount, err := r.checkMaximumLimit(ctx, config, namespace, "pods", targetCount, config.Spec.ResourceChurn.Pods.Maximum)
 	if err != nil {
 		return 0, fmt.Errorf("failed to check maximum limit for pods: %w", err)
 	}
@@ -1629,24 +1761,26 @@ func (r *ScaleLoadConfigReconciler) managePods(ctx context.Context,
 	}
 	r.recordAPICall(config, 1) // List operation
 
-	currentCount := len(podList.Items)
+	missing, surplus := expectedIndexDiff(podList.Items, generatedKindPrefix("pod"), targetCount)
+	// maxOperationsPerCycle spreads a large jump in the target over several cycles
+	missing, surplus, offset := limitCycleOperations(ctx, missing, surplus)
 	log.V(1).Info("Pod management starting",
-		"current", currentCount,
+		"current", len(podList.Items),
 		"target", targetCount,
-		"targetCount", targetCount)
+		"missing", len(missing),
+		"surplus", len(surplus))
 
 	var totalApiCalls, created, deleted int32
 
-	// Scale up pods if needed
-	if int32(currentCount) < targetCount {
-		toCreate := targetCount - int32(currentCount)
-		log.V(1).Info("Creating pods", "count", toCreate)
+	// Create the expected pods that are missing
+	if len(missing) > 0 {
+		log.V(1).Info("Creating pods", "count", len(missing))
 
-		for i := int32(0); i < toCreate; i++ {
+		for _, i := range missing {
 			// Generate unique pod name to avoid conflicts
-			uniqueName := r.generateUniquePodName(namespace, currentCount+int(i))
+			uniqueName := r.generateUniquePodName(config, int(i))
 			pod := r.generatePod(config, namespace, uniqueName)
-			if err := r.Create(ctx, pod); err != nil {
+			if err := r.createOrAdopt(ctx, config, pod); err != nil {
 				log.Error(err, "Failed to create pod", "pod", pod.Name)
 				continue
 			}
@@ -1656,14 +1790,13 @@ func (r *ScaleLoadConfigReconciler) managePods(ctx context.Context,
 		}
 	}
 
-	// Scale down pods if needed
-	if int32(currentCount) > targetCount {
-		toDelete := int32(currentCount) - targetCount
-		log.V(1).Info("Deleting pods", "count", toDelete)
+	// Delete the pods outside the expected set
+	if len(surplus) > 0 {
+		log.V(1).Info("Deleting pods", "count", len(surplus))
 
-		for i := int32(len(podList.Items)) - 1; i >= int32(len(podList.Items))-toDelete && i >= 0; i-- {
-			pod := &podList.Items[i]
-			if err := r.Delete(ctx, pod); err != nil {
+		for i := range surplus {
+			pod := &surplus[i]
+			if err := r.Delete(ctx, pod); err != nil && !errors.IsNotFound(err) {
 				log.Error(err, "Failed to delete pod", "pod", pod.Name)
 				continue
 			}
@@ -1689,7 +1822,7 @@ func (r *ScaleLoadConfigReconciler) managePods(ctx context.Context,
 		"updated", updatedCount,
 		"totalApiCalls", totalApiCalls)
 
-	return targetCount, nil
+	return targetCount + offset, nil
 }
 
 // generatePod creates a Pod with realistic configuration for KWOK nodes
@@ -1793,6 +1926,11 @@ func (r *ScaleLoadConfigReconciler) generatePod(config *scalev1.ScaleLoadConfig,
 		}
 	}
 
+	// Pods of namespaces pinned to a subset of the KWOK nodes run there only
+	if len(config.Spec.NamespaceConfig.NodeSelector) > 0 {
+		pod.Spec.NodeSelector = workloadNodeSelector(config)
+	}
+
 	// Add node affinity to prefer KWOK nodes
 	if config.Spec.ResourceChurn.Pods.NodeAffinityStrategy != "" {
 		pod.Spec.Affinity = &corev1.Affinity{
@@ -1815,6 +1953,25 @@ func (r *ScaleLoadConfigReconciler) generatePod(config *scalev1.ScaleLoadConfig,
 		}
 	}
 
+	// Keep workloads in their namespace's synthetic zone
+	if zone := r.namespaceZoneFor(namespace); zone != "" {
+		if pod.Spec.Affinity == nil {
+			pod.Spec.Affinity = &corev1.Affinity{}
+		}
+		if pod.Spec.Affinity.NodeAffinity == nil {
+			pod.Spec.Affinity.NodeAffinity = &corev1.NodeAffinity{}
+		}
+		pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &corev1.NodeSelector{
+			NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+				MatchExpressions: []corev1.NodeSelectorRequirement{{
+					Key:      corev1.LabelTopologyZone,
+					Operator: corev1.NodeSelectorOpIn,
+					Values:   []string{zone},
+				}},
+			}},
+		}
+	}
+
 	return pod
 }
 
@@ -1874,6 +2031,8 @@ func parseResourceQuantity(s string) (resource.Quantity, error) {
 func (r *ScaleLoadConfigReconciler) manageResourceTypesParallel(ctx context.Context, config *scalev1.ScaleLoadConfig, namespace corev1.Namespace) map[string]int {
 	log := r.Log.WithName("resource-parallel").WithValues("namespace", namespace.Name)
 	startTime := time.Now()
+	// Churners see the namespace's archetype as the resource churn settings
+	config = configForNamespace(config, namespace)
 
 	// Result collection
 	type resourceResult struct {
@@ -1882,109 +2041,32 @@ func (r *ScaleLoadConfigReconciler) manageResourceTypesParallel(ctx context.Cont
 		err          error
 	}
 
-	resultsChan := make(chan resourceResult, 10) // Buffer for all resource types
+	resultsChan := make(chan resourceResult, len(resourceChurners))
 	var wg sync.WaitGroup
 
 	// Track which resource types to process
 	resourceTypes := []string{}
 
-	log.V(2).Info("Starting parallel resource management",
-		"configmapsEnabled", config.Spec.ResourceChurn.ConfigMaps.Enabled,
-		"secretsEnabled", config.Spec.ResourceChurn.Secrets.Enabled,
-		"routesEnabled", config.Spec.ResourceChurn.Routes.Enabled,
-		"imagestreamsEnabled", config.Spec.ResourceChurn.ImageStreams.Enabled,
-		"buildconfigsEnabled", config.Spec.ResourceChurn.BuildConfigs.Enabled,
-		"eventsEnabled", config.Spec.ResourceChurn.Events.Enabled,
-		"podsEnabled", config.Spec.ResourceChurn.Pods.Enabled)
-
-	// ConfigMaps
-	if config.Spec.ResourceChurn.ConfigMaps.Enabled {
-		if r.shouldCreateResourceForNamespace(namespace, config.Spec.ResourceChurn.ConfigMaps.NamespaceInterval) {
-			resourceTypes = append(resourceTypes, "configMaps")
-			wg.Add(1)
-			go func() {
-				defer wg.Done()
-				count, err := r.manageConfigMaps(ctx, config, namespace.Name, config.Spec.ResourceChurn.ConfigMaps.Count)
-				resultsChan <- resourceResult{"configMaps", count, err}
-			}()
-		}
-	}
-
-	// Secrets
-	if config.Spec.ResourceChurn.Secrets.Enabled {
-		if r.shouldCreateResourceForNamespace(namespace, config.Spec.ResourceChurn.Secrets.NamespaceInterval) {
-			resourceTypes = append(resourceTypes, "secrets")
-			wg.Add(1)
-			go func() {
-				defer wg.Done()
-				count, err := r.manageSecrets(ctx, config, namespace.Name, config.Spec.ResourceChurn.Secrets.Count)
-				resultsChan <- resourceResult{"secrets", count, err}
-			}()
-		}
-	}
-
-	// Routes
-	if config.Spec.ResourceChurn.Routes.Enabled {
-		if r.shouldCreateResourceForNamespace(namespace, config.Spec.ResourceChurn.Routes.NamespaceInterval) {
-			resourceTypes = append(resourceTypes, "routes")
-			wg.Add(1)
-			go func() {
-				defer wg.Done()
-				count, err := r.manageRoutes(ctx, config, namespace.Name, config.Spec.ResourceChurn.Routes.Count)
-				resultsChan <- resourceResult{"routes", count, err}
-			}()
-		}
-	}
-
-	// ImageStreams
-	if config.Spec.ResourceChurn.ImageStreams.Enabled {
-		if r.shouldCreateResourceForNamespace(namespace, config.Spec.ResourceChurn.ImageStreams.NamespaceInterval) {
-			resourceTypes = append(resourceTypes, "imageStreams")
-			wg.Add(1)
-			go func() {
-				defer wg.Done()
-				count, err := r.manageImageStreams(ctx, config, namespace.Name, config.Spec.ResourceChurn.ImageStreams.Count)
-				resultsChan <- resourceResult{"imageStreams", count, err}
-			}()
-		}
-	}
-
-	// BuildConfigs
-	if config.Spec.ResourceChurn.BuildConfigs.Enabled {
-		if r.shouldCreateResourceForNamespace(namespace, config.Spec.ResourceChurn.BuildConfigs.NamespaceInterval) {
-			resourceTypes = append(resourceTypes, "buildConfigs")
-			wg.Add(1)
-			go func() {
-				defer wg.Done()
-				count, err := r.manageBuildConfigs(ctx, config, namespace.Name, config.Spec.ResourceChurn.BuildConfigs.Count)
-				resultsChan <- resourceResult{"buildConfigs", count, err}
-			}()
-		}
-	}
-
-	// Events (no namespace interval check)
-	if config.Spec.ResourceChurn.Events.Enabled {
-		resourceTypes = append(resourceTypes, "events")
+	for _, churner := range resourceChurners {
+		if !churner.Enabled(config) {
+			continue
+		}
+		if !r.shouldCreateResourceForNamespace(namespace, churner.NamespaceInterval(config)) {
+			continue
+		}
+		if !namespaceTargeted(namespace, churner.NamespaceTargeting(config)) {
+			continue
+		}
+		resourceTypes = append(resourceTypes, churner.Name())
 		wg.Add(1)
-		go func() {
+		go func(churner ResourceChurner) {
 			defer wg.Done()
-			count, err := r.manageEvents(ctx, config, namespace.Name)
-			resultsChan <- resourceResult{"events", count, err}
-		}()
+			count, err := r.runChurner(ctx, churner, config, namespace.Name)
+			resultsChan <- resourceResult{churner.Name(), count, err}
+		}(churner)
 	}
 
-	// Pods
-	if config.Spec.ResourceChurn.Pods.Enabled {
-		if r.shouldCreateResourceForNamespace(namespace, config.Spec.ResourceChurn.Pods.NamespaceInterval) {
-			resourceTypes = append(resourceTypes, "pods")
-			wg.Add(1)
-			go func() {
-				defer wg.Done()
-				count, err := r.managePods(ctx, config, namespace.Name, config.Spec.ResourceChurn.Pods.Count)
-				resultsChan <- resourceResult{"pods", count, err}
-			}()
-		}
-	}
+	log.V(2).Info("Starting parallel resource management", "resourceTypes", resourceTypes)
 
 	// Wait for all resource types to complete
 	wg.Wait()
@@ -0,0 +1,118 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+	"github.com/jtaleric/sim-operator/pkg/generator"
+)
+
+// runIDLabel marks every object created during a run with the run's ID
+const runIDLabel = "scale.openshift.io/run-id"
+
+type runIDKey struct{}
+
+// withRunID tags ctx with the run its requests belong to; an empty runID leaves ctx untagged
+func withRunID(ctx context.Context, runID string) context.Context {
+	if runID == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, runIDKey{}, runID)
+}
+
+// runIDFrom returns the run ctx was tagged with, or ""
+func runIDFrom(ctx context.Context) string {
+	runID, _ := ctx.Value(runIDKey{}).(string)
+	return runID
+}
+
+// ensureRunID assigns the config a run ID the first time load is generated for it and records it
+// in status right away, before any object that should carry it is created
+func (r *ScaleLoadConfigReconciler) ensureRunID(ctx context.Context, config *scalev1.ScaleLoadConfig) error {
+	if config.Status.RunID != "" {
+		return nil
+	}
+
+	latestConfig := &scalev1.ScaleLoadConfig{}
+	if err := r.Get(ctx, types.NamespacedName{Name: config.Name, Namespace: config.Namespace}, latestConfig); err != nil {
+		return fmt.Errorf("failed to fetch latest ScaleLoadConfig: %w", err)
+	}
+	if latestConfig.Status.RunID == "" {
+		original := latestConfig.DeepCopy()
+		now := metav1.Now()
+		latestConfig.Status.RunID = generator.UUID()
+		latestConfig.Status.RunStartTime = &now
+		if err := r.Status().Patch(ctx, latestConfig, client.MergeFrom(original)); err != nil {
+			return fmt.Errorf("failed to record run ID: %w", err)
+		}
+		r.Log.WithName("status-manager").Info("Started run", "config", config.Name, "runID", latestConfig.Status.RunID)
+	}
+	config.Status.RunID = latestConfig.Status.RunID
+	config.Status.RunStartTime = latestConfig.Status.RunStartTime
+	return nil
+}
+
+// runLabelingClient labels the config-owned objects it creates or updates with the run ID of the context
+type runLabelingClient struct {
+	client.Client
+}
+
+// withRunLabels wraps a client so objects written under a run carry its ID
+func withRunLabels(c client.Client) client.Client {
+	return &runLabelingClient{Client: c}
+}
+
+// label adds the run ID to an object some config owns; objects such as access reviews are left alone
+func (c *runLabelingClient) label(ctx context.Context, obj client.Object) {
+	runID := runIDFrom(ctx)
+	if _, configName := orphanOwner(obj); runID == "" || configName == "" {
+		return
+	}
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	labels[runIDLabel] = runID
+	obj.SetLabels(labels)
+}
+
+func (c *runLabelingClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	c.label(ctx, obj)
+	return c.Client.Create(ctx, obj, opts...)
+}
+
+func (c *runLabelingClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	c.label(ctx, obj)
+	return c.Client.Update(ctx, obj, opts...)
+}
+
+// WrapRunIDTransport appends " run/<id>" to the user agent of every request made with a context
+// tagged with a run, so audit logs can single out a run's requests among other cluster traffic
+func WrapRunIDTransport(config *rest.Config) {
+	config.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return &runIDTransport{next: rt}
+	})
+}
+
+// runIDTransport sits below client-go's user agent round tripper, so the user agent is already set
+type runIDTransport struct {
+	next http.RoundTripper
+}
+
+func (t *runIDTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	runID := runIDFrom(req.Context())
+	if runID == "" {
+		return t.next.RoundTrip(req)
+	}
+	req = req.Clone(req.Context())
+	req.Header.Set("User-Agent", strings.TrimSpace(req.Header.Get("User-Agent")+" run/"+runID))
+	return t.next.RoundTrip(req)
+}
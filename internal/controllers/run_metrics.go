@@ -0,0 +1,218 @@
+package controllers
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+const (
+	// RunMetricsPath serves the per-run summary on the metrics server, in the OpenMetrics format
+	// when the scraper asks for it so exemplars are included
+	RunMetricsPath = "/metrics/runs"
+	// traceParentAnnotation holds the W3C traceparent of the trace a run belongs to, set on the config
+	// by the tooling that starts the run when it traces it
+	traceParentAnnotation = "scale.openshift.io/traceparent"
+)
+
+// traceParentPattern matches a version 00 traceparent, capturing its trace and parent span IDs
+var traceParentPattern = regexp.MustCompile(`^00-([0-9a-f]{32})-([0-9a-f]{16})-[0-9a-f]{2}$`)
+
+var (
+	runLabels = []string{"config", "run_id"}
+
+	runStartDesc = prometheus.NewDesc("kwok_load_generator_run_start_timestamp_seconds",
+		"When the run started, in seconds since the epoch", runLabels, nil)
+	runAPICallsDesc = prometheus.NewDesc("kwok_load_generator_run_api_calls_total",
+		"API calls made during the run; the exemplar points to the run's trace when it has one", runLabels, nil)
+	runAPICallRateDesc = prometheus.NewDesc("kwok_load_generator_run_api_calls_per_minute",
+		"API call rate achieved at the run's latest status update", runLabels, nil)
+	runTargetAPICallRateDesc = prometheus.NewDesc("kwok_load_generator_run_target_api_calls_per_minute",
+		"Effective target API call rate at the run's latest status update", runLabels, nil)
+	runOperationRateDesc = prometheus.NewDesc("kwok_load_generator_run_operations_per_minute",
+		"Resources created, updated and deleted per minute at the run's latest status update, by operation",
+		append(runLabels, "operation"), nil)
+	runErrorRateDesc = prometheus.NewDesc("kwok_load_generator_run_error_percent",
+		"Share of failed operations at the run's latest status update", runLabels, nil)
+	runAchievedPercentDesc = prometheus.NewDesc("kwok_load_generator_run_achieved_percent",
+		"Share of the targeted namespaces and resources that existed at the run's latest status update", runLabels, nil)
+	runResourcesDesc = prometheus.NewDesc("kwok_load_generator_run_resources",
+		"Resources that existed at the run's latest status update, by resource type", append(runLabels, "resource_type"), nil)
+	runTargetResourcesDesc = prometheus.NewDesc("kwok_load_generator_run_target_resources",
+		"Target count of each resource type at the run's latest status update", append(runLabels, "resource_type"), nil)
+)
+
+// runSample is what the run summary reports for one config's current run
+type runSample struct {
+	runID     string
+	traceID   string
+	spanID    string
+	start     time.Time
+	updatedAt time.Time
+	apiCalls  int64
+	achieved  scalev1.ResourceCounts
+	targets   scalev1.LoadTargets
+	metrics   scalev1.LoadGenerationMetrics
+}
+
+// RunMetrics collects the per-run summary of every config; API calls are recorded from the parallel
+// namespace workers while the summary is scraped, so it is guarded by a mutex
+type RunMetrics struct {
+	mutex sync.Mutex
+	runs  map[string]*runSample
+}
+
+func NewRunMetrics() *RunMetrics {
+	return &RunMetrics{runs: make(map[string]*runSample)}
+}
+
+// Handler serves the summary from a registry of its own, so it does not repeat the process metrics.
+// It is added to the metrics server's extra handlers at RunMetricsPath when the manager is built
+func (m *RunMetrics) Handler() http.Handler {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(m)
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{EnableOpenMetrics: true})
+}
+
+// sample returns the config's sample for its current run, starting a new one when the run changed.
+// Callers hold the mutex
+func (m *RunMetrics) sample(config *scalev1.ScaleLoadConfig) *runSample {
+	sample := m.runs[config.Name]
+	if sample == nil || sample.runID != config.Status.RunID {
+		sample = &runSample{runID: config.Status.RunID}
+		m.runs[config.Name] = sample
+	}
+	return sample
+}
+
+// addAPICalls counts API calls made for the config's current run
+func (m *RunMetrics) addAPICalls(config *scalev1.ScaleLoadConfig, calls int32) {
+	if m == nil || config == nil || config.Status.RunID == "" {
+		return
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.sample(config).apiCalls += int64(calls)
+}
+
+// record takes the config's latest status into its run's summary
+func (m *RunMetrics) record(config *scalev1.ScaleLoadConfig, now time.Time) {
+	if m == nil || config.Status.RunID == "" {
+		return
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	sample := m.sample(config)
+	sample.traceID, sample.spanID = parseTraceParent(config.Annotations[traceParentAnnotation])
+	if config.Status.RunStartTime != nil {
+		sample.start = config.Status.RunStartTime.Time
+	}
+	sample.updatedAt = now
+	sample.achieved = config.Status.TotalResources
+	sample.targets = config.Status.Targets
+	sample.metrics = config.Status.Metrics
+}
+
+// forget drops a deleted config's summary
+func (m *RunMetrics) forget(configName string) {
+	if m == nil {
+		return
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	delete(m.runs, configName)
+}
+
+// parseTraceParent returns the trace and parent span IDs of a traceparent, or empty IDs when it is not valid
+func parseTraceParent(traceParent string) (string, string) {
+	match := traceParentPattern.FindStringSubmatch(strings.TrimSpace(traceParent))
+	if match == nil || strings.Trim(match[1], "0") == "" || strings.Trim(match[2], "0") == "" {
+		return "", ""
+	}
+	return match[1], match[2]
+}
+
+func (m *RunMetrics) Describe(ch chan<- *prometheus.Desc) {
+	for _, desc := range []*prometheus.Desc{runStartDesc, runAPICallsDesc, runAPICallRateDesc, runTargetAPICallRateDesc,
+		runOperationRateDesc, runErrorRateDesc, runAchievedPercentDesc, runResourcesDesc, runTargetResourcesDesc} {
+		ch <- desc
+	}
+}
+
+func (m *RunMetrics) Collect(ch chan<- prometheus.Metric) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	for configName, sample := range m.runs {
+		// Runs whose status was not updated yet have nothing to summarize
+		if sample.updatedAt.IsZero() {
+			continue
+		}
+		labels := []string{configName, sample.runID}
+
+		if !sample.start.IsZero() {
+			ch <- prometheus.MustNewConstMetric(runStartDesc, prometheus.GaugeValue, float64(sample.start.Unix()), labels...)
+		}
+		apiCalls := prometheus.MustNewConstMetric(runAPICallsDesc, prometheus.CounterValue, float64(sample.apiCalls), labels...)
+		if sample.traceID != "" {
+			apiCalls = prometheus.MustNewMetricWithExemplars(apiCalls, prometheus.Exemplar{
+				Value:     float64(sample.apiCalls),
+				Labels:    prometheus.Labels{"trace_id": sample.traceID, "span_id": sample.spanID},
+				Timestamp: sample.updatedAt,
+			})
+		}
+		ch <- apiCalls
+
+		gauge := func(desc *prometheus.Desc, value string, extra ...string) {
+			if v, err := parseFloat(value); err == nil {
+				ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, v, append(labels, extra...)...)
+			}
+		}
+		gauge(runAPICallRateDesc, sample.metrics.APICallsPerMinute)
+		ch <- prometheus.MustNewConstMetric(runTargetAPICallRateDesc, prometheus.GaugeValue,
+			float64(sample.targets.APICallsPerMinute), labels...)
+		gauge(runOperationRateDesc, sample.metrics.ResourceCreationRate, "create")
+		gauge(runOperationRateDesc, sample.metrics.ResourceUpdateRate, "update")
+		gauge(runOperationRateDesc, sample.metrics.ResourceDeletionRate, "delete")
+		gauge(runErrorRateDesc, sample.metrics.ErrorRate)
+		gauge(runAchievedPercentDesc, sample.targets.AchievedPercent)
+
+		achieved := resourceCountsByType(sample.achieved)
+		for resourceType, target := range resourceCountsByType(sample.targets.Resources) {
+			// Types the run neither targets nor has are left out, as in the summary ConfigMap
+			if target == 0 && achieved[resourceType] == 0 {
+				continue
+			}
+			ch <- prometheus.MustNewConstMetric(runResourcesDesc, prometheus.GaugeValue,
+				float64(achieved[resourceType]), append(labels, resourceType)...)
+			ch <- prometheus.MustNewConstMetric(runTargetResourcesDesc, prometheus.GaugeValue,
+				float64(target), append(labels, resourceType)...)
+		}
+	}
+}
+
+// resourceCountsByType keys the targeted resource types by the names their counts are kept under
+func resourceCountsByType(counts scalev1.ResourceCounts) map[string]int32 {
+	return map[string]int32{
+		"namespaces":       counts.Namespaces,
+		"configMaps":       counts.ConfigMaps,
+		"secrets":          counts.Secrets,
+		"routes":           counts.Routes,
+		"imageStreams":     counts.ImageStreams,
+		"buildConfigs":     counts.BuildConfigs,
+		"pods":             counts.Pods,
+		"appBundles":       counts.AppBundles,
+		"daemonSets":       counts.DaemonSets,
+		"completedPods":    counts.CompletedPods,
+		"mirrorPods":       counts.MirrorPods,
+		"networkPolicies":  counts.NetworkPolicies,
+		"etcdPressure":     counts.EtcdPressure,
+		"controllerLeases": counts.ControllerLeases,
+	}
+}
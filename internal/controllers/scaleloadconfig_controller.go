@@ -2,6 +2,7 @@ package controllers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"math/rand"
@@ -9,11 +10,13 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/prometheus/client_golang/prometheus"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -24,9 +27,13 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+	"github.com/jtaleric/sim-operator/pkg/generator"
 )
 
 // ScaleLoadConfigReconciler reconciles a ScaleLoadConfig object
@@ -35,24 +42,79 @@ type ScaleLoadConfigReconciler struct {
 	Scheme *runtime.Scheme
 	Log    logr.Logger
 
+	// SummaryNamespace receives the summary ConfigMaps; empty uses the namespace the operator runs in
+	SummaryNamespace string
+
+	// OperatorNamespace is the namespace the operator runs in, the only one notification secrets are
+	// read from; empty falls back to the namespace of the pod's service account
+	OperatorNamespace string
+
+	// ImportNamespaces are the namespaces besides the operator's that spec.imports may read from
+	ImportNamespaces []string
+
+	// KubeconfigNamespaces are the namespaces besides the operator's that target clusters may read
+	// kubeconfig secrets from
+	KubeconfigNamespaces []string
+
+	// APIReader reads from the apiserver, bypassing the cache, to measure warm-up baseline latencies
+	APIReader client.Reader
+
+	// SubsystemClients, keyed by subsystem, send each simulated source's writes under its own user
+	// agent and field manager; nil sends everything under the operator's identity
+	SubsystemClients map[string]client.Client
+
+	// RunMetrics collects the per-run summary served at RunMetricsPath; nil skips it
+	RunMetrics *RunMetrics
+
+	// ReconcileDurationBuckets and APICallDurationBuckets are the upper bounds of the reconcile and API
+	// call histogram buckets, in seconds; nil uses prometheus.DefBuckets
+	ReconcileDurationBuckets []float64
+	APICallDurationBuckets   []float64
+
+	// StallIntervals is how many reconcile intervals may pass without a completed reconcile before a
+	// config is reported as stalled; 0 disables the watchdog. ExitOnStall also stops the manager then,
+	// so the pod is restarted
+	StallIntervals int
+	ExitOnStall    bool
+
 	// Metrics for observability
-	KwokNodeCount       prometheus.Gauge
-	GeneratedNamespaces prometheus.Gauge
+	KwokNodeCount       *prometheus.GaugeVec
+	GeneratedNamespaces *prometheus.GaugeVec
 	APICallRate         prometheus.Histogram
 	ReconcileTime       prometheus.Histogram
 	ErrorCount          prometheus.Counter
+	ChurnerPasses       *prometheus.CounterVec
+	ChurnerDuration     *prometheus.HistogramVec
+	BytesWritten        *prometheus.CounterVec
+	NodeUpdateConflicts *prometheus.CounterVec
+	ManagedFieldsBytes  *prometheus.HistogramVec
+
+	NodeAnnotationKeysChanged  *prometheus.CounterVec
+	NodeAnnotationBytesChanged *prometheus.CounterVec
+
+	EventsRequested     prometheus.Counter
+	EventsDropped       *prometheus.CounterVec
+	CreateAlreadyExists *prometheus.CounterVec
+	NamespacesSkipped   *prometheus.CounterVec
+	SelfUsage           *prometheus.GaugeVec
+	SelfGrowthSuspected *prometheus.GaugeVec
+	ReconcileStalled    *prometheus.GaugeVec
 
 	// Internal state for load generation
 	lastReconcileTime time.Time
 	resourceManagers  map[string]*ResourceManager
+	resourceTiming    resourceTiming
 
 	// Simplified API rate control
 	targetAPICallsPerMinute int32
-	apiCallsThisMinute      int32
-	lastRateReset           time.Time
+	apiCalls                apiCallTracker
+
+	// When the running reconcile started, in Unix nanoseconds, or 0 between reconciles; read by the liveness probe
+	reconcileStarted atomic.Int64
 
-	// Cumulative API call tracking for metrics
-	totalAPICallsMade int64
+	// When each config's latest reconcile completed and its next one was due, read by the stall watchdog
+	reconcileExpectations map[string]*reconcileExpectation
+	stallMutex            sync.Mutex
 
 	// Logging optimization
 	reconcileCounter int
@@ -61,12 +123,107 @@ type ScaleLoadConfigReconciler struct {
 	// Cached managed namespaces for current reconcile (avoids repeated getManagedNamespaces in checkMaximumLimit)
 	currentManagedNamespaces []corev1.Namespace
 
+	// Synthetic zone of each active namespace for the current reconcile, used for pod zone affinity
+	currentNamespaceZones map[string]string
+	zonesMutex            sync.RWMutex
+
+	// Background churn workers for configs using the Background churn engine
+	churnEngine *churnEngine
+	churnMutex  sync.Mutex
+
+	// Managed namespaces deleted outside the operator since the last reconcile, mapped to their config
+	deletedNamespaces      map[string]string
+	deletedNamespacesMutex sync.Mutex
+
+	// What the latest cycle left undone when it ran out of budget, per config that yielded
+	convergence map[string]*scalev1.ConvergenceStatus
+
+	// Zone currently taken down by an injected outage, per config
+	activeZoneOutages map[string]string
+
 	// Enhanced deletion manager for complex resources
 	deletionManager *DeletionManager
+
+	// When each config last posted simulated alerts to Alertmanager
+	lastAlertPost map[string]time.Time
+
+	// Cached SelfSubjectAccessReview results per ScaleLoadConfig
+	permissionResults map[string]*permissionResult
+
+	// Reconcilers bound to target clusters, keyed by config and cluster name
+	remoteClusters      map[string]*remoteCluster
+	remoteClustersMutex sync.Mutex
+
+	// Latest per-cluster status for each config with target clusters
+	clusterStatuses map[string][]scalev1.ClusterStatus
+
+	// Latest pods-per-node density and pod scheduling counts, per config with podsPerNode set
+	podDensity map[string]*scalev1.PodDensityStatus
+
+	// When each config first wanted to scale namespaces down, used to debounce node flaps
+	scaleDownPendingSince map[string]time.Time
+
+	// When each KWOK node was first seen missing, keyed by config and node name
+	missingNodesSince map[string]time.Time
+
+	// When each config last generated node events, to hold the per-node event rate
+	lastNodeEventTime map[string]time.Time
+
+	// When each config's status was last written, to resync unchanged status
+	lastStatusWrite map[string]time.Time
+
+	// When each config's summary ConfigMap was last written
+	lastSummaryWrite map[string]time.Time
+
+	// Latest gate evaluation, per config
+	gateStates map[string]*gateState
+
+	// Configs whose warm-up ran since the operator started
+	warmedUp map[string]bool
+
+	// Recent samples of the operator's own resource use, for leak detection in soak tests
+	selfMonitor *selfMonitor
+
+	// Spaces writes at the effective API rate; nil leaves writes unpaced
+	pacer *requestPacer
+
+	// Adds up the serialized size of writes, shared with target cluster reconcilers; nil skips it
+	writeMeter *writeMeter
+
+	// Adds stress labels to created objects, shared with target cluster reconcilers; nil skips it
+	labelStamper *labelStamper
+
+	// Refuses writes to protected and excluded namespaces, shared with target cluster reconcilers; nil skips it
+	namespaceGuard *namespaceGuard
+
+	// Health signals feeding the Degraded condition, per config
+	health      map[string]*configHealth
+	healthMutex sync.Mutex
+
+	// Cumulative etcd pressure writes, per config
+	etcdPressure      map[string]*etcdPressureTotals
+	etcdPressureMutex sync.Mutex
+
+	// Created and dropped events over the current window, per config
+	eventRates     map[string]*eventRateTracker
+	eventRateMutex sync.Mutex
+
+	// Background renewal of simulated controller Leases, per config
+	leaseSimulators map[string]*leaseSimulator
+	leaseMutex      sync.Mutex
+
+	// Background pruning of expired generated objects, per config
+	janitors     map[string]*janitor
+	janitorMutex sync.Mutex
+
+	// Payload providers of ConfigMaps and Secrets, keyed by config and resource type
+	dataProviders     map[string]dataProviderEntry
+	dataProviderMutex sync.Mutex
 }
 
 // ResourceManager handles lifecycle of resources for a specific namespace
 type ResourceManager struct {
+	configName       string
 	namespace        string
 	associatedNode   string
 	lastUpdate       time.Time
@@ -77,26 +234,47 @@ type ResourceManager struct {
 //+kubebuilder:rbac:groups=scale.openshift.io,resources=scaleloadconfigs,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=scale.openshift.io,resources=scaleloadconfigs/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=scale.openshift.io,resources=scaleloadconfigs/finalizers,verbs=update
-//+kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups=scale.openshift.io,resources=loadprofilepresets,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups="",resources=nodes/status,verbs=patch
 //+kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups="",resources=pods/status,verbs=patch
 //+kubebuilder:rbac:groups=route.openshift.io,resources=routes,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=image.openshift.io,resources=imagestreams,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=image.openshift.io,resources=imagestreams/status,verbs=patch
 //+kubebuilder:rbac:groups=build.openshift.io,resources=buildconfigs,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;create;update;patch;delete
-
-// Reconcile implements the main reconciliation loop
+//+kubebuilder:rbac:groups="",resources=serviceaccounts,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=apps,resources=deployments;daemonsets,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=apps,resources=replicasets;controllerrevisions,verbs=get;list;delete
+//+kubebuilder:rbac:groups=networking.k8s.io,resources=ingresses;networkpolicies,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=monitoring.coreos.com,resources=prometheusrules,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=cluster.open-cluster-management.io,resources=managedclusters;placements,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=work.open-cluster-management.io,resources=manifestworks,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=argoproj.io,resources=applications,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=hypershift.openshift.io,resources=hostedclusters,verbs=get;list;watch
+//+kubebuilder:rbac:groups=authorization.k8s.io,resources=selfsubjectaccessreviews,verbs=create
+
+// Reconcile implements the main reconciliation loop, recording each completed pass for the stall watchdog
 func (r *ScaleLoadConfigReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	result, err := r.reconcile(ctx, req)
+	r.recordReconcileCompleted(req.Name, result, err, time.Now())
+	return result, err
+}
+
+// reconcile runs one pass of the main reconciliation loop
+func (r *ScaleLoadConfigReconciler) reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := r.Log.WithValues("scaleloadconfig", req.NamespacedName)
 	startTime := time.Now()
+	defer r.markReconcileStarted(startTime)()
 
 	// Add timeout to prevent infinite reconcile loops
-	timeout := 5 * time.Minute
-	ctx, cancel := context.WithTimeout(ctx, timeout)
+	ctx, cancel := context.WithTimeout(ctx, reconcileTimeout)
 	defer cancel()
 
 	defer func() {
@@ -116,11 +294,23 @@ func (r *ScaleLoadConfigReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		log.Error(err, "Unable to fetch ScaleLoadConfig")
 		return ctrl.Result{}, err
 	}
+	// Requests made for the config, cleanup included, carry its run ID
+	ctx = withRunID(ctx, config.Status.RunID)
+	// Long create and delete loops yield once the cycle's budget is spent
+	ctx = withCycleBudget(ctx, config, startTime)
+	ctx = withOperationBudget(ctx, config)
+
+	// Excluded namespaces apply to every write below, including cleanup of a deleted config
+	if r.namespaceGuard != nil {
+		r.namespaceGuard.configure(config)
+	}
 
 	// Initialize resource managers if needed
 	if r.resourceManagers == nil {
 		r.resourceManagers = make(map[string]*ResourceManager)
 	}
+	r.forgetDeletedNamespaces()
+	r.monitorSelf(ctx)
 
 	// Add finalizer for cleanup
 	if !controllerutil.ContainsFinalizer(config, "scale.openshift.io/cleanup") {
@@ -140,11 +330,112 @@ func (r *ScaleLoadConfigReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	// Skip reconciliation if disabled
 	if !config.Spec.Enabled {
 		log.Info("Scale load generation is disabled")
+		r.stopChurnWorkers(config.Name)
+		r.stopControllerLeases(config.Name)
+		r.stopJanitor(config.Name)
+		delete(r.convergence, config.Name)
+		if config.Spec.CleanupConfig.OnDisable == scalev1.OnDisableCleanup {
+			r.paceCleanup(config)
+			err := r.removeGeneratedLoad(ctx, config)
+			if err == nil || isCleanupInProgress(err) {
+				if progressErr := r.recordCleanupProgress(ctx, config, err == nil); progressErr != nil {
+					log.Error(progressErr, "Failed to record cleanup progress")
+				}
+			}
+			if isCleanupInProgress(err) {
+				log.Info("Cleanup continues on the next pass")
+				return ctrl.Result{RequeueAfter: yieldRequeueDelay}, nil
+			}
+			if err != nil {
+				r.ErrorCount.Inc()
+				log.Error(err, "Failed to remove generated load after disabling")
+				return ctrl.Result{RequeueAfter: 30 * time.Second}, err
+			}
+			delete(r.clusterStatuses, config.Name)
+			if config.Spec.CleanupConfig.VerifyCleanup && !cleanupVerified(config) {
+				done, err := r.verifyCleanup(ctx, config)
+				if err != nil {
+					log.Error(err, "Failed to verify cleanup after disabling")
+				} else if !done {
+					result, err := r.updateStatus(ctx, config, 0, 0, make(map[string]int))
+					if err == nil {
+						result.RequeueAfter = 10 * time.Second
+					}
+					return result, err
+				}
+			}
+		}
 		return r.updateStatus(ctx, config, 0, 0, make(map[string]int))
 	}
 
+	if err := r.ensureRunID(ctx, config); err != nil {
+		r.ErrorCount.Inc()
+		log.Error(err, "Failed to assign run ID")
+		return ctrl.Result{}, err
+	}
+	ctx = withRunID(ctx, config.Status.RunID)
+
+	// Resolve the scenario, referenced preset, imports and load profile before anything reads the load or churn settings
+	r.applyScenario(config, time.Now())
+	if err := r.applyLoadProfilePreset(ctx, config); err != nil {
+		r.ErrorCount.Inc()
+		log.Error(err, "Failed to apply LoadProfilePreset")
+		if ackErr := r.acknowledgeSpec(ctx, config, err); ackErr != nil {
+			log.Error(ackErr, "Failed to record rejected spec")
+		}
+		return ctrl.Result{}, err
+	}
+	if err := r.applyImports(ctx, config); err != nil {
+		r.ErrorCount.Inc()
+		log.Error(err, "Failed to apply imports")
+		if ackErr := r.acknowledgeSpec(ctx, config, err); ackErr != nil {
+			log.Error(ackErr, "Failed to record rejected spec")
+		}
+		return ctrl.Result{}, err
+	}
+	r.applyLoadProfile(config)
+
+	// Repeat the webhook's checks, so a config admitted without the webhook generates no load
+	if err := r.recheckAdmission(ctx, config); err != nil {
+		log.Info("Holding load generation, config fails admission checks", "reason", err.Error())
+		r.stopChurnWorkers(config.Name)
+		r.stopControllerLeases(config.Name)
+		r.stopJanitor(config.Name)
+		if ackErr := r.acknowledgeSpec(ctx, config, err); ackErr != nil {
+			log.Error(ackErr, "Failed to record rejected spec")
+		}
+		return ctrl.Result{RequeueAfter: time.Minute}, nil
+	}
+
+	// Target clusters run their own permission checks, so keep the spec as written for them
+	targetConfig := config.DeepCopy()
+
+	// Remove what features switched off by a spec change left behind, before permission
+	// checks turn off features only for this cycle
+	if err := r.convergeSpecChange(ctx, config); err != nil {
+		r.ErrorCount.Inc()
+		log.Error(err, "Failed to converge spec change")
+		return ctrl.Result{}, err
+	}
+
+	// Record a new generation before the resource pass, which can take minutes at scale. The teardown
+	// above detects spec changes by the observed generation, so it has to run first
+	if err := r.acknowledgeSpec(ctx, config, nil); err != nil {
+		log.Error(err, "Failed to acknowledge spec, continuing")
+	}
+
+	// Turn off features the operator is not permitted to run instead of failing on every cycle
+	r.applyPermissionChecks(ctx, config)
+
+	// Grow or shrink the operator-owned KWOK node pool before sizing load from it (nodes are cluster-scoped)
+	if config.Spec.NodeManagement.Enabled && !isNamespaceScoped(config) {
+		if _, err := r.manageKwokNodes(ctx, config); err != nil {
+			log.Error(err, "Failed to manage KWOK nodes, continuing")
+		}
+	}
+
 	// Get KWOK nodes
-	kwokNodes, err := r.getKwokNodes(ctx, config.Spec.KwokNodeSelector)
+	kwokNodes, err := r.getKwokNodes(ctx, config)
 	if err != nil {
 		r.ErrorCount.Inc()
 		log.Error(err, "Failed to get KWOK nodes")
@@ -153,18 +444,64 @@ func (r *ScaleLoadConfigReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	r.recordAPICall(config, 1) // List nodes operation
 
 	log.V(1).Info("Found KWOK nodes", "count", len(kwokNodes))
-	r.KwokNodeCount.Set(float64(len(kwokNodes)))
+	r.KwokNodeCount.WithLabelValues(config.Name).Set(float64(len(kwokNodes)))
+
+	// Spread KWOK nodes across synthetic zones (nodes are cluster-scoped, so not in Namespaced mode)
+	if config.Spec.Topology.Enabled && !isNamespaceScoped(config) {
+		if err := r.applyNodeTopology(ctx, config, kwokNodes); err != nil {
+			log.Error(err, "Failed to apply node topology labels, continuing")
+		}
+	}
+
+	// Start or end injected zone outages; runs even when disabled so tainted nodes always recover
+	if !isNamespaceScoped(config) {
+		if zone, err := r.manageZoneOutage(ctx, config, kwokNodes); err != nil {
+			log.Error(err, "Failed to manage zone outage, continuing")
+		} else {
+			r.setActiveZoneOutage(config.Name, zone)
+		}
+	}
+
+	// Flip scheduling labels in waves; runs even when disabled so flipped labels are restored
+	if !isNamespaceScoped(config) {
+		if err := r.manageSchedulingLabelChurn(ctx, config, kwokNodes); err != nil {
+			log.Error(err, "Failed to churn scheduling labels, continuing")
+		}
+	}
 
 	// Early status update with current node count to prevent stale status
 	if err := r.updateNodeCountStatus(ctx, config, len(kwokNodes)); err != nil {
 		log.Error(err, "Failed to update node count status early, continuing")
 	}
 
+	// Hold load generation while a gate does not hold; the load generated so far stays in place
+	if paused, reason := r.checkGates(ctx, config); paused {
+		log.Info("Load generation paused by gates", "reason", reason)
+		r.stopChurnWorkers(config.Name)
+		r.stopControllerLeases(config.Name)
+		r.stopJanitor(config.Name)
+		if err := r.setGatedCondition(ctx, config); err != nil {
+			log.Error(err, "Failed to record gate pause in status")
+		}
+		return ctrl.Result{RequeueAfter: gateInterval(config)}, nil
+	}
+
+	// Prime caches and take baseline latencies before the first churn since the operator started
+	if err := r.warmUp(ctx, config); err != nil {
+		log.Error(err, "Failed to warm up, continuing")
+	}
+
 	// Calculate target namespace count based on load profile
 	targetNamespaces := r.calculateTargetNamespaces(config, len(kwokNodes))
 
 	// Log effective API rate
 	effectiveRate, rateType := r.getEffectiveAPIRate(config, len(kwokNodes))
+	if r.pacer != nil {
+		r.pacer.configure(config, effectiveRate)
+	}
+	if r.labelStamper != nil {
+		r.labelStamper.configure(config)
+	}
 	log.Info("API rate configuration",
 		"effectiveRate", effectiveRate,
 		"rateType", rateType,
@@ -173,6 +510,11 @@ func (r *ScaleLoadConfigReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 
 	log.V(1).Info("Target namespace calculation", "kwokNodes", len(kwokNodes), "targetNamespaces", targetNamespaces)
 
+	// Size pods from the pods-per-node density before anything reads the pod count
+	if err := r.applyPodDensity(ctx, config, kwokNodes, targetNamespaces); err != nil {
+		log.Error(err, "Failed to measure pod density, continuing")
+	}
+
 	// Note: Removed restrictive pre-flight API rate limiting to allow actual configured rates
 	// Resource managers will handle rate limiting individually with more accurate tracking
 
@@ -189,7 +531,9 @@ func (r *ScaleLoadConfigReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	}
 
 	// Check if we should throttle operations to avoid exceeding target API rate
-	if r.shouldThrottleOperations(config, len(kwokNodes)) {
+	throttled := r.shouldThrottleOperations(config, len(kwokNodes))
+	r.setThrottled(config.Name, throttled)
+	if throttled {
 		log.Info("Throttling this reconcile cycle to control API rate")
 		// Return early with current status to avoid excessive API calls
 		_, err := r.updateStatus(ctx, config, len(kwokNodes), 0, make(map[string]int))
@@ -200,6 +544,15 @@ func (r *ScaleLoadConfigReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	}
 
 	namespaceCount, resourceCounts, err := r.manageLoadResources(ctx, config, kwokNodes, targetNamespaces)
+	if isCycleBudgetSpent(err) {
+		// Record the progress so far and continue right away, after any spec change or deletion queued meanwhile
+		log.Info("Reconcile cycle yielded", "reason", err.Error(), "duration", time.Since(startTime).String())
+		if _, err := r.updateStatus(ctx, config, len(kwokNodes), namespaceCount, resourceCounts); err != nil {
+			r.ErrorCount.Inc()
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: yieldRequeueDelay}, nil
+	}
 	if err != nil {
 		r.ErrorCount.Inc()
 		log.Error(err, "Failed to manage load resources")
@@ -225,13 +578,93 @@ func (r *ScaleLoadConfigReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 			"effectiveAPIRate", fmt.Sprintf("%.0f calls/min", float64(effectiveRate)))
 	}
 
-	// Update node annotations for networking churn
-	if config.Spec.AnnotationChurn.Enabled {
+	// Update node annotations for networking churn (nodes are cluster-scoped, so not in Namespaced mode)
+	if config.Spec.AnnotationChurn.Enabled && !isNamespaceScoped(config) {
 		if err := r.updateNodeAnnotations(ctx, config, kwokNodes); err != nil {
 			log.Error(err, "Failed to update node annotations, continuing")
 		}
 	}
 
+	// Generate events attributed to each KWOK node
+	if config.Spec.ResourceChurn.Events.Enabled {
+		events, err := r.manageNodeEvents(ctx, config, kwokNodes)
+		if err != nil {
+			log.Error(err, "Failed to generate node events, continuing")
+		}
+		resourceCounts["events"] = events
+	}
+
+	// Write node events to the shared system namespace (it is outside the selected namespaces in Namespaced mode)
+	if config.Spec.ResourceChurn.Events.Enabled && config.Spec.ResourceChurn.Events.SystemEvents.Enabled && !isNamespaceScoped(config) {
+		systemEvents, err := r.manageSystemEvents(ctx, config, kwokNodes, resourceCounts["events"])
+		if err != nil {
+			log.Error(err, "Failed to generate system events, continuing")
+		}
+		resourceCounts["events"] += systemEvents
+	}
+
+	// Churn namespace annotations (the operator does not own namespaces in Namespaced mode)
+	if config.Spec.NamespaceAnnotationChurn.Enabled && !isNamespaceScoped(config) {
+		if _, err := r.updateNamespaceAnnotations(ctx, config); err != nil {
+			log.Error(err, "Failed to update namespace annotations, continuing")
+		}
+	}
+
+	// Generate simulated firing alerts if enabled
+	if config.Spec.AlertSimulation.Enabled {
+		alertCount, err := r.manageAlertSimulation(ctx, config)
+		if err != nil {
+			log.Error(err, "Failed to manage alert simulation, continuing")
+		}
+		resourceCounts["alerts"] = alertCount
+	}
+
+	// Generate Argo CD Applications if enabled
+	if config.Spec.ArgoCDSimulation.Enabled {
+		appCount, err := r.manageArgoCDApplications(ctx, config)
+		if err != nil {
+			log.Error(err, "Failed to manage Argo CD Applications, continuing")
+		}
+		resourceCounts["applications"] = appCount
+	}
+
+	// Churn ACM hub objects if enabled (ManagedClusters are cluster-scoped, so not in Namespaced mode)
+	if config.Spec.ACMSimulation.Enabled && !isNamespaceScoped(config) {
+		acmCounts, err := r.manageACMSimulation(ctx, config)
+		if err != nil {
+			log.Error(err, "Failed to manage ACM simulation, continuing")
+		}
+		for resourceType, count := range acmCounts {
+			resourceCounts[resourceType] = count
+		}
+	}
+
+	// Mirror the static pods of every KWOK node (Pods are bound to cluster-scoped Nodes, so not in Namespaced mode)
+	if config.Spec.MirrorPods.Enabled && !isNamespaceScoped(config) {
+		mirrorPodCount, err := r.manageMirrorPods(ctx, config, kwokNodes)
+		if err != nil {
+			log.Error(err, "Failed to manage mirror pods, continuing")
+		}
+		resourceCounts["mirrorPods"] = mirrorPodCount
+	}
+
+	// Renew simulated controller Leases in the background
+	resourceCounts["controllerLeases"] = r.syncControllerLeases(config)
+
+	// Prune expired events, terminated pods and old revisions in the background
+	r.syncJanitor(config)
+
+	// Drive equivalent load into target clusters
+	if r.clusterStatuses == nil {
+		r.clusterStatuses = make(map[string][]scalev1.ClusterStatus)
+	}
+	if len(targetConfig.Spec.TargetClusters) > 0 {
+		r.clusterStatuses[config.Name] = r.manageTargetClusters(ctx, targetConfig)
+	} else {
+		delete(r.clusterStatuses, config.Name)
+		r.pruneRemoteClusters(targetConfig)
+	}
+
 	// Update status
 	_, err = r.updateStatus(ctx, config, len(kwokNodes), namespaceCount, resourceCounts)
 	if err != nil {
@@ -246,8 +679,8 @@ func (r *ScaleLoadConfigReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		}
 	}
 
-	// Perform namespace churn if enabled
-	if config.Spec.ResourceChurn.Namespaces.Enabled {
+	// Perform namespace churn if enabled (the operator does not own namespaces in Namespaced mode)
+	if config.Spec.ResourceChurn.Namespaces.Enabled && !isNamespaceScoped(config) {
 		if err := r.performNamespaceChurn(ctx, config); err != nil {
 			log.Error(err, "Failed to perform namespace churn")
 		}
@@ -263,15 +696,22 @@ func (r *ScaleLoadConfigReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	return ctrl.Result{RequeueAfter: nextReconcile}, nil
 }
 
-// getKwokNodes retrieves nodes matching the KWOK selector with pagination support
-func (r *ScaleLoadConfigReconciler) getKwokNodes(ctx context.Context, selector map[string]string) ([]corev1.Node, error) {
-	log := r.Log.WithName("node-lister")
-
-	if len(selector) == 0 {
-		selector = map[string]string{"type": "kwok"}
+// kwokNodeSelector returns the label selector for the nodes a config generates load on
+func kwokNodeSelector(config *scalev1.ScaleLoadConfig) labels.Selector {
+	if len(config.Spec.KwokNodeSelector) == 0 {
+		return labels.SelectorFromSet(map[string]string{"type": "kwok"})
 	}
+	return labels.SelectorFromSet(config.Spec.KwokNodeSelector)
+}
+
+// getKwokNodes retrieves nodes matching the KWOK selector with pagination support. Matched nodes
+// that are not KWOK nodes are left out unless the config allows real nodes, since every node
+// mutation works from this list
+func (r *ScaleLoadConfigReconciler) getKwokNodes(ctx context.Context, config *scalev1.ScaleLoadConfig) ([]corev1.Node, error) {
+	log := r.Log.WithName("node-lister")
 
-	labelSelector := labels.SelectorFromSet(selector)
+	labelSelector := kwokNodeSelector(config)
+	selector := labelSelector.String()
 	var allNodes []corev1.Node
 
 	// Use pagination to handle large node lists
@@ -329,18 +769,69 @@ func (r *ScaleLoadConfigReconciler) getKwokNodes(ctx context.Context, selector m
 		}
 	}
 
+	verification := scalev1.NodeVerificationStatus{MatchedNodes: int32(len(allNodes))}
+	var rejected []string
+	accepted := allNodes[:0]
+	for _, node := range allNodes {
+		if isKwokNode(node) {
+			verification.KwokNodes++
+		} else if !config.Spec.AllowRealNodes {
+			rejected = append(rejected, node.Name)
+			continue
+		}
+		accepted = append(accepted, node)
+	}
+	allNodes = accepted
+	if len(rejected) > 0 {
+		sort.Strings(rejected)
+		verification.RejectedNodes = int32(len(rejected))
+		verification.RejectedExamples = rejected
+		if len(rejected) > 5 {
+			verification.RejectedExamples = rejected[:5]
+		}
+		log.Info("Skipping nodes matched by the KWOK selector that carry no KWOK markers",
+			"selector", selector, "count", len(rejected))
+	}
+	r.recordNodeVerification(config.Name, verification)
+
 	log.Info("Successfully listed all KWOK nodes", "totalCount", len(allNodes))
 
 	return allNodes, nil
 }
 
+// isKwokNode reports whether a node is simulated by KWOK, from any of the markers KWOK nodes carry:
+// a kwok:// provider ID, the kwok.x-k8s.io/node annotation or the kwok.x-k8s.io/node taint
+func isKwokNode(node corev1.Node) bool {
+	if strings.HasPrefix(node.Spec.ProviderID, "kwok://") || node.Annotations["kwok.x-k8s.io/node"] == "fake" {
+		return true
+	}
+	for _, taint := range node.Spec.Taints {
+		if taint.Key == "kwok.x-k8s.io/node" {
+			return true
+		}
+	}
+	return false
+}
+
 // Helper function to create int64 pointer
 func int64ptr(i int64) *int64 {
 	return &i
 }
 
-// calculateTargetNamespaces computes how many namespaces should exist based on node count and density
+// calculateTargetNamespaces computes how many namespaces should exist based on node count and density.
+// Pools have fixed counts instead, as long as there are KWOK nodes to load
 func (r *ScaleLoadConfigReconciler) calculateTargetNamespaces(config *scalev1.ScaleLoadConfig, nodeCount int) int {
+	if len(config.Spec.Pools) > 0 {
+		if nodeCount == 0 {
+			return 0
+		}
+		total := 0
+		for _, pool := range config.Spec.Pools {
+			total += int(pool.Namespaces)
+		}
+		return total
+	}
+
 	// Default to 0.6 based on must-gather analysis (72 namespaces on 126 nodes)
 	namespacesPerNodeStr := "0.6"
 	if config.Spec.LoadProfile.NamespacesPerNode != nil {
@@ -362,6 +853,8 @@ func (r *ScaleLoadConfigReconciler) manageLoadResources(ctx context.Context, con
 	log := r.Log.WithName("resource-manager")
 	resourceCounts := make(map[string]int)
 	var namespacesCreated, namespacesDeleted int
+	// Set when the cycle budget runs out; the cycle then stops and reports what is left
+	var yieldErr error
 
 	// Get existing managed namespaces, separated by status
 	activeNamespaces, terminatingNamespaces, err := r.getManagedNamespacesWithStatus(ctx, config)
@@ -369,13 +862,18 @@ func (r *ScaleLoadConfigReconciler) manageLoadResources(ctx context.Context, con
 		return 0, resourceCounts, fmt.Errorf("failed to get managed namespaces: %w", err)
 	}
 	r.recordAPICall(config, 1) // List namespaces operation
+	r.recordStuckNamespaces(config.Name, terminatingNamespaces)
 
 	// Cache for checkMaximumLimit to avoid repeated getManagedNamespaces per resource type per namespace
 	allManaged := make([]corev1.Namespace, 0, len(activeNamespaces)+len(terminatingNamespaces))
 	allManaged = append(allManaged, activeNamespaces...)
 	allManaged = append(allManaged, terminatingNamespaces...)
-	r.currentManagedNamespaces = allManaged
-	defer func() { r.currentManagedNamespaces = nil }()
+	r.pruneResourceManagers(config.Name, allManaged)
+	// Background churn workers run outside the reconcile and must not see this per-reconcile cache
+	if !backgroundChurn(config) {
+		r.currentManagedNamespaces = allManaged
+		defer func() { r.currentManagedNamespaces = nil }()
+	}
 
 	currentActiveCount := len(activeNamespaces)
 	terminatingCount := len(terminatingNamespaces)
@@ -390,7 +888,7 @@ func (r *ScaleLoadConfigReconciler) manageLoadResources(ctx context.Context, con
 
 	// Check maximum limit for namespaces if namespace churn is enabled
 	effectiveTarget := targetNamespaces
-	if config.Spec.ResourceChurn.Namespaces.Enabled && config.Spec.ResourceChurn.Namespaces.Maximum > 0 {
+	if len(config.Spec.Pools) == 0 && config.Spec.ResourceChurn.Namespaces.Enabled && config.Spec.ResourceChurn.Namespaces.Maximum > 0 {
 		if currentNamespaceCount >= int(config.Spec.ResourceChurn.Namespaces.Maximum) {
 			effectiveTarget = currentNamespaceCount // Don't create more, maintain current count
 			log.Info("Namespace creation limited by maximum",
@@ -413,15 +911,34 @@ func (r *ScaleLoadConfigReconciler) manageLoadResources(ctx context.Context, con
 		"effectiveTarget", effectiveTarget,
 		"kwokNodes", len(kwokNodes))
 
+	// In Namespaced mode the selected namespaces are used as-is and never created or deleted
+	if isNamespaceScoped(config) {
+		effectiveTarget = currentNamespaceCount
+		log.V(1).Info("Namespace-scoped mode, using existing namespaces", "namespaces", currentActiveCount)
+	}
+
+	// Namespaces are scaled per pool; without pools they are all one group
+	groups := namespaceGroups(config, effectiveTarget, activeNamespaces, terminatingNamespaces)
+
 	// Scale up namespaces if needed
-	if currentNamespaceCount < effectiveTarget {
-		namespacesToCreate := effectiveTarget - currentNamespaceCount
-		log.V(1).Info("Scaling up namespaces", "current", currentNamespaceCount, "target", effectiveTarget, "toCreate", namespacesToCreate)
+	if missing, _ := namespaceShortfall(groups); missing > 0 {
+		log.V(1).Info("Scaling up namespaces", "current", currentNamespaceCount, "target", effectiveTarget, "toCreate", missing)
 
-		if err := r.createNamespaces(ctx, config, kwokNodes, namespacesToCreate); err != nil {
-			return currentNamespaceCount, resourceCounts, fmt.Errorf("failed to create namespaces: %w", err)
+		for _, group := range groups {
+			namespacesToCreate := group.target - len(group.active) - len(group.terminating)
+			if namespacesToCreate <= 0 {
+				continue
+			}
+			created, err := r.createNamespaces(ctx, config, group.pool, kwokNodes, namespacesToCreate)
+			namespacesCreated += created
+			if err != nil {
+				if !isCycleBudgetSpent(err) {
+					return currentNamespaceCount, resourceCounts, fmt.Errorf("failed to create namespaces: %w", err)
+				}
+				yieldErr = err
+				break
+			}
 		}
-		namespacesCreated = namespacesToCreate
 
 		// Re-fetch to get updated count (including new namespaces)
 		activeNamespaces, terminatingNamespaces, err = r.getManagedNamespacesWithStatus(ctx, config)
@@ -431,27 +948,53 @@ func (r *ScaleLoadConfigReconciler) manageLoadResources(ctx context.Context, con
 		currentActiveCount = len(activeNamespaces)
 		terminatingCount = len(terminatingNamespaces)
 		currentNamespaceCount = currentActiveCount + terminatingCount
+		groups = namespaceGroups(config, effectiveTarget, activeNamespaces, terminatingNamespaces)
 		log.V(1).Info("Namespaces created successfully", "created", namespacesCreated, "newActive", currentActiveCount, "stillTerminating", terminatingCount, "newTotal", currentNamespaceCount)
 	}
 
 	// Scale down namespaces if needed
 	// Only consider excess ACTIVE namespaces for deletion (don't retry terminating ones)
-	if currentActiveCount > effectiveTarget {
-		namespacesToDelete := currentActiveCount - effectiveTarget
+	_, surplus := namespaceShortfall(groups)
+	scaleDownWanted := surplus > 0
+	// Called on every pass, so a flap that recovers clears its pending timestamp
+	scaleDownDeferred := r.deferScaleDown(config, scaleDownWanted)
+	scaleDownDue := scaleDownWanted && !scaleDownDeferred
+	if scaleDownWanted && !scaleDownDue {
+		log.V(1).Info("Deferring namespace scale down until cleanup delay elapses",
+			"currentActive", currentActiveCount,
+			"target", effectiveTarget,
+			"cleanupDelaySeconds", config.Spec.CleanupConfig.CleanupDelaySeconds)
+	}
+	if scaleDownDue {
 		log.V(1).Info("Scaling down namespaces",
 			"currentActive", currentActiveCount,
 			"terminating", terminatingCount,
 			"total", currentNamespaceCount,
 			"target", effectiveTarget,
-			"toDelete", namespacesToDelete)
+			"toDelete", surplus)
 
-		if err := r.deleteNamespaces(ctx, config, activeNamespaces, namespacesToDelete); err != nil {
-			return currentNamespaceCount, resourceCounts, fmt.Errorf("failed to delete namespaces: %w", err)
+		// Namespaces deleted here are Terminating from now on and must not be churned below
+		for i := range groups {
+			group := &groups[i]
+			namespacesToDelete := len(group.active) - group.target
+			if namespacesToDelete <= 0 {
+				continue
+			}
+			remaining, err := r.deleteNamespaces(ctx, config, group.active, namespacesToDelete)
+			namespacesDeleted += len(group.active) - len(remaining)
+			group.active = remaining
+			if err != nil {
+				if !isCycleBudgetSpent(err) {
+					return currentNamespaceCount, resourceCounts, fmt.Errorf("failed to delete namespaces: %w", err)
+				}
+				yieldErr = err
+				break
+			}
 		}
-		namespacesDeleted = namespacesToDelete
+		activeNamespaces = groupedActive(groups)
 		// Update counts: some active namespaces are now terminating
-		currentActiveCount -= namespacesToDelete
-		terminatingCount += namespacesToDelete
+		currentActiveCount -= namespacesDeleted
+		terminatingCount += namespacesDeleted
 		currentNamespaceCount = currentActiveCount + terminatingCount
 		log.V(1).Info("Namespaces deletion initiated",
 			"deleted", namespacesDeleted,
@@ -460,11 +1003,47 @@ func (r *ScaleLoadConfigReconciler) manageLoadResources(ctx context.Context, con
 			"newTotal", currentNamespaceCount)
 	}
 
+	// A cycle that ran out of budget on namespaces leaves their resources to the next one and reports
+	// the counts of their last pass
+	if yieldErr != nil {
+		toCreate, toDelete := namespaceShortfall(groups)
+		r.recordConvergence(ctx, config.Name, true, toCreate, toDelete, 0)
+		log.Info("Cycle budget spent, yielding before managing resources",
+			"namespacesCreated", namespacesCreated,
+			"namespacesDeleted", namespacesDeleted,
+			"toCreate", toCreate,
+			"toDelete", toDelete)
+		return currentNamespaceCount, r.lastManagedCounts(activeNamespaces), yieldErr
+	}
+
 	// Get the current list of active namespaces for resource processing (skip terminating ones)
 	currentNamespaces := activeNamespaces
+	deferred := 0
+	if backgroundChurn(config) {
+		// The reconcile only keeps one worker per namespace running; the workers do the churn
+		resourceCounts = r.syncChurnWorkers(config, currentNamespaces)
+	} else {
+		r.stopChurnWorkers(config.Name)
+		if config.Spec.Topology.Enabled {
+			r.setCurrentNamespaceZones(namespaceZones(currentNamespaces))
+			defer r.setCurrentNamespaceZones(nil)
+		}
 
-	// Manage resources within namespaces - PARALLEL PROCESSING
-	resourceCounts = r.manageNamespacesParallel(ctx, config, currentNamespaces)
+		// Manage resources within namespaces - PARALLEL PROCESSING
+		resourceCounts, deferred = r.manageNamespacesParallel(ctx, config, currentNamespaces)
+	}
+	toCreate, toDelete := namespaceShortfall(groups)
+	r.recordConvergence(ctx, config.Name, deferred > 0, toCreate, toDelete, deferred)
+	if deferred > 0 {
+		log.Info("Cycle budget spent, yielding with namespaces left to manage", "deferred", deferred)
+		return currentNamespaceCount, resourceCounts,
+			fmt.Errorf("deferred %d namespaces: %w", deferred, errCycleBudgetSpent)
+	}
+	if deferredOps := deferredOperations(ctx); deferredOps > 0 {
+		log.Info("Operation budget spent, continuing convergence next cycle",
+			"maxOperationsPerCycle", config.Spec.MaxOperationsPerCycle,
+			"deferredOperations", deferredOps)
+	}
 
 	// Calculate total resource operations
 	totalResourceOperations := 0
@@ -485,6 +1064,10 @@ func (r *ScaleLoadConfigReconciler) manageLoadResources(ctx context.Context, con
 
 // getManagedNamespaces gets namespaces managed by this operator
 func (r *ScaleLoadConfigReconciler) getManagedNamespaces(ctx context.Context, config *scalev1.ScaleLoadConfig) ([]corev1.Namespace, error) {
+	if isNamespaceScoped(config) {
+		return r.getScopedNamespaces(ctx, config)
+	}
+
 	namespaceList := &corev1.NamespaceList{}
 
 	labelSelector := labels.SelectorFromSet(map[string]string{
@@ -499,7 +1082,14 @@ func (r *ScaleLoadConfigReconciler) getManagedNamespaces(ctx context.Context, co
 		return nil, err
 	}
 
-	return namespaceList.Items, nil
+	// A managed-by label copied onto a platform or excluded namespace must not make it a load target
+	namespaces := namespaceList.Items[:0]
+	for _, ns := range namespaceList.Items {
+		if !scalev1.IsProtectedNamespace(ns.Name, config.Spec.ExcludedNamespaces) {
+			namespaces = append(namespaces, ns)
+		}
+	}
+	return namespaces, nil
 }
 
 // getManagedNamespacesWithStatus gets namespaces managed by this operator and separates by status
@@ -510,7 +1100,8 @@ func (r *ScaleLoadConfigReconciler) getManagedNamespacesWithStatus(ctx context.C
 	}
 
 	for _, ns := range allNamespaces {
-		if ns.Status.Phase == corev1.NamespaceTerminating {
+		// Count by deletion timestamp too, since the phase lags behind the delete
+		if namespaceTerminating(ns) {
 			terminating = append(terminating, ns)
 		} else {
 			active = append(active, ns)
@@ -520,30 +1111,63 @@ func (r *ScaleLoadConfigReconciler) getManagedNamespacesWithStatus(ctx context.C
 	return active, terminating, nil
 }
 
-// createNamespaces creates new namespaces with proper labeling
+// createNamespaces creates new namespaces with proper labeling and returns how many it created; it
+// creates no more than the cycle's operation budget allows and stops early when the cycle budget runs out
 func (r *ScaleLoadConfigReconciler) createNamespaces(ctx context.Context, config *scalev1.ScaleLoadConfig,
-	kwokNodes []corev1.Node, count int) error {
+	pool *scalev1.NamespacePool, kwokNodes []corev1.Node, count int) (int, error) {
 
+	if count = takeOperations(ctx, count); count == 0 {
+		return 0, nil
+	}
 	log := r.Log.WithName("namespace-creator")
 	prefix := config.Spec.NamespaceConfig.NamespacePrefix
 	if prefix == "" {
 		prefix = "openshift-fake-"
 	}
+	if pool != nil {
+		prefix = config.PoolPrefix(pool)
+	}
+	// Namespaces pinned to a subset of the KWOK nodes are associated with those nodes only
+	if nodeSelector := config.NamespaceNodeSelector(pool); len(nodeSelector) > 0 {
+		kwokNodes = nodesMatching(kwokNodes, nodeSelector)
+		if len(kwokNodes) == 0 {
+			log.Info("No KWOK nodes match the namespace node selector, creating namespaces without an associated node",
+				"nodeSelector", nodeSelector)
+		}
+	}
 
-	// Get current namespace count to continue indexing sequence
+	// Reuse indices freed by deleted namespaces so NamespaceInterval placement stays consistent
 	existingNamespaces, err := r.getManagedNamespaces(ctx, config)
 	if err != nil {
-		return fmt.Errorf("failed to get existing namespaces for indexing: %w", err)
+		return 0, fmt.Errorf("failed to get existing namespaces for indexing: %w", err)
+	}
+	if pool != nil {
+		// Each pool indexes its own namespaces from 0
+		var poolNamespaces []corev1.Namespace
+		for _, ns := range existingNamespaces {
+			if ns.Labels[namespacePoolLabel] == pool.Name {
+				poolNamespaces = append(poolNamespaces, ns)
+			}
+		}
+		existingNamespaces = poolNamespaces
 	}
-	startIndex := len(existingNamespaces)
+	indices := allocateNamespaceIndices(existingNamespaces, count)
 
 	for i := 0; i < count; i++ {
+		if cycleBudgetSpent(ctx) {
+			return i, fmt.Errorf("created %d of %d namespaces: %w", i, count, errCycleBudgetSpent)
+		}
+
 		// Generate unique namespace name
-		namespaceName := fmt.Sprintf("%s%s-%d", prefix, generateRandomString(6), time.Now().Unix()%10000)
+		namespaceName := fmt.Sprintf("%s%s-%d", prefix, generator.RandomString(6), time.Now().Unix()%10000)
 
 		// Select associated node (for resource locality simulation)
 		associatedNode := ""
-		if len(kwokNodes) > 0 {
+		zone := ""
+		if config.Spec.Topology.Enabled {
+			zone = zoneForPosition(topologyZones(config), uint64(indices[i]))
+			associatedNode = selectNodeInZone(kwokNodes, zone)
+		} else if len(kwokNodes) > 0 {
 			associatedNode = kwokNodes[rand.Intn(len(kwokNodes))].Name
 		}
 
@@ -554,11 +1178,22 @@ func (r *ScaleLoadConfigReconciler) createNamespaces(ctx context.Context, config
 					"scale.openshift.io/managed-by":      config.Name,
 					"scale.openshift.io/associated-node": associatedNode,
 					"scale.openshift.io/created-by":      "sim-operator",
-					"scale.openshift.io/namespace-index": fmt.Sprintf("%d", startIndex+i),
+					"scale.openshift.io/namespace-index": fmt.Sprintf("%d", indices[i]),
 				},
 			},
 		}
 
+		if zone != "" {
+			namespace.Labels[namespaceZoneLabel] = zone
+		}
+		if pool != nil {
+			namespace.Labels[namespacePoolLabel] = pool.Name
+		}
+		if archetype := archetypeForIndex(config, indices[i]); archetype != "" {
+			namespace.Labels[namespaceArchetypeLabel] = archetype
+		}
+		stampSCCAnnotations(config, namespace)
+
 		// Add custom labels and annotations
 		if config.Spec.NamespaceConfig.Labels != nil {
 			for k, v := range config.Spec.NamespaceConfig.Labels {
@@ -575,8 +1210,8 @@ func (r *ScaleLoadConfigReconciler) createNamespaces(ctx context.Context, config
 			}
 		}
 
-		if err := r.Create(ctx, namespace); err != nil {
-			return fmt.Errorf("failed to create namespace %s: %w", namespaceName, err)
+		if err := r.createOrAdopt(ctx, config, namespace); err != nil {
+			return i, fmt.Errorf("failed to create namespace %s: %w", namespaceName, err)
 		}
 		r.recordAPICall(config, 1) // Create namespace operation
 
@@ -584,6 +1219,7 @@ func (r *ScaleLoadConfigReconciler) createNamespaces(ctx context.Context, config
 
 		// Initialize resource manager
 		r.resourceManagers[namespaceName] = &ResourceManager{
+			configName:       config.Name,
 			namespace:        namespaceName,
 			associatedNode:   associatedNode,
 			lastUpdate:       time.Now(),
@@ -592,49 +1228,161 @@ func (r *ScaleLoadConfigReconciler) createNamespaces(ctx context.Context, config
 		}
 	}
 
-	return nil
+	return count, nil
 }
 
-// deleteNamespaces removes the specified number of namespaces
+// pruneResourceManagers drops the entries of a config's namespaces that no longer exist, e.g. deleted
+// by hand or by another controller, which the delete paths never see
+func (r *ScaleLoadConfigReconciler) pruneResourceManagers(configName string, namespaces []corev1.Namespace) {
+	present := make(map[string]bool, len(namespaces))
+	for _, ns := range namespaces {
+		present[ns.Name] = true
+	}
+	for name, manager := range r.resourceManagers {
+		if manager.configName == configName && !present[name] {
+			delete(r.resourceManagers, name)
+		}
+	}
+}
+
+// deleteNamespaces removes the specified number of namespaces and returns the ones it left; it deletes
+// no more than the cycle's operation budget allows and stops early when the cycle budget runs out
 func (r *ScaleLoadConfigReconciler) deleteNamespaces(ctx context.Context, config *scalev1.ScaleLoadConfig,
-	namespaces []corev1.Namespace, count int) error {
+	namespaces []corev1.Namespace, count int) ([]corev1.Namespace, error) {
 
 	log := r.Log.WithName("namespace-deleter")
+	count = takeOperations(ctx, count)
 
-	// Sort by creation time to delete oldest first
-	sort.Slice(namespaces, func(i, j int) bool {
-		return namespaces[i].CreationTimestamp.Before(&namespaces[j].CreationTimestamp)
-	})
+	orderScaleDownVictims(namespaces, config.Spec.NamespaceConfig.ScaleDownPolicy)
 
 	for i := 0; i < count && i < len(namespaces); i++ {
+		if cycleBudgetSpent(ctx) {
+			return namespaces[i:], fmt.Errorf("deleted %d of %d namespaces: %w", i, count, errCycleBudgetSpent)
+		}
 		ns := namespaces[i]
 
-		if config.Spec.CleanupConfig.GracefulDeletes {
-			gracePeriod := int64(30)
-			deleteOpts := &client.DeleteOptions{
-				GracePeriodSeconds: &gracePeriod,
-			}
-			if err := r.Delete(ctx, &ns, deleteOpts); err != nil {
-				return fmt.Errorf("failed to delete namespace %s: %w", ns.Name, err)
-			}
-		} else {
-			if err := r.Delete(ctx, &ns); err != nil {
-				return fmt.Errorf("failed to delete namespace %s: %w", ns.Name, err)
-			}
+		if err := r.deleteManagedNamespace(ctx, config, &ns); err != nil {
+			return namespaces[i:], fmt.Errorf("failed to delete namespace %s: %w", ns.Name, err)
 		}
 		r.recordAPICall(config, 1) // Delete namespace operation
 
-		// Clean up resource manager
+		// Clean up resource manager and churn timing; target clusters have no namespace watch to do it
 		delete(r.resourceManagers, ns.Name)
+		r.forgetResourceTiming(ns.Name)
 
 		log.V(1).Info("Deleted namespace", "name", ns.Name)
 	}
 
-	return nil
+	return namespaces[min(count, len(namespaces)):], nil
+}
+
+// orderScaleDownVictims sorts namespaces so the ones to remove first come first
+func orderScaleDownVictims(namespaces []corev1.Namespace, policy string) {
+	switch policy {
+	case scalev1.ScaleDownNewestFirst:
+		sort.SliceStable(namespaces, func(i, j int) bool {
+			return namespaces[j].CreationTimestamp.Before(&namespaces[i].CreationTimestamp)
+		})
+	case scalev1.ScaleDownRandom:
+		rand.Shuffle(len(namespaces), func(i, j int) {
+			namespaces[i], namespaces[j] = namespaces[j], namespaces[i]
+		})
+	case scalev1.ScaleDownHighestIndexFirst:
+		// Namespaces without an index sort last so indexed ones keep a contiguous range
+		sort.SliceStable(namespaces, func(i, j int) bool {
+			return namespaceIndex(namespaces[i]) > namespaceIndex(namespaces[j])
+		})
+	default:
+		sort.SliceStable(namespaces, func(i, j int) bool {
+			return namespaces[i].CreationTimestamp.Before(&namespaces[j].CreationTimestamp)
+		})
+	}
+}
+
+// allocateNamespaceIndices returns the lowest count indices not held by any existing namespace,
+// including terminating ones, so freed indices are reused deterministically
+func allocateNamespaceIndices(existing []corev1.Namespace, count int) []int {
+	used := make(map[int]bool, len(existing))
+	for _, ns := range existing {
+		if index := namespaceIndex(ns); index >= 0 {
+			used[index] = true
+		}
+	}
+
+	indices := make([]int, 0, count)
+	for candidate := 0; len(indices) < count; candidate++ {
+		if !used[candidate] {
+			indices = append(indices, candidate)
+		}
+	}
+	return indices
+}
+
+// namespaceIndex returns the namespace-index label value, or -1 when it is missing or invalid
+func namespaceIndex(namespace corev1.Namespace) int {
+	index, err := strconv.Atoi(namespace.Labels["scale.openshift.io/namespace-index"])
+	if err != nil {
+		return -1
+	}
+	return index
+}
+
+// namespaceDeleteOptions builds the grace period and propagation policy for namespace deletes
+func namespaceDeleteOptions(config *scalev1.ScaleLoadConfig) *client.DeleteOptions {
+	cleanup := config.Spec.CleanupConfig
+
+	gracePeriod := int64(0)
+	if cleanup.GracefulDeletes {
+		gracePeriod = int64(cleanup.GracePeriodSeconds)
+	}
+
+	propagation := metav1.DeletePropagationBackground
+	if cleanup.PropagationPolicy == string(metav1.DeletePropagationForeground) {
+		propagation = metav1.DeletePropagationForeground
+	}
+
+	return &client.DeleteOptions{
+		GracePeriodSeconds: &gracePeriod,
+		PropagationPolicy:  &propagation,
+	}
+}
+
+// deferScaleDown reports whether a wanted namespace scale down should wait because the
+// node count has not stayed low for CleanupDelaySeconds, so brief KWOK node flaps do not
+// delete and recreate whole batches of namespaces
+func (r *ScaleLoadConfigReconciler) deferScaleDown(config *scalev1.ScaleLoadConfig, wanted bool) bool {
+	if !wanted {
+		delete(r.scaleDownPendingSince, config.Name)
+		return false
+	}
+
+	delay := time.Duration(config.Spec.CleanupConfig.CleanupDelaySeconds) * time.Second
+	if delay <= 0 {
+		return false
+	}
+
+	if r.scaleDownPendingSince == nil {
+		r.scaleDownPendingSince = make(map[string]time.Time)
+	}
+	since, pending := r.scaleDownPendingSince[config.Name]
+	if !pending {
+		r.scaleDownPendingSince[config.Name] = time.Now()
+		return true
+	}
+	if time.Since(since) < delay {
+		return true
+	}
+
+	delete(r.scaleDownPendingSince, config.Name)
+	return false
 }
 
 // calculateReconcileInterval determines how often to reconcile based on API rate capacity
 func (r *ScaleLoadConfigReconciler) calculateReconcileInterval(config *scalev1.ScaleLoadConfig, nodeCount int) time.Duration {
+	if config.Spec.ReconcileInterval != nil && config.Spec.ReconcileInterval.Duration > 0 {
+		return config.Spec.ReconcileInterval.Duration
+	}
+
 	// Fast reconcile for node detection scenarios
 	if nodeCount == 0 {
 		return 5 * time.Second // Faster for cleanup and node detection
@@ -662,24 +1410,21 @@ func (r *ScaleLoadConfigReconciler) ensureAPICallRate(ctx context.Context, confi
 	effectiveRate, _ := r.getEffectiveAPIRate(config, nodeCount)
 	r.targetAPICallsPerMinute = effectiveRate
 
-	// Reset counter every minute
-	if r.lastRateReset.IsZero() || now.Sub(r.lastRateReset) >= time.Minute {
-		r.apiCallsThisMinute = 0
-		r.lastRateReset = now
-	}
+	// The counter resets every minute
+	callsThisMinute, windowStart, _ := r.apiCalls.window(now)
 
 	// Calculate how many more API calls needed this minute
-	elapsedSeconds := now.Sub(r.lastRateReset).Seconds()
+	elapsedSeconds := now.Sub(windowStart).Seconds()
 	expectedCallsByNow := int32(float64(r.targetAPICallsPerMinute) * (elapsedSeconds / 60.0))
-	callsNeeded := expectedCallsByNow - r.apiCallsThisMinute
+	callsNeeded := expectedCallsByNow - callsThisMinute
 
 	if callsNeeded <= 0 {
 		// If we're significantly over target, warn and skip additional calls
-		if r.apiCallsThisMinute > r.targetAPICallsPerMinute*2 {
+		if callsThisMinute > r.targetAPICallsPerMinute*2 {
 			r.Log.WithName("rate-controller").Info("API call rate significantly above target - skipping additional calls to prevent overload",
-				"current", r.apiCallsThisMinute,
+				"current", callsThisMinute,
 				"target", r.targetAPICallsPerMinute,
-				"overagePercent", int(float64(r.apiCallsThisMinute-r.targetAPICallsPerMinute)/float64(r.targetAPICallsPerMinute)*100))
+				"overagePercent", int(float64(callsThisMinute-r.targetAPICallsPerMinute)/float64(r.targetAPICallsPerMinute)*100))
 		}
 		return // Already meeting or exceeding target
 	}
@@ -687,7 +1432,7 @@ func (r *ScaleLoadConfigReconciler) ensureAPICallRate(ctx context.Context, confi
 	log := r.Log.WithName("rate-controller")
 	log.V(1).Info("Making additional API calls to meet target",
 		"target", r.targetAPICallsPerMinute,
-		"currentThisMinute", r.apiCallsThisMinute,
+		"currentThisMinute", callsThisMinute,
 		"expected", expectedCallsByNow,
 		"needed", callsNeeded)
 
@@ -803,7 +1548,7 @@ func (r *ScaleLoadConfigReconciler) performNamespaceChurn(ctx context.Context, c
 		log.V(1).Info("Churning namespace", "namespace", ns.Name)
 
 		// Delete the namespace
-		if err := r.Delete(ctx, &ns); err != nil {
+		if err := r.deleteManagedNamespace(ctx, config, &ns); err != nil {
 			log.Error(err, "Failed to delete namespace for churn", "namespace", ns.Name)
 			continue
 		}
@@ -811,7 +1556,14 @@ func (r *ScaleLoadConfigReconciler) performNamespaceChurn(ctx context.Context, c
 
 		// Create a replacement namespace immediately
 		newNamespace := r.generateNamespace(config, ns.Name+"-new")
-		if err := r.Create(ctx, newNamespace); err != nil {
+		// The replacement takes over the churned namespace's index, zone, archetype and pool
+		for _, key := range []string{"scale.openshift.io/namespace-index", namespaceZoneLabel, namespaceArchetypeLabel, namespacePoolLabel} {
+			if value, ok := ns.Labels[key]; ok {
+				newNamespace.Labels[key] = value
+			}
+		}
+		stampSCCAnnotations(config, newNamespace)
+		if err := r.createOrAdopt(ctx, config, newNamespace); err != nil {
 			log.Error(err, "Failed to create replacement namespace", "namespace", newNamespace.Name)
 			continue
 		}
@@ -861,36 +1613,35 @@ func (r *ScaleLoadConfigReconciler) generateNamespace(config *scalev1.ScaleLoadC
 	return namespace
 }
 
-// updateNodeCountStatus provides early status update for node count to prevent stale status
+// updateNodeCountStatus provides early status update for node count to prevent stale status.
+// Like updateStatus it only writes when the node count or verification changed, unless every
+// reconcile is meant to write status
 func (r *ScaleLoadConfigReconciler) updateNodeCountStatus(ctx context.Context, config *scalev1.ScaleLoadConfig, nodeCount int) error {
-	// Retry logic to handle concurrent modifications
-	maxRetries := 3
-	for attempt := 0; attempt < maxRetries; attempt++ {
-		// Get fresh copy to avoid conflicts
-		freshConfig := &scalev1.ScaleLoadConfig{}
-		if err := r.Get(ctx, client.ObjectKeyFromObject(config), freshConfig); err != nil {
-			return fmt.Errorf("failed to get fresh config for status update: %w", err)
-		}
-
-		// Only update node count, preserve other fields
-		freshConfig.Status.KwokNodeCount = int32(nodeCount)
-		now := metav1.NewTime(time.Now())
-		freshConfig.Status.LastReconcileTime = &now
-
-		// Quick status update with retry
-		if err := r.Status().Update(ctx, freshConfig); err != nil {
-			// If it's a conflict error and we have retries left, try again
-			if strings.Contains(err.Error(), "object has been modified") && attempt < maxRetries-1 {
-				time.Sleep(time.Duration(attempt+1) * 100 * time.Millisecond) // Exponential backoff
-				continue
-			}
-			return fmt.Errorf("failed to update node count status after %d attempts: %w", attempt+1, err)
-		}
+	verification := r.nodeVerification(config.Name)
+	if config.Spec.StatusUpdates.Mode != scalev1.StatusUpdateEveryReconcile &&
+		config.Status.KwokNodeCount == int32(nodeCount) &&
+		equality.Semantic.DeepEqual(config.Status.NodeVerification, verification) {
+		return nil
+	}
 
-		return nil // Success
+	// Get fresh copy to avoid overwriting newer status
+	freshConfig := &scalev1.ScaleLoadConfig{}
+	if err := r.Get(ctx, client.ObjectKeyFromObject(config), freshConfig); err != nil {
+		return fmt.Errorf("failed to get fresh config for status update: %w", err)
 	}
+	original := freshConfig.DeepCopy()
+
+	// Only update node count, preserve other fields
+	freshConfig.Status.KwokNodeCount = int32(nodeCount)
+	freshConfig.Status.NodeVerification = verification
+	now := metav1.NewTime(time.Now())
+	freshConfig.Status.LastReconcileTime = &now
 
-	return fmt.Errorf("failed to update node count status after %d retries", maxRetries)
+	// A merge patch does not conflict with concurrent spec edits
+	if err := r.Status().Patch(ctx, freshConfig, client.MergeFrom(original)); err != nil {
+		return fmt.Errorf("failed to update node count status: %w", err)
+	}
+	return nil
 }
 
 // calculateNextReconcileResult returns appropriate reconcile result when skipping full processing
@@ -899,10 +1650,16 @@ func (r *ScaleLoadConfigReconciler) calculateNextReconcileResult(config *scalev1
 	return ctrl.Result{RequeueAfter: nextReconcile}, nil
 }
 
-// manageNamespacesParallel processes multiple namespaces concurrently for better performance
-func (r *ScaleLoadConfigReconciler) manageNamespacesParallel(ctx context.Context, config *scalev1.ScaleLoadConfig, namespaces []corev1.Namespace) map[string]int {
+// manageNamespacesParallel processes multiple namespaces concurrently for better performance. It also
+// returns how many namespaces it left for the next cycle because the cycle budget ran out
+func (r *ScaleLoadConfigReconciler) manageNamespacesParallel(ctx context.Context, config *scalev1.ScaleLoadConfig, namespaces []corev1.Namespace) (map[string]int, int) {
 	log := r.Log.WithName("parallel-manager")
 
+	// Namespaces a yielded cycle did not get to go first, ahead of the batching below
+	if yielded := r.convergence[config.Name]; yielded != nil && yielded.NamespacesDeferred > 0 {
+		r.deferredFirst(namespaces)
+	}
+
 	// Implement smart namespace batching based on cluster size
 	maxNamespacesPerReconcile := r.calculateOptimalBatchSize(len(namespaces))
 	if len(namespaces) > maxNamespacesPerReconcile {
@@ -932,8 +1689,11 @@ func (r *ScaleLoadConfigReconciler) manageNamespacesParallel(ctx context.Context
 	// Process namespaces in parallel
 	for _, ns := range namespaces {
 		// Check if namespace is ready before starting goroutine
-		if !r.isNamespaceReady(ctx, ns.Name) {
-			log.V(1).Info("Namespace not ready, skipping", "namespace", ns.Name)
+		if ready, phase := r.checkNamespaceStatus(ctx, ns.Name); !ready {
+			if namespaceGone(phase) {
+				r.recordNamespaceSkipped(phase)
+			}
+			log.V(2).Info("Namespace not ready, skipping", "namespace", ns.Name, "phase", phase)
 			continue
 		}
 
@@ -945,6 +1705,12 @@ func (r *ScaleLoadConfigReconciler) manageNamespacesParallel(ctx context.Context
 			semaphore <- struct{}{}
 			defer func() { <-semaphore }()
 
+			// Namespaces still waiting when the budget runs out are left for the next cycle
+			if cycleBudgetSpent(ctx) {
+				resultsChan <- namespaceResult{namespace: namespace.Name, err: errCycleBudgetSpent}
+				return
+			}
+
 			// Process single namespace
 			r.processNamespaceWithResult(ctx, config, namespace, resultsChan)
 		}(ns)
@@ -957,8 +1723,20 @@ func (r *ScaleLoadConfigReconciler) manageNamespacesParallel(ctx context.Context
 	// Aggregate results from all namespaces
 	aggregatedCounts := make(map[string]int)
 	var totalAPIcalls, successfulNamespaces, failedNamespaces int32
+	deferredNamespaces := 0
 
 	for result := range resultsChan {
+		manager := r.resourceManagers[result.namespace]
+		if isCycleBudgetSpent(result.err) {
+			deferredNamespaces++
+			// Their resources are still in place, so the counts of their last pass stand in for them
+			if manager != nil {
+				for resourceType, count := range manager.resourceCounters {
+					aggregatedCounts[resourceType] += count
+				}
+			}
+			continue
+		}
 		if result.err != nil {
 			log.Error(result.err, "Failed to manage namespace resources", "namespace", result.namespace)
 			failedNamespaces++
@@ -974,6 +1752,17 @@ func (r *ScaleLoadConfigReconciler) manageNamespacesParallel(ctx context.Context
 					"newTotal", aggregatedCounts[resourceType])
 			}
 			totalAPIcalls += result.apiCalls
+			// Namespaces created before the operator started get a manager on their first pass
+			if manager == nil {
+				manager = &ResourceManager{
+					configName:   config.Name,
+					namespace:    result.namespace,
+					updateTimers: make(map[string]time.Time),
+				}
+				r.resourceManagers[result.namespace] = manager
+			}
+			manager.lastUpdate = time.Now()
+			manager.resourceCounters = result.resourceCounts
 		}
 	}
 
@@ -985,6 +1774,7 @@ func (r *ScaleLoadConfigReconciler) manageNamespacesParallel(ctx context.Context
 		"totalNamespaces", len(namespaces),
 		"successful", successfulNamespaces,
 		"failed", failedNamespaces,
+		"deferred", deferredNamespaces,
 		"concurrency", maxConcurrency,
 		"namespacesPerSecond", fmt.Sprintf("%.1f", namespacesPerSecond),
 		"totalAPIcalls", totalAPIcalls,
@@ -1000,7 +1790,7 @@ func (r *ScaleLoadConfigReconciler) manageNamespacesParallel(ctx context.Context
 		"buildConfigs", aggregatedCounts["buildConfigs"],
 		"events", aggregatedCounts["events"])
 
-	return aggregatedCounts
+	return aggregatedCounts, deferredNamespaces
 }
 
 // namespaceResult holds the result of processing a single namespace
@@ -1091,44 +1881,82 @@ func parseFloat(s string) (float64, error) {
 
 // SetupWithManager sets up the controller with the Manager
 func (r *ScaleLoadConfigReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	// Initialize metrics
-	r.initializeMetrics()
-
-	// Initialize deletion manager for complex resources
-	r.deletionManager = NewDeletionManager(r)
+	// The manager serves controller-runtime's registry on its metrics endpoint
+	r.Prepare(metrics.Registry)
+	// Only the leader reconciles, so only the leader watches for stalls
+	if r.StallIntervals > 0 {
+		if err := mgr.Add(manager.RunnableFunc(r.watchForStalls)); err != nil {
+			return fmt.Errorf("failed to start stall watchdog: %w", err)
+		}
+	}
 
 	// Watch ScaleLoadConfig resources and Node changes for immediate response
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&scalev1.ScaleLoadConfig{}).
 		Watches(&corev1.Node{}, &NodeEventHandler{Client: mgr.GetClient()}).
+		Watches(&corev1.Namespace{}, &NamespaceEventHandler{Reconciler: r}).
+		Watches(&scalev1.LoadProfilePreset{}, handler.EnqueueRequestsFromMapFunc(r.configsForPreset)).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: 1, // Single threaded for simplicity
 		}).
 		Complete(r)
 }
 
+// Prepare initializes metrics, registering them with registerer, and wraps the client with subsystem
+// identities, pacing, write metering, run and stress labels and the namespace guard. SetupWithManager calls
+// it; callers that drive Reconcile without a manager, such as pkg/simtest, call it once themselves
+func (r *ScaleLoadConfigReconciler) Prepare(registerer prometheus.Registerer) {
+	r.initializeMetrics(registerer)
+
+	// Subsystem identities only swap the client a write is sent with, so they go innermost
+	if len(r.SubsystemClients) > 0 {
+		r.Client = withSubsystemIdentities(r.Client, r.SubsystemClients)
+	}
+	// Pace writes so each reconcile's traffic is spread out rather than sent in bursts, and
+	// meter their size to estimate etcd write volume
+	r.writeMeter = newWriteMeter(r.BytesWritten)
+	r.Client, r.pacer = withPacing(withWriteMeter(r.Client, r.writeMeter))
+	// Stress labels are added before metering so their bytes are counted
+	r.labelStamper = newLabelStamper()
+	r.Client = withLabelStamping(withRunLabels(r.Client), r.labelStamper)
+	// Protected namespaces are checked first so refused writes are neither paced nor metered
+	r.namespaceGuard = newNamespaceGuard()
+	r.Client = withNamespaceGuard(r.Client, r.namespaceGuard)
+
+	// Initialize deletion manager for complex resources
+	r.deletionManager = NewDeletionManager(r)
+}
+
+// StopBackgroundWork stops a config's churn workers, lease renewals and janitor. They are started
+// again by the config's next reconcile; callers use it to leave no goroutines behind, as tests must
+func (r *ScaleLoadConfigReconciler) StopBackgroundWork(configName string) {
+	r.stopChurnWorkers(configName)
+	r.stopControllerLeases(configName)
+	r.stopJanitor(configName)
+}
+
 // initializeMetrics sets up Prometheus metrics
-func (r *ScaleLoadConfigReconciler) initializeMetrics() {
-	r.KwokNodeCount = prometheus.NewGauge(prometheus.GaugeOpts{
+func (r *ScaleLoadConfigReconciler) initializeMetrics(registerer prometheus.Registerer) {
+	r.KwokNodeCount = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 		Name: "kwok_load_generator_nodes_total",
-		Help: "Current number of KWOK nodes being monitored",
-	})
+		Help: "Current number of KWOK nodes being monitored, by config",
+	}, []string{"config"})
 
-	r.GeneratedNamespaces = prometheus.NewGauge(prometheus.GaugeOpts{
+	r.GeneratedNamespaces = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 		Name: "kwok_load_generator_namespaces_total",
-		Help: "Current number of generated namespaces",
-	})
+		Help: "Current number of generated namespaces, by config",
+	}, []string{"config"})
 
 	r.APICallRate = prometheus.NewHistogram(prometheus.HistogramOpts{
 		Name:    "kwok_load_generator_api_calls_duration_seconds",
 		Help:    "Time taken for API calls",
-		Buckets: prometheus.DefBuckets,
+		Buckets: histogramBuckets(r.APICallDurationBuckets),
 	})
 
 	r.ReconcileTime = prometheus.NewHistogram(prometheus.HistogramOpts{
 		Name:    "kwok_load_generator_reconcile_duration_seconds",
 		Help:    "Time taken for reconcile loops",
-		Buckets: prometheus.DefBuckets,
+		Buckets: histogramBuckets(r.ReconcileDurationBuckets),
 	})
 
 	r.ErrorCount = prometheus.NewCounter(prometheus.CounterOpts{
@@ -1136,8 +1964,125 @@ func (r *ScaleLoadConfigReconciler) initializeMetrics() {
 		Help: "Total number of errors encountered",
 	})
 
-	// Register metrics
-	prometheus.MustRegister(r.KwokNodeCount, r.GeneratedNamespaces, r.APICallRate, r.ReconcileTime, r.ErrorCount)
+	r.ChurnerPasses = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "kwok_load_generator_churner_passes_total",
+		Help: "Per-namespace churn passes by resource type and result",
+	}, []string{"resource_type", "result"})
+
+	r.ChurnerDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "kwok_load_generator_churner_duration_seconds",
+		Help:    "Time taken for a per-namespace churn pass by resource type",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"resource_type"})
+
+	r.BytesWritten = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "kwok_load_generator_bytes_written_total",
+		Help: "Estimated bytes written to etcd, from the serialized size of each create, update and patch",
+	}, []string{"resource_type"})
+
+	r.NodeUpdateConflicts = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "kwok_load_generator_node_update_conflicts_total",
+		Help: "Node annotation update attempts that hit a resource version conflict, by node",
+	}, []string{"node"})
+
+	r.NodeAnnotationKeysChanged = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "kwok_load_generator_node_annotation_keys_changed_total",
+		Help: "Node annotation keys set or changed by annotation churn with recordDeltas, by config",
+	}, []string{"config"})
+
+	r.NodeAnnotationBytesChanged = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "kwok_load_generator_node_annotation_bytes_changed_total",
+		Help: "Bytes of the node annotations set or changed by annotation churn with recordDeltas, by config",
+	}, []string{"config"})
+
+	r.ManagedFieldsBytes = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "kwok_load_generator_managed_fields_bytes",
+		Help:    "Serialized size of the managedFields of objects applied by managedFields bloat, by resource type",
+		Buckets: prometheus.ExponentialBuckets(256, 2, 10),
+	}, []string{"resource_type"})
+
+	r.EventsRequested = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "kwok_load_generator_events_requested_total",
+		Help: "Events owed by the configured event rate, system events included",
+	})
+
+	r.EventsDropped = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "kwok_load_generator_events_dropped_total",
+		Help: "Events owed by the configured event rate that were not created, by reason",
+	}, []string{"reason"})
+
+	r.CreateAlreadyExists = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "kwok_load_generator_create_already_exists_total",
+		Help: "Creates that found the name taken, by resource type and result: owned, adopted, unowned or conflict",
+	}, []string{"resource_type", "result"})
+
+	r.NamespacesSkipped = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "kwok_load_generator_namespaces_skipped_total",
+		Help: "Namespaces left out of a churn pass because they are being deleted, by phase: Terminating or NotFound",
+	}, []string{"phase"})
+
+	r.SelfUsage = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "kwok_load_generator_self_usage",
+		Help: "The operator's own goroutines, tracking map entries and cached objects, by series",
+	}, []string{"series"})
+
+	r.SelfGrowthSuspected = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "kwok_load_generator_self_growth_suspected",
+		Help: "1 when a self usage series grew on every sample over the last hour",
+	}, []string{"series"})
+
+	r.ReconcileStalled = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "kwok_load_generator_reconcile_stalled",
+		Help: "1 while no reconcile of the config has completed within the stall watchdog's threshold",
+	}, []string{"config"})
+
+	// Register metrics, reusing the ones a reconciler prepared earlier in the same process registered
+	log := r.Log.WithName("metrics")
+	r.KwokNodeCount = registerCollector(log, registerer, r.KwokNodeCount)
+	r.GeneratedNamespaces = registerCollector(log, registerer, r.GeneratedNamespaces)
+	r.APICallRate = registerCollector(log, registerer, r.APICallRate)
+	r.ReconcileTime = registerCollector(log, registerer, r.ReconcileTime)
+	r.ErrorCount = registerCollector(log, registerer, r.ErrorCount)
+	r.ChurnerPasses = registerCollector(log, registerer, r.ChurnerPasses)
+	r.ChurnerDuration = registerCollector(log, registerer, r.ChurnerDuration)
+	r.BytesWritten = registerCollector(log, registerer, r.BytesWritten)
+	r.NodeUpdateConflicts = registerCollector(log, registerer, r.NodeUpdateConflicts)
+	r.ManagedFieldsBytes = registerCollector(log, registerer, r.ManagedFieldsBytes)
+	r.NodeAnnotationKeysChanged = registerCollector(log, registerer, r.NodeAnnotationKeysChanged)
+	r.NodeAnnotationBytesChanged = registerCollector(log, registerer, r.NodeAnnotationBytesChanged)
+	r.EventsRequested = registerCollector(log, registerer, r.EventsRequested)
+	r.EventsDropped = registerCollector(log, registerer, r.EventsDropped)
+	r.CreateAlreadyExists = registerCollector(log, registerer, r.CreateAlreadyExists)
+	r.NamespacesSkipped = registerCollector(log, registerer, r.NamespacesSkipped)
+	r.SelfUsage = registerCollector(log, registerer, r.SelfUsage)
+	r.SelfGrowthSuspected = registerCollector(log, registerer, r.SelfGrowthSuspected)
+	r.ReconcileStalled = registerCollector(log, registerer, r.ReconcileStalled)
+}
+
+// registerCollector registers collector, or returns the collector already registered under the same
+// name so a restart in the same process keeps counting into it. A collector that cannot be registered,
+// e.g. a histogram whose buckets changed, is still returned and works, but is not exported
+func registerCollector[T prometheus.Collector](log logr.Logger, registerer prometheus.Registerer, collector T) T {
+	err := registerer.Register(collector)
+	if err == nil {
+		return collector
+	}
+	var registered prometheus.AlreadyRegisteredError
+	if errors.As(err, &registered) {
+		if existing, ok := registered.ExistingCollector.(T); ok {
+			return existing
+		}
+	}
+	log.Error(err, "Failed to register metric, it will not be exported")
+	return collector
+}
+
+// histogramBuckets returns the configured bucket bounds, or prometheus.DefBuckets when none are set
+func histogramBuckets(buckets []float64) []float64 {
+	if len(buckets) == 0 {
+		return prometheus.DefBuckets
+	}
+	return buckets
 }
 
 // performOrphanCleanup removes resources (especially pods) that are stuck on deleted KWOK nodes
@@ -1145,7 +2090,7 @@ func (r *ScaleLoadConfigReconciler) performOrphanCleanup(ctx context.Context, co
 	log := r.Log.WithName("orphan-cleanup")
 
 	// Get all existing KWOK nodes to build a list of valid node names
-	kwokNodes, err := r.getKwokNodes(ctx, config.Spec.KwokNodeSelector)
+	kwokNodes, err := r.getKwokNodes(ctx, config)
 	if err != nil {
 		return fmt.Errorf("failed to get KWOK nodes for orphan cleanup: %w", err)
 	}
@@ -1153,7 +2098,12 @@ func (r *ScaleLoadConfigReconciler) performOrphanCleanup(ctx context.Context, co
 	validNodeNames := make(map[string]bool)
 	for _, node := range kwokNodes {
 		validNodeNames[node.Name] = true
+		delete(r.missingNodesSince, config.Name+"/"+node.Name)
 	}
+	if r.missingNodesSince == nil {
+		r.missingNodesSince = make(map[string]time.Time)
+	}
+	cleanupDelay := time.Duration(config.Spec.CleanupConfig.CleanupDelaySeconds) * time.Second
 
 	log.V(1).Info("Starting orphan cleanup", "validKwokNodes", len(validNodeNames))
 
@@ -1182,6 +2132,17 @@ func (r *ScaleLoadConfigReconciler) performOrphanCleanup(ctx context.Context, co
 			if pod.Spec.NodeName != "" && !validNodeNames[pod.Spec.NodeName] {
 				orphanPodsFound++
 
+				// Give the node CleanupDelaySeconds to come back before touching its pods
+				missingKey := config.Name + "/" + pod.Spec.NodeName
+				missingSince, seen := r.missingNodesSince[missingKey]
+				if !seen {
+					missingSince = time.Now()
+					r.missingNodesSince[missingKey] = missingSince
+				}
+				if time.Since(missingSince) < cleanupDelay {
+					continue
+				}
+
 				// Check if this pod was created by sim-operator (has our labels)
 				if labels := pod.Labels; labels != nil {
 					if managedBy, exists := labels["scale.openshift.io/managed-by"]; exists && managedBy == config.Name {
@@ -1296,19 +2257,18 @@ func (r *ScaleLoadConfigReconciler) shouldThrottleOperations(config *scalev1.Sca
 	// Calculate target rate
 	effectiveRate, _ := r.getEffectiveAPIRate(config, nodeCount)
 
-	// Reset counter every minute
-	if r.lastRateReset.IsZero() || now.Sub(r.lastRateReset) >= time.Minute {
-		r.apiCallsThisMinute = 0
-		r.lastRateReset = now
+	// The counter resets every minute
+	callsThisMinute, _, fresh := r.apiCalls.window(now)
+	if fresh {
 		return false // Fresh minute, don't throttle
 	}
 
 	// Check if we're significantly over target (more than 150% of target)
-	if r.apiCallsThisMinute > effectiveRate*3/2 {
+	if callsThisMinute > effectiveRate*3/2 {
 		r.Log.V(1).Info("Throttling operations due to high API rate",
-			"currentRate", r.apiCallsThisMinute,
+			"currentRate", callsThisMinute,
 			"targetRate", effectiveRate,
-			"overage", r.apiCallsThisMinute-effectiveRate)
+			"overage", callsThisMinute-effectiveRate)
 		return true
 	}
 
@@ -0,0 +1,76 @@
+package controllers_test
+
+import (
+	"context"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+	"github.com/jtaleric/sim-operator/pkg/simtest"
+)
+
+// newTestConfig returns an enabled config whose API rate is high enough that paced writes do not
+// slow the test down
+func newTestConfig(name string) *scalev1.ScaleLoadConfig {
+	rate := int32(1000)
+	config := &scalev1.ScaleLoadConfig{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	config.Spec.Enabled = true
+	config.Spec.LoadProfile.APICallRatePerNode = &rate
+	return config
+}
+
+func TestScaleLoadConfigReconciler_DeleteCleansUp(t *testing.T) {
+	ctx := context.Background()
+	h := simtest.NewFake(t, simtest.Options{}, simtest.KwokNodes(3)...)
+
+	if err := h.Apply(ctx, newTestConfig("cleanup")); err != nil {
+		t.Fatal(err)
+	}
+	// Apply defaults the cleanup delay to a minute, so it is turned off on the stored config
+	config := &scalev1.ScaleLoadConfig{}
+	if err := h.Client.Get(ctx, client.ObjectKey{Name: "cleanup"}, config); err != nil {
+		t.Fatal(err)
+	}
+	config.Spec.CleanupConfig.CleanupDelaySeconds = 0
+	if err := h.Client.Update(ctx, config); err != nil {
+		t.Fatal(err)
+	}
+	if err := h.ReconcilePasses(ctx, "cleanup", 3); err != nil {
+		t.Fatal(err)
+	}
+	set, err := h.Generated(ctx, "cleanup")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if set.Count("namespace") == 0 || set.Count("configmap") == 0 {
+		t.Fatalf("expected namespaces and ConfigMaps before deletion, got %v", set.Counts())
+	}
+
+	if err := h.Delete(ctx, "cleanup"); err != nil {
+		t.Fatal(err)
+	}
+	// Cleanup may take several passes; the finalizer is removed once it is done
+	for pass := 0; pass < 10; pass++ {
+		if err := h.Client.Get(ctx, client.ObjectKey{Name: "cleanup"}, config); apierrors.IsNotFound(err) {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := h.Reconcile(ctx, "cleanup"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := h.Client.Get(ctx, client.ObjectKey{Name: "cleanup"}, config); !apierrors.IsNotFound(err) {
+		t.Fatalf("config still exists after cleanup with finalizers %v", config.Finalizers)
+	}
+
+	// The fake client does not empty deleted namespaces, so only the namespaces themselves are checked
+	set, err = h.Generated(ctx, "cleanup")
+	if err != nil {
+		t.Fatal(err)
+	}
+	simtest.AssertCounts(t, set, map[string]int{"namespace": 0})
+}
@@ -0,0 +1,168 @@
+package controllers
+
+import (
+	"math"
+	"time"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+// applyScenario copies the settings of the selected built-in scenario onto the in-memory config.
+// It runs before the preset and load profile, so both still apply on top of the scenario
+func (r *ScaleLoadConfigReconciler) applyScenario(config *scalev1.ScaleLoadConfig, now time.Time) {
+	log := r.Log.WithName("scenario-manager")
+
+	switch config.Spec.Scenario {
+	case "":
+		return
+	case scalev1.ScenarioUpgradeStorm:
+		applyUpgradeStorm(config)
+	case scalev1.ScenarioCIBurst:
+		applyCIBurst(config)
+	case scalev1.ScenarioDiurnalProduction:
+		applyDiurnalProduction(config, now)
+	case scalev1.ScenarioRescheduleWave:
+		applyRescheduleWave(config)
+	default:
+		// Webhooks may be disabled, so fall back to the spec as written
+		log.Info("Ignoring unknown scenario", "scenario", config.Spec.Scenario)
+		return
+	}
+
+	// The scenario's cadence applies ahead of the preset and profile, but never over reconcileInterval
+	if interval, ok := config.Spec.ScenarioReconcileIntervals[config.Spec.Scenario]; ok && config.Spec.ReconcileInterval == nil {
+		config.Spec.ReconcileInterval = interval.DeepCopy()
+	}
+
+	// The scenario's load tier applies unless the spec already selects one
+	if config.Spec.LoadProfile.Profile == "" {
+		config.Spec.LoadProfile.Profile = scalev1.ScenarioLoadProfile(config.Spec.Scenario)
+	}
+	log.V(1).Info("Applied scenario", "scenario", config.Spec.Scenario)
+}
+
+// applyUpgradeStorm simulates a rolling cluster upgrade: the machine config daemon and OVN rewrite
+// node annotations, nodes report status changes, and drained pods are deleted and recreated elsewhere
+func applyUpgradeStorm(config *scalev1.ScaleLoadConfig) {
+	annotations := &config.Spec.AnnotationChurn
+	annotations.Enabled = true
+	annotations.MachineConfigAnnotations = true
+	annotations.NetworkingAnnotations = true
+	annotations.UpdateIntervalMin = 15
+	annotations.UpdateIntervalMax = 60
+
+	churn := &config.Spec.ResourceChurn
+	churn.Pods.Enabled = true
+	churn.Pods.UpdateFrequencyMin = 30
+	churn.Pods.UpdateFrequencyMax = 120
+	churn.Pods.DeleteRecreateChance = "0.6"
+
+	churn.Events.Enabled = true
+	churn.Events.EventsPerNodePerHour = 200
+	churn.Events.SeriesChance = "0.3"
+	churn.Events.SystemEvents.Enabled = true
+	churn.Events.SystemEvents.Fraction = "0.7"
+
+	churn.Namespaces.Enabled = false
+}
+
+// applyCIBurst simulates CI clusters: short-lived test namespaces, builds and image pushes,
+// and pods that only live for the length of a job
+func applyCIBurst(config *scalev1.ScaleLoadConfig) {
+	churn := &config.Spec.ResourceChurn
+	churn.Namespaces.Enabled = true
+	churn.Namespaces.ChurnPercentage = 25
+	churn.Namespaces.ChurnIntervalSeconds = 60
+	churn.Namespaces.PreserveOldestNamespaces = 5
+
+	for _, resource := range []*scalev1.ResourceTypeConfig{&churn.BuildConfigs, &churn.ImageStreams} {
+		resource.Enabled = true
+		resource.UpdateFrequencyMin = 30
+		resource.UpdateFrequencyMax = 120
+	}
+
+	churn.Pods.Enabled = true
+	churn.Pods.TTLSeconds = 900
+	churn.Pods.DeleteRecreateChance = "0.5"
+
+	churn.Events.Enabled = true
+	churn.Events.EventsPerNodePerHour = 150
+	churn.Events.TimestampSpreadSeconds = 0
+}
+
+// applyDiurnalProduction simulates a long-running production cluster: a mix of tenant personas
+// whose churn follows the working day, peaking mid-afternoon and bottoming out overnight
+func applyDiurnalProduction(config *scalev1.ScaleLoadConfig, now time.Time) {
+	if len(config.Spec.NamespaceArchetypes) == 0 {
+		config.Spec.NamespaceArchetypes = diurnalProductionArchetypes()
+	}
+
+	config.Spec.NamespaceAnnotationChurn.Enabled = true
+
+	events := &config.Spec.ResourceChurn.Events
+	events.Enabled = true
+	events.EventsPerNodePerHour = 50
+	events.TimestampSpreadSeconds = 3600
+	events.SeriesChance = "0.2"
+	events.SystemEvents.Enabled = true
+	events.SystemEvents.Fraction = "0.5"
+
+	scaleChurn(config, diurnalMultiplier(now))
+}
+
+// applyRescheduleWave simulates node pools being relabeled: every five minutes a fifth of the nodes
+// change role and pool labels, and the pods selecting on them are deleted and recreated elsewhere
+func applyRescheduleWave(config *scalev1.ScaleLoadConfig) {
+	labels := &config.Spec.SchedulingLabelChurn
+	labels.Enabled = true
+	labels.NodePercent = 20
+	labels.IntervalSeconds = 300
+
+	churn := &config.Spec.ResourceChurn
+	churn.Pods.Enabled = true
+	churn.Pods.DeleteRecreateChance = "0.5"
+
+	churn.Events.Enabled = true
+	churn.Events.EventsPerNodePerHour = 100
+}
+
+// diurnalProductionArchetypes splits production namespaces into web services, batch jobs and
+// rarely touched data services
+func diurnalProductionArchetypes() []scalev1.NamespaceArchetype {
+	mix := func(count, updateMin, updateMax int32) *scalev1.ArchetypeResourceMix {
+		return &scalev1.ArchetypeResourceMix{Count: &count, UpdateFrequencyMin: &updateMin, UpdateFrequencyMax: &updateMax}
+	}
+
+	return []scalev1.NamespaceArchetype{
+		{
+			Name:       "web",
+			Weight:     5,
+			ConfigMaps: mix(6, 300, 1200),
+			Secrets:    mix(4, 600, 3600),
+			Routes:     mix(2, 600, 3600),
+			Pods:       mix(6, 120, 600),
+		},
+		{
+			Name:         "batch",
+			Weight:       2,
+			ConfigMaps:   mix(3, 120, 600),
+			BuildConfigs: mix(2, 300, 1200),
+			Pods:         mix(10, 60, 300),
+		},
+		{
+			Name:       "data",
+			Weight:     1,
+			ConfigMaps: mix(2, 1800, 7200),
+			Secrets:    mix(6, 1800, 7200),
+			Pods:       mix(3, 1800, 7200),
+		},
+	}
+}
+
+// diurnalMultiplier is the churn multiplier for the hour of day in UTC, between 0.4 at 03:00 and
+// 1.6 at 15:00. It changes once an hour so the resolved spec, and the churn workers, stay stable within the hour
+func diurnalMultiplier(now time.Time) float64 {
+	hour := float64(now.UTC().Hour())
+	multiplier := 1 + 0.6*math.Sin(2*math.Pi*(hour-9)/24)
+	return math.Round(multiplier*10) / 10
+}
@@ -0,0 +1,180 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+const (
+	// schedulingLabelWaveAnnotation records the config and last wave that flipped a node's
+	// scheduling labels, as <config>/<wave>
+	schedulingLabelWaveAnnotation = "scale.openshift.io/scheduling-label-wave"
+	// schedulingLabelOriginalAnnotation holds the values the churned labels had before the first
+	// wave, as JSON with null for labels the node did not have, so they can be restored
+	schedulingLabelOriginalAnnotation = "scale.openshift.io/scheduling-labels-original"
+)
+
+// schedulingLabels returns the configured labels, or the node-role and pool labels when none are set
+func schedulingLabels(config *scalev1.ScaleLoadConfig) []scalev1.SchedulingLabel {
+	if len(config.Spec.SchedulingLabelChurn.Labels) > 0 {
+		return config.Spec.SchedulingLabelChurn.Labels
+	}
+	return []scalev1.SchedulingLabel{
+		{Key: "node-role.kubernetes.io/infra"},
+		{Key: "scale.openshift.io/pool", Values: []string{"pool-a", "pool-b"}},
+	}
+}
+
+// schedulingLabelWave returns the number of the latest wave at the given time, or 0 before the first
+func schedulingLabelWave(config *scalev1.ScaleLoadConfig, now time.Time) int64 {
+	interval := int64(config.Spec.SchedulingLabelChurn.IntervalSeconds)
+	if interval <= 0 {
+		interval = 900
+	}
+	return int64(now.Sub(config.CreationTimestamp.Time)/time.Second) / interval
+}
+
+// inSchedulingLabelWave reports whether a node is flipped in a wave; each wave picks a different share of the nodes
+func inSchedulingLabelWave(nodeName string, wave int64, percent int32) bool {
+	return nodeNameHash(fmt.Sprintf("%s/%d", nodeName, wave))%100 < uint64(percent)
+}
+
+// manageSchedulingLabelChurn flips the scheduling labels of the nodes picked for the current wave,
+// once per node and wave. While churn is disabled it restores the labels of nodes flipped before
+func (r *ScaleLoadConfigReconciler) manageSchedulingLabelChurn(ctx context.Context, config *scalev1.ScaleLoadConfig, nodes []corev1.Node) error {
+	log := r.Log.WithName("scheduling-label-churn")
+	churn := config.Spec.SchedulingLabelChurn
+
+	if !churn.Enabled {
+		restored := 0
+		for i := range nodes {
+			node := &nodes[i]
+			// Nodes flipped by another config sharing them are left to that config
+			if !strings.HasPrefix(node.Annotations[schedulingLabelWaveAnnotation], config.Name+"/") {
+				continue
+			}
+			patch := client.MergeFrom(node.DeepCopy())
+			if !restoreSchedulingLabels(node) {
+				continue
+			}
+			if err := r.Patch(ctx, node, patch); err != nil {
+				return fmt.Errorf("failed to restore scheduling labels on node %s: %w", node.Name, err)
+			}
+			r.recordAPICall(config, 1)
+			restored++
+		}
+		if restored > 0 {
+			log.Info("Restored scheduling labels", "nodes", restored)
+		}
+		return nil
+	}
+
+	wave := schedulingLabelWave(config, time.Now())
+	if wave == 0 {
+		return nil
+	}
+	percent := churn.NodePercent
+	if percent <= 0 {
+		percent = 10
+	}
+	labels := schedulingLabels(config)
+	waveValue := fmt.Sprintf("%s/%d", config.Name, wave)
+
+	flipped := 0
+	for i := range nodes {
+		node := &nodes[i]
+		if node.Annotations[schedulingLabelWaveAnnotation] == waveValue || !inSchedulingLabelWave(node.Name, wave, percent) {
+			continue
+		}
+		patch := client.MergeFrom(node.DeepCopy())
+		flipSchedulingLabels(node, labels)
+		node.Annotations[schedulingLabelWaveAnnotation] = waveValue
+		if err := r.Patch(ctx, node, patch); err != nil {
+			return fmt.Errorf("failed to flip scheduling labels on node %s: %w", node.Name, err)
+		}
+		r.recordAPICall(config, 1)
+		flipped++
+	}
+	if flipped > 0 {
+		log.Info("Flipped scheduling labels", "wave", wave, "nodes", flipped)
+	}
+	return nil
+}
+
+// flipSchedulingLabels moves each label to its next value, or toggles it when it has no values,
+// recording the value a label had before it was first flipped
+func flipSchedulingLabels(node *corev1.Node, labels []scalev1.SchedulingLabel) {
+	if node.Labels == nil {
+		node.Labels = make(map[string]string)
+	}
+	if node.Annotations == nil {
+		node.Annotations = make(map[string]string)
+	}
+	original := make(map[string]*string)
+	if data, ok := node.Annotations[schedulingLabelOriginalAnnotation]; ok {
+		_ = json.Unmarshal([]byte(data), &original)
+	}
+
+	for _, label := range labels {
+		value, present := node.Labels[label.Key]
+		if _, recorded := original[label.Key]; !recorded {
+			original[label.Key] = nil
+			if present {
+				original[label.Key] = &value
+			}
+		}
+
+		if len(label.Values) == 0 {
+			if present {
+				delete(node.Labels, label.Key)
+			} else {
+				node.Labels[label.Key] = ""
+			}
+			continue
+		}
+		next := label.Values[0]
+		for j, candidate := range label.Values {
+			if present && candidate == value {
+				next = label.Values[(j+1)%len(label.Values)]
+				break
+			}
+		}
+		node.Labels[label.Key] = next
+	}
+
+	data, _ := json.Marshal(original)
+	node.Annotations[schedulingLabelOriginalAnnotation] = string(data)
+}
+
+// restoreSchedulingLabels puts back the labels a node had before scheduling label churn and drops
+// the churn's annotations. It reports whether the node had been flipped
+func restoreSchedulingLabels(node *corev1.Node) bool {
+	data, ok := node.Annotations[schedulingLabelOriginalAnnotation]
+	if !ok {
+		return false
+	}
+	var original map[string]*string
+	if err := json.Unmarshal([]byte(data), &original); err == nil {
+		for key, value := range original {
+			if value == nil {
+				delete(node.Labels, key)
+				continue
+			}
+			if node.Labels == nil {
+				node.Labels = make(map[string]string)
+			}
+			node.Labels[key] = *value
+		}
+	}
+	delete(node.Annotations, schedulingLabelOriginalAnnotation)
+	delete(node.Annotations, schedulingLabelWaveAnnotation)
+	return true
+}
@@ -0,0 +1,153 @@
+package controllers
+
+import (
+	"context"
+	"runtime"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+const (
+	// selfMonitorInterval is the time between samples of the operator's own resource use
+	selfMonitorInterval = 5 * time.Minute
+
+	// selfMonitorWindow is the number of samples growth is judged over, one hour
+	selfMonitorWindow = 12
+
+	// selfGrowthMinimum is the least absolute growth over the window that counts as a leak, so small
+	// series do not trip on noise
+	selfGrowthMinimum = 20
+)
+
+// selfMonitor keeps recent samples of the operator's own goroutines, tracking maps and informer caches,
+// so soak tests can tell the operator leaking apart from the cluster under test degrading
+type selfMonitor struct {
+	lastSample time.Time
+	samples    map[string][]int
+	suspected  map[string]bool
+}
+
+// monitorSelf samples the operator's resource use once per interval and warns about series that grew
+// on every sample over the window. It runs in the reconcile, which is single threaded, so the maps
+// the reconcile owns can be read without locks
+func (r *ScaleLoadConfigReconciler) monitorSelf(ctx context.Context) {
+	now := time.Now()
+	if r.selfMonitor == nil {
+		r.selfMonitor = &selfMonitor{samples: make(map[string][]int), suspected: make(map[string]bool)}
+	}
+	monitor := r.selfMonitor
+	if !monitor.lastSample.IsZero() && now.Sub(monitor.lastSample) < selfMonitorInterval {
+		return
+	}
+	monitor.lastSample = now
+	log := r.Log.WithName("self-monitor")
+
+	sizes := map[string]int{
+		"goroutines":            runtime.NumGoroutine(),
+		"map/resourceManagers":  len(r.resourceManagers),
+		"map/missingNodesSince": len(r.missingNodesSince),
+		"map/permissionResults": len(r.permissionResults),
+		"map/lastStatusWrite":   len(r.lastStatusWrite),
+		"map/scaleDownPending":  len(r.scaleDownPendingSince),
+		"map/zoneOutages":       len(r.activeZoneOutages),
+		"map/lastAlertPost":     len(r.lastAlertPost),
+	}
+	r.resourceTiming.mu.Lock()
+	sizes["map/resourceOperationTimes"] = len(r.resourceTiming.lastOperation)
+	sizes["map/resourcePhaseIntervals"] = len(r.resourceTiming.phaseIntervals)
+	r.resourceTiming.mu.Unlock()
+	r.remoteClustersMutex.Lock()
+	sizes["map/remoteClusters"] = len(r.remoteClusters)
+	r.remoteClustersMutex.Unlock()
+	r.leaseMutex.Lock()
+	sizes["map/leaseSimulators"] = len(r.leaseSimulators)
+	r.leaseMutex.Unlock()
+	r.janitorMutex.Lock()
+	sizes["map/janitors"] = len(r.janitors)
+	r.janitorMutex.Unlock()
+	r.dataProviderMutex.Lock()
+	sizes["map/dataProviders"] = len(r.dataProviders)
+	r.dataProviderMutex.Unlock()
+	r.churnMutex.Lock()
+	engine := r.churnEngine
+	r.churnMutex.Unlock()
+	if engine != nil {
+		engine.mu.Lock()
+		sizes["map/churnWorkers"] = len(engine.workers)
+		engine.mu.Unlock()
+	}
+
+	// Only kinds the controller watches, so counting never starts a new informer
+	namespaces := &corev1.NamespaceList{}
+	for kind, list := range map[string]client.ObjectList{
+		"namespace":       namespaces,
+		"node":            &corev1.NodeList{},
+		"scaleloadconfig": &scalev1.ScaleLoadConfigList{},
+	} {
+		if err := r.List(ctx, list, client.UnsafeDisableDeepCopy); err != nil {
+			log.V(1).Info("Failed to count cached objects", "kind", kind, "error", err.Error())
+			continue
+		}
+		sizes["cache/"+kind] = meta.LenList(list)
+	}
+
+	// Entries for namespaces that are gone will never be removed by the delete paths
+	existing := make(map[string]bool, len(namespaces.Items))
+	for _, ns := range namespaces.Items {
+		existing[ns.Name] = true
+	}
+	var stale int
+	for name := range r.resourceManagers {
+		if !existing[name] {
+			stale++
+		}
+	}
+	if stale > 0 && len(namespaces.Items) > 0 {
+		log.Info("Tracking entries for namespaces that no longer exist", "map", "resourceManagers", "stale", stale)
+	}
+
+	for series, size := range sizes {
+		samples := append(monitor.samples[series], size)
+		if len(samples) > selfMonitorWindow {
+			samples = samples[len(samples)-selfMonitorWindow:]
+		}
+		monitor.samples[series] = samples
+
+		if r.SelfUsage != nil {
+			r.SelfUsage.WithLabelValues(series).Set(float64(size))
+		}
+		growing := steadyGrowth(samples, selfMonitorWindow)
+		if growing && !monitor.suspected[series] {
+			log.Info("Unbounded growth suspected in the operator", "series", series,
+				"from", samples[0], "to", size, "window", (selfMonitorWindow * selfMonitorInterval).String())
+		}
+		monitor.suspected[series] = growing
+		if r.SelfGrowthSuspected != nil {
+			value := 0.0
+			if growing {
+				value = 1
+			}
+			r.SelfGrowthSuspected.WithLabelValues(series).Set(value)
+		}
+	}
+}
+
+// steadyGrowth reports whether a full window of samples never went down and grew by at least a
+// tenth and by selfGrowthMinimum overall
+func steadyGrowth(samples []int, window int) bool {
+	if len(samples) < window {
+		return false
+	}
+	for i := 1; i < len(samples); i++ {
+		if samples[i] < samples[i-1] {
+			return false
+		}
+	}
+	first, last := samples[0], samples[len(samples)-1]
+	return last-first >= selfGrowthMinimum && last-first >= first/10
+}
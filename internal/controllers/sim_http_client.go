@@ -0,0 +1,27 @@
+package controllers
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"os"
+	"time"
+)
+
+// serviceCAPath is where OpenShift mounts the service CA bundle into the operator's pod
+const serviceCAPath = "/var/run/secrets/kubernetes.io/serviceaccount/service-ca.crt"
+
+// newSimHTTPClient builds the client for the operator's outbound calls to Alertmanager, Prometheus
+// and notification webhooks, trusting the cluster service CA when it is mounted
+func newSimHTTPClient() *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if caData, err := os.ReadFile(serviceCAPath); err == nil {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		pool.AppendCertsFromPEM(caData)
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	}
+	return &http.Client{Transport: transport, Timeout: 30 * time.Second}
+}
@@ -0,0 +1,144 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+const (
+	// maxRetryBackoff is the longest the workqueue waits before retrying a failed or requeued reconcile
+	maxRetryBackoff = 1000 * time.Second
+	// stallCheckInterval is how often the watchdog looks for stalled configs
+	stallCheckInterval = 30 * time.Second
+)
+
+// errReconcileStalled stops the manager when ExitOnStall is set, so the pod is restarted
+var errReconcileStalled = errors.New("reconcile stalled")
+
+// reconcileExpectation is when a config's latest reconcile completed and how soon the next one was due
+type reconcileExpectation struct {
+	completed time.Time
+	interval  time.Duration
+	// reported marks a stall that was already reported
+	reported bool
+}
+
+// recordReconcileCompleted notes when the config's next reconcile is due. Failed and requeued reconciles
+// back off, so the longest backoff is allowed for; configs with nothing due are no longer watched
+func (r *ScaleLoadConfigReconciler) recordReconcileCompleted(configName string, result ctrl.Result, err error, now time.Time) {
+	interval := result.RequeueAfter
+	if err != nil || (interval == 0 && result.Requeue) {
+		interval = maxRetryBackoff
+	}
+
+	r.stallMutex.Lock()
+	defer r.stallMutex.Unlock()
+	if previous := r.reconcileExpectations[configName]; previous != nil && previous.reported {
+		r.Log.WithName("stall-watchdog").Info("Reconcile completed again after a stall", "config", configName,
+			"stalledFor", now.Sub(previous.completed).Round(time.Second).String())
+	}
+	if r.ReconcileStalled != nil {
+		r.ReconcileStalled.DeleteLabelValues(configName)
+	}
+	if interval <= 0 {
+		delete(r.reconcileExpectations, configName)
+		return
+	}
+	if r.reconcileExpectations == nil {
+		r.reconcileExpectations = make(map[string]*reconcileExpectation)
+	}
+	r.reconcileExpectations[configName] = &reconcileExpectation{completed: now, interval: interval}
+}
+
+// watchForStalls checks every stallCheckInterval for configs whose next reconcile is overdue; it runs
+// until ctx is done, or stops the manager on a stall with ExitOnStall
+func (r *ScaleLoadConfigReconciler) watchForStalls(ctx context.Context) error {
+	ticker := time.NewTicker(stallCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if stalled := r.checkStalls(ctx, time.Now()); stalled && r.ExitOnStall {
+				return errReconcileStalled
+			}
+		}
+	}
+}
+
+// checkStalls reports configs without a completed reconcile for StallIntervals times their interval,
+// and for no less than the reconcile timeout, since a single cycle may take that long. It reports
+// whether any config is stalled
+func (r *ScaleLoadConfigReconciler) checkStalls(ctx context.Context, now time.Time) bool {
+	log := r.Log.WithName("stall-watchdog")
+
+	type stall struct {
+		configName string
+		since      time.Duration
+		threshold  time.Duration
+	}
+	var stalls []stall
+	stalled := false
+	r.stallMutex.Lock()
+	for configName, expectation := range r.reconcileExpectations {
+		threshold := max(time.Duration(r.StallIntervals)*expectation.interval, reconcileTimeout)
+		since := now.Sub(expectation.completed)
+		if since <= threshold {
+			continue
+		}
+		stalled = true
+		if !expectation.reported {
+			expectation.reported = true
+			stalls = append(stalls, stall{configName: configName, since: since, threshold: threshold})
+		}
+		if r.ReconcileStalled != nil {
+			r.ReconcileStalled.WithLabelValues(configName).Set(1)
+		}
+	}
+	r.stallMutex.Unlock()
+
+	// The condition is written outside the lock, as the client may be what hangs
+	for _, s := range stalls {
+		log.Error(errReconcileStalled, "No reconcile completed within the expected interval", "config", s.configName,
+			"since", s.since.Round(time.Second).String(), "threshold", s.threshold.String())
+		if err := r.reportStall(ctx, s.configName, s.since); err != nil {
+			log.Error(err, "Failed to report stall", "config", s.configName)
+		}
+	}
+	return stalled
+}
+
+// reportStall sets the Stalled condition on the config. The next status update rebuilds the conditions,
+// which clears it once reconciles complete again
+func (r *ScaleLoadConfigReconciler) reportStall(ctx context.Context, configName string, since time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+
+	latestConfig := &scalev1.ScaleLoadConfig{}
+	if err := r.Get(ctx, types.NamespacedName{Name: configName}, latestConfig); err != nil {
+		return fmt.Errorf("failed to fetch latest ScaleLoadConfig: %w", err)
+	}
+	original := latestConfig.DeepCopy()
+	meta.SetStatusCondition(&latestConfig.Status.Conditions, metav1.Condition{
+		Type:               "Stalled",
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: latestConfig.Generation,
+		Reason:             "NoReconcileCompleted",
+		Message:            fmt.Sprintf("No reconcile has completed for %s", since.Round(time.Second)),
+	})
+	if err := r.Status().Patch(ctx, latestConfig, client.MergeFrom(original)); err != nil {
+		return fmt.Errorf("failed to patch ScaleLoadConfig status: %w", err)
+	}
+	return nil
+}
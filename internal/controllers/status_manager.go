@@ -3,11 +3,14 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -17,7 +20,9 @@ import (
 	scalev1 "github.com/jtaleric/sim-operator/api/v1"
 )
 
-// updateStatus updates the ScaleLoadConfig status with current state
+// updateStatus updates the ScaleLoadConfig status with current state. In the default OnChange mode the
+// status is only written when it changed or the resync interval passed, so the operator's own writes
+// stay out of the generated load; the write is a merge patch, so it never conflicts with spec edits
 func (r *ScaleLoadConfigReconciler) updateStatus(ctx context.Context, config *scalev1.ScaleLoadConfig,
 	kwokNodeCount int, namespaceCount int, resourceCounts map[string]int) (ctrl.Result, error) {
 
@@ -29,6 +34,7 @@ func (r *ScaleLoadConfigReconciler) updateStatus(ctx context.Context, config *sc
 		log.Error(err, "Failed to fetch latest ScaleLoadConfig for status update")
 		return ctrl.Result{}, err
 	}
+	original := latestConfig.DeepCopy()
 
 	// Calculate metrics
 	metrics := r.calculateMetrics(latestConfig, kwokNodeCount, namespaceCount, resourceCounts)
@@ -36,30 +42,22 @@ func (r *ScaleLoadConfigReconciler) updateStatus(ctx context.Context, config *sc
 	// Update status on the latest version
 	latestConfig.Status.ObservedGeneration = latestConfig.Generation
 	latestConfig.Status.KwokNodeCount = int32(kwokNodeCount)
+	latestConfig.Status.NodeVerification = r.nodeVerification(config.Name)
 	latestConfig.Status.GeneratedNamespaces = int32(namespaceCount)
 	latestConfig.Status.LastReconcileTime = &metav1.Time{Time: time.Now()}
 	latestConfig.Status.Metrics = metrics
 
 	// Update resource counts (minimal logging)
-	latestConfig.Status.TotalResources = scalev1.ResourceCounts{
-		ConfigMaps:   int32(resourceCounts["configMaps"]),
-		Secrets:      int32(resourceCounts["secrets"]),
-		Routes:       int32(resourceCounts["routes"]),
-		ImageStreams: int32(resourceCounts["imageStreams"]),
-		BuildConfigs: int32(resourceCounts["buildConfigs"]),
-		Events:       int32(resourceCounts["events"]),
-		Pods:         int32(resourceCounts["pods"]),
-		Namespaces:   int32(namespaceCount),
-	}
-
-	// Only log status updates every 10 reconciles to reduce spam
-	r.statusLogCounter++
-	if r.statusLogCounter%10 == 0 {
-		log.Info("Status updated",
-			"pods", latestConfig.Status.TotalResources.Pods,
-			"configMaps", latestConfig.Status.TotalResources.ConfigMaps,
-			"totalResources", getTotalResourceCount(resourceCounts))
-	}
+	latestConfig.Status.TotalResources = resourceCountsFromMap(resourceCounts, namespaceCount)
+	// Targets and the effective profile come from the in-memory config, which holds the resolved spec
+	latestConfig.Status.EffectiveProfile = r.effectiveProfile(config, kwokNodeCount)
+	latestConfig.Status.Targets = r.calculateLoadTargets(config, kwokNodeCount, namespaceCount, resourceCounts, metrics)
+	latestConfig.Status.Clusters = r.clusterStatuses[config.Name]
+	latestConfig.Status.EtcdPressure = r.etcdPressureStatus(latestConfig)
+	latestConfig.Status.Events = r.eventRateStatus(config, kwokNodeCount)
+	latestConfig.Status.PodDensity = r.podDensityStatus(config)
+	latestConfig.Status.Convergence = r.estimateConvergence(config, r.convergenceStatus(config.Name),
+		latestConfig.Status.Targets, latestConfig.Status.TotalResources, kwokNodeCount, time.Now())
 
 	// Update conditions
 	latestConfig.Status.Conditions = r.updateConditions(latestConfig, kwokNodeCount)
@@ -71,59 +69,42 @@ func (r *ScaleLoadConfigReconciler) updateStatus(ctx context.Context, config *sc
 
 	// Update Prometheus metrics
 	r.updatePrometheusMetrics(latestConfig)
+	r.RunMetrics.record(latestConfig, time.Now())
+	if err := r.publishSummary(ctx, latestConfig); err != nil {
+		log.Error(err, "Failed to publish summary")
+	}
 
-	// Retry status update with exponential backoff for conflict resolution
-	maxRetries := 3
-	baseDelay := 100 * time.Millisecond
+	now := time.Now()
+	if !r.statusWriteDue(config, &original.Status, &latestConfig.Status, now) {
+		log.V(2).Info("Status unchanged, skipping write")
+		return ctrl.Result{}, nil
+	}
 
-	for attempt := 0; attempt < maxRetries; attempt++ {
-		if err := r.Status().Update(ctx, latestConfig); err != nil {
-			if attempt == maxRetries-1 {
-				log.Error(err, "Failed to update ScaleLoadConfig status after retries", "attempts", maxRetries)
-				return ctrl.Result{RequeueAfter: 5 * time.Second}, err
-			}
+	// Drained only when status is written, so bytes written between skipped updates still add up
+	var written map[string]int64
+	if r.writeMeter != nil {
+		written = r.writeMeter.drain(config.Name)
+	}
+	applyWriteVolume(latestConfig, written)
 
-			// Check if it's a conflict error
-			if strings.Contains(err.Error(), "the object has been modified") {
-				// Wait with exponential backoff
-				retryDelay := time.Duration(attempt+1) * baseDelay
-				log.V(1).Info("Status update conflict, retrying", "attempt", attempt+1, "delay", retryDelay)
-				time.Sleep(retryDelay)
-
-				// Refetch the latest version before retry
-				if err := r.Get(ctx, types.NamespacedName{Name: config.Name, Namespace: config.Namespace}, latestConfig); err != nil {
-					log.Error(err, "Failed to refetch ScaleLoadConfig for retry")
-					return ctrl.Result{}, err
-				}
+	// Only log status updates every 10 reconciles to reduce spam
+	r.statusLogCounter++
+	if r.statusLogCounter%10 == 0 {
+		log.Info("Status updated",
+			"pods", latestConfig.Status.TotalResources.Pods,
+			"configMaps", latestConfig.Status.TotalResources.ConfigMaps,
+			"totalResources", getTotalResourceCount(resourceCounts))
+	}
 
-				// Recalculate metrics and update status fields
-				metrics := r.calculateMetrics(latestConfig, kwokNodeCount, namespaceCount, resourceCounts)
-				latestConfig.Status.ObservedGeneration = latestConfig.Generation
-				latestConfig.Status.KwokNodeCount = int32(kwokNodeCount)
-				latestConfig.Status.GeneratedNamespaces = int32(namespaceCount)
-				latestConfig.Status.LastReconcileTime = &metav1.Time{Time: time.Now()}
-				latestConfig.Status.Metrics = metrics
-				latestConfig.Status.TotalResources = scalev1.ResourceCounts{
-					ConfigMaps:   int32(resourceCounts["configMaps"]),
-					Secrets:      int32(resourceCounts["secrets"]),
-					Routes:       int32(resourceCounts["routes"]),
-					ImageStreams: int32(resourceCounts["imageStreams"]),
-					BuildConfigs: int32(resourceCounts["buildConfigs"]),
-					Events:       int32(resourceCounts["events"]),
-					Pods:         int32(resourceCounts["pods"]),
-					Namespaces:   int32(namespaceCount),
-				}
-				latestConfig.Status.Conditions = r.updateConditions(latestConfig, kwokNodeCount)
-				continue
-			} else {
-				// Non-conflict error, fail immediately
-				log.Error(err, "Failed to update ScaleLoadConfig status (non-conflict)")
-				return ctrl.Result{}, err
-			}
-		}
-		// Success - break out of retry loop
-		break
+	if err := r.Status().Patch(ctx, latestConfig, client.MergeFrom(original)); err != nil {
+		log.Error(err, "Failed to patch ScaleLoadConfig status")
+		return ctrl.Result{RequeueAfter: 5 * time.Second}, err
+	}
+	if r.lastStatusWrite == nil {
+		r.lastStatusWrite = make(map[string]time.Time)
 	}
+	r.lastStatusWrite[config.Name] = now
+	r.notifyTransitions(ctx, latestConfig, &original.Status, &latestConfig.Status)
 
 	log.V(1).Info("Updated ScaleLoadConfig status",
 		"kwokNodes", kwokNodeCount,
@@ -133,6 +114,160 @@ func (r *ScaleLoadConfigReconciler) updateStatus(ctx context.Context, config *sc
 	return ctrl.Result{}, nil
 }
 
+// acknowledgeSpec records the config's generation with an Accepted condition, and marks it not yet
+// Converged, as soon as the spec is resolved. Clients such as CI pipelines can then wait for a spec
+// change to be accepted separately from waiting for the load to converge. resolveErr rejects the spec
+func (r *ScaleLoadConfigReconciler) acknowledgeSpec(ctx context.Context, config *scalev1.ScaleLoadConfig, resolveErr error) error {
+	if config.Status.ObservedGeneration == config.Generation && resolveErr == nil {
+		return nil
+	}
+
+	latestConfig := &scalev1.ScaleLoadConfig{}
+	if err := r.Get(ctx, types.NamespacedName{Name: config.Name, Namespace: config.Namespace}, latestConfig); err != nil {
+		return fmt.Errorf("failed to fetch latest ScaleLoadConfig: %w", err)
+	}
+	original := latestConfig.DeepCopy()
+
+	latestConfig.Status.ObservedGeneration = latestConfig.Generation
+	meta.SetStatusCondition(&latestConfig.Status.Conditions, acceptedCondition(latestConfig.Generation, resolveErr))
+	meta.SetStatusCondition(&latestConfig.Status.Conditions, metav1.Condition{
+		Type:               "Converged",
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: latestConfig.Generation,
+		Reason:             "Reconciling",
+		Message:            fmt.Sprintf("Generation %d has not been applied yet", latestConfig.Generation),
+	})
+	if equality.Semantic.DeepEqual(original.Status, latestConfig.Status) {
+		return nil
+	}
+
+	if err := r.Status().Patch(ctx, latestConfig, client.MergeFrom(original)); err != nil {
+		return fmt.Errorf("failed to patch ScaleLoadConfig status: %w", err)
+	}
+	config.Status.ObservedGeneration = latestConfig.Generation
+	r.Log.WithName("status-manager").V(1).Info("Acknowledged spec", "config", config.Name,
+		"generation", latestConfig.Generation, "accepted", resolveErr == nil)
+	if resolveErr == nil {
+		r.notify(ctx, latestConfig, scalev1.NotificationStarted, "SpecAccepted",
+			fmt.Sprintf("Generation %d accepted", latestConfig.Generation))
+	}
+	return nil
+}
+
+// acceptedCondition reports whether the given generation of the spec could be resolved
+func acceptedCondition(generation int64, resolveErr error) metav1.Condition {
+	if resolveErr != nil {
+		return metav1.Condition{
+			Type:               "Accepted",
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: generation,
+			Reason:             "SpecNotResolved",
+			Message:            resolveErr.Error(),
+		}
+	}
+	return metav1.Condition{
+		Type:               "Accepted",
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: generation,
+		Reason:             "SpecAccepted",
+		Message:            fmt.Sprintf("Generation %d accepted", generation),
+	}
+}
+
+// convergedCondition reports whether every achieved count is within the convergence tolerance of its
+// target for the current generation. Counts above the target, e.g. while scaling down, do not converge either
+func convergedCondition(config *scalev1.ScaleLoadConfig, now metav1.Time) metav1.Condition {
+	condition := metav1.Condition{
+		Type:               "Converged",
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: config.Generation,
+		LastTransitionTime: now,
+		Reason:             "TargetsMet",
+		Message:            "Generated load meets the targets",
+	}
+
+	if !config.Spec.Enabled {
+		condition.Reason = "LoadGenerationDisabled"
+		condition.Message = "Load generation is disabled"
+		return condition
+	}
+
+	tolerancePercent := convergenceTolerancePercent(config)
+	for _, pair := range targetPairs(config.Status.Targets.Resources, config.Status.TotalResources) {
+		target, achieved := pair[0], pair[1]
+		tolerance := int32(math.Ceil(float64(target) * float64(tolerancePercent) / 100))
+		switch {
+		case achieved < target-tolerance:
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = "BelowTargets"
+			condition.Message = fmt.Sprintf("%s%% of the target resources generated", config.Status.Targets.AchievedPercent) +
+				expectedConvergence(config)
+			return condition
+		case achieved > target+tolerance:
+			condition.Status = metav1.ConditionFalse
+			condition.Reason = "AboveTargets"
+			condition.Message = "More resources exist than the targets, scaling down" + expectedConvergence(config)
+			return condition
+		}
+	}
+	return condition
+}
+
+// expectedConvergence words the estimated completion time for a condition message, or is empty without one
+func expectedConvergence(config *scalev1.ScaleLoadConfig) string {
+	if convergence := config.Status.Convergence; convergence != nil && convergence.EstimatedCompletionTime != nil {
+		return fmt.Sprintf(", expected to converge by %s", convergence.EstimatedCompletionTime.UTC().Format(time.RFC3339))
+	}
+	return ""
+}
+
+// statusWriteDue reports whether the new status has to be written: always in EveryReconcile mode,
+// and in OnChange mode when it differs from the stored status or the resync interval has passed
+func (r *ScaleLoadConfigReconciler) statusWriteDue(config *scalev1.ScaleLoadConfig, stored, updated *scalev1.ScaleLoadConfigStatus, now time.Time) bool {
+	statusUpdates := config.Spec.StatusUpdates
+	if statusUpdates.Mode == scalev1.StatusUpdateEveryReconcile {
+		return true
+	}
+
+	resync := time.Duration(statusUpdates.ResyncMinutes) * time.Minute
+	if resync <= 0 {
+		resync = 5 * time.Minute
+	}
+	last, written := r.lastStatusWrite[config.Name]
+	if !written || now.Sub(last) >= resync {
+		return true
+	}
+	return !equality.Semantic.DeepEqual(stableStatus(stored), stableStatus(updated))
+}
+
+// stableStatus returns a copy of the status without the fields that change on every reconcile:
+// timestamps, rate estimates and cumulative counters. Those are refreshed on resync instead
+func stableStatus(status *scalev1.ScaleLoadConfigStatus) *scalev1.ScaleLoadConfigStatus {
+	stable := status.DeepCopy()
+	stable.LastReconcileTime = nil
+	stable.Metrics = scalev1.LoadGenerationMetrics{}
+	stable.Targets.AchievedPercent = ""
+	stable.Targets.APIRateAchievedPercent = ""
+	stable.EtcdPressure = nil
+	if stable.Convergence != nil {
+		stable.Convergence.LastYieldTime = nil
+		stable.Convergence.EstimatedCompletionTime = nil
+	}
+	stable.Events = nil
+	stable.WriteVolume = scalev1.WriteVolume{}
+	stable.DeletionStatus.LastDeletionBatch = nil
+	for i := range stable.Clusters {
+		stable.Clusters[i].LastSyncTime = nil
+	}
+	// Condition messages can carry rates, so only the type, status and reason count as a change
+	for i := range stable.Conditions {
+		stable.Conditions[i].LastTransitionTime = metav1.Time{}
+		stable.Conditions[i].Message = ""
+		stable.Conditions[i].ObservedGeneration = 0
+	}
+	return stable
+}
+
 // calculateMetrics computes current performance metrics
 func (r *ScaleLoadConfigReconciler) calculateMetrics(config *scalev1.ScaleLoadConfig,
 	kwokNodeCount int, namespaceCount int, resourceCounts map[string]int) scalev1.LoadGenerationMetrics {
@@ -187,8 +322,9 @@ func (r *ScaleLoadConfigReconciler) calculateMetrics(config *scalev1.ScaleLoadCo
 	}
 
 	// Use actual tracker data if available and higher
-	if r.apiCallsThisMinute > 0 {
-		actualFromTracker := float64(r.apiCallsThisMinute)
+	callsThisMinute, _, _ := r.apiCalls.window(time.Now())
+	if callsThisMinute > 0 {
+		actualFromTracker := float64(callsThisMinute)
 		if actualFromTracker > actualAPICallsPerMinute {
 			actualAPICallsPerMinute = actualFromTracker
 		}
@@ -200,7 +336,7 @@ func (r *ScaleLoadConfigReconciler) calculateMetrics(config *scalev1.ScaleLoadCo
 		"estimatedCallsPerReconcile", estimatedAPICallsPerReconcile,
 		"reconcileIntervalMinutes", reconcileIntervalMinutes,
 		"finalAPICallsPerMinute", actualAPICallsPerMinute,
-		"trackerThisMinute", r.apiCallsThisMinute)
+		"trackerThisMinute", callsThisMinute)
 
 	// Calculate resource operation rates based on actual counts
 	minutesSinceReconcile := timeSinceLastReconcile.Minutes()
@@ -259,7 +395,7 @@ func (r *ScaleLoadConfigReconciler) updateConditions(config *scalev1.ScaleLoadCo
 		readyCondition.Message = "No KWOK nodes found matching selector"
 	}
 
-	conditions = append(conditions, readyCondition)
+	conditions = append(conditions, acceptedCondition(config.Generation, nil), readyCondition)
 
 	// Scaling condition
 	scalingCondition := metav1.Condition{
@@ -276,7 +412,10 @@ func (r *ScaleLoadConfigReconciler) updateConditions(config *scalev1.ScaleLoadCo
 		scalingCondition.Message = "No scaling activities due to zero KWOK nodes"
 	}
 
-	conditions = append(conditions, scalingCondition)
+	conditions = append(conditions, scalingCondition, convergedCondition(config, now))
+	if gatedCondition, ok := r.gatedCondition(config, now); ok {
+		conditions = append(conditions, gatedCondition)
+	}
 
 	// Degraded condition (check for issues)
 	degradedCondition := metav1.Condition{
@@ -287,22 +426,64 @@ func (r *ScaleLoadConfigReconciler) updateConditions(config *scalev1.ScaleLoadCo
 		Message:            "Load generation is operating normally",
 	}
 
-	// Check for potential issues
+	// Check for potential issues; the most severe sets the reason so alerts can route on it
+	var signals []degradedSignal
 	if errorRate, err := strconv.ParseFloat(config.Status.Metrics.ErrorRate, 64); err == nil && errorRate > 10.0 {
+		signals = append(signals, degradedSignal{reason: "HighErrorRate", message: fmt.Sprintf("High error rate: %.1f%%", errorRate)})
+	}
+	signals = append(signals, r.degradedSignals(config.Name)...)
+	if len(signals) > 0 {
+		messages := make([]string, 0, len(signals))
+		for _, signal := range signals {
+			messages = append(messages, signal.message)
+		}
 		degradedCondition.Status = metav1.ConditionTrue
-		degradedCondition.Reason = "HighErrorRate"
-		degradedCondition.Message = fmt.Sprintf("High error rate: %.1f%%", errorRate)
+		degradedCondition.Reason = signals[0].reason
+		degradedCondition.Message = strings.Join(messages, "; ")
 	}
 
 	conditions = append(conditions, degradedCondition)
 
+	// PermissionsRestricted condition reports features disabled by missing RBAC
+	if denied := r.deniedFeatures(config.Name); len(denied) > 0 {
+		conditions = append(conditions, metav1.Condition{
+			Type:               "PermissionsRestricted",
+			Status:             metav1.ConditionTrue,
+			LastTransitionTime: now,
+			Reason:             "InsufficientPermissions",
+			Message:            fmt.Sprintf("Disabled due to missing RBAC permissions: %s", strings.Join(denied, ", ")),
+		})
+	}
+
+	// ZoneOutage condition reports an injected zone failure in progress
+	if zone := r.activeZoneOutages[config.Name]; zone != "" {
+		conditions = append(conditions, metav1.Condition{
+			Type:               "ZoneOutage",
+			Status:             metav1.ConditionTrue,
+			LastTransitionTime: now,
+			Reason:             "ZoneOutageInjected",
+			Message:            fmt.Sprintf("KWOK nodes in zone %s are tainted unreachable", zone),
+		})
+	}
+
+	// ScopeRestricted condition reports features auto-disabled by Namespaced mode
+	if disabled := scopeDisabledFeatures(config); len(disabled) > 0 {
+		conditions = append(conditions, metav1.Condition{
+			Type:               "ScopeRestricted",
+			Status:             metav1.ConditionTrue,
+			LastTransitionTime: now,
+			Reason:             "NamespaceScopedMode",
+			Message:            fmt.Sprintf("Disabled in Namespaced mode: %s", strings.Join(disabled, ", ")),
+		})
+	}
+
 	return conditions
 }
 
 // updatePrometheusMetrics updates the Prometheus metrics
 func (r *ScaleLoadConfigReconciler) updatePrometheusMetrics(config *scalev1.ScaleLoadConfig) {
-	r.KwokNodeCount.Set(float64(config.Status.KwokNodeCount))
-	r.GeneratedNamespaces.Set(float64(config.Status.GeneratedNamespaces))
+	r.KwokNodeCount.WithLabelValues(config.Name).Set(float64(config.Status.KwokNodeCount))
+	r.GeneratedNamespaces.WithLabelValues(config.Name).Set(float64(config.Status.GeneratedNamespaces))
 }
 
 // handleDeletion cleans up resources when ScaleLoadConfig is deleted
@@ -319,6 +500,44 @@ func (r *ScaleLoadConfigReconciler) handleDeletion(ctx context.Context, namespac
 	for ns := range r.resourceManagers {
 		delete(r.resourceManagers, ns)
 	}
+	delete(r.permissionResults, namespacedName.Name)
+	delete(r.scaleDownPendingSince, namespacedName.Name)
+	delete(r.convergence, namespacedName.Name)
+	r.RunMetrics.forget(namespacedName.Name)
+	r.KwokNodeCount.DeleteLabelValues(namespacedName.Name)
+	r.GeneratedNamespaces.DeleteLabelValues(namespacedName.Name)
+	delete(r.lastNodeEventTime, namespacedName.Name)
+	delete(r.lastAlertPost, namespacedName.Name)
+	delete(r.lastStatusWrite, namespacedName.Name)
+	delete(r.lastSummaryWrite, namespacedName.Name)
+	delete(r.gateStates, namespacedName.Name)
+	delete(r.warmedUp, namespacedName.Name)
+	r.eventRateMutex.Lock()
+	delete(r.eventRates, namespacedName.Name)
+	r.eventRateMutex.Unlock()
+	delete(r.activeZoneOutages, namespacedName.Name)
+	delete(r.podDensity, namespacedName.Name)
+	r.stopChurnWorkers(namespacedName.Name)
+	r.healthMutex.Lock()
+	delete(r.health, namespacedName.Name)
+	r.healthMutex.Unlock()
+	r.etcdPressureMutex.Lock()
+	delete(r.etcdPressure, namespacedName.Name)
+	r.etcdPressureMutex.Unlock()
+	if r.writeMeter != nil {
+		r.writeMeter.drain(namespacedName.Name)
+	}
+	if r.labelStamper != nil {
+		r.labelStamper.forget(namespacedName.Name)
+	}
+	if r.namespaceGuard != nil {
+		r.namespaceGuard.forget(namespacedName.Name)
+	}
+	for key := range r.missingNodesSince {
+		if strings.HasPrefix(key, namespacedName.Name+"/") {
+			delete(r.missingNodesSince, key)
+		}
+	}
 
 	log.Info("Cleaned up resources for deleted ScaleLoadConfig")
 	return ctrl.Result{}, nil
@@ -330,35 +549,117 @@ func (r *ScaleLoadConfigReconciler) handleConfigDeletion(ctx context.Context,
 
 	log := r.Log.WithName("config-deletion").WithValues("config", config.Name)
 
-	// Perform cleanup
+	// Stop background churn so workers do not recreate objects while they are being removed
+	r.stopChurnWorkers(config.Name)
+	r.stopControllerLeases(config.Name)
+	r.stopJanitor(config.Name)
+
 	if config.Spec.CleanupConfig.Enabled {
-		if err := r.cleanupManagedNamespaces(ctx, config.Name); err != nil {
-			log.Error(err, "Failed to cleanup managed namespaces during deletion")
+		r.paceCleanup(config)
+		err := r.removeGeneratedLoad(ctx, config)
+		if err == nil || isCleanupInProgress(err) {
+			if progressErr := r.recordCleanupProgress(ctx, config, err == nil); progressErr != nil {
+				log.Error(progressErr, "Failed to record cleanup progress")
+			}
+		}
+		if isCleanupInProgress(err) {
+			log.Info("Cleanup continues on the next pass")
+			return ctrl.Result{RequeueAfter: yieldRequeueDelay}, nil
+		}
+		if err != nil {
+			log.Error(err, "Failed to remove generated load during deletion")
 			return ctrl.Result{RequeueAfter: 30 * time.Second}, err
 		}
-
-		// Wait for cleanup delay if configured
-		if config.Spec.CleanupConfig.CleanupDelaySeconds > 0 {
-			delay := time.Duration(config.Spec.CleanupConfig.CleanupDelaySeconds) * time.Second
-			if time.Since(config.DeletionTimestamp.Time) < delay {
-				log.Info("Waiting for cleanup delay", "remaining", delay-time.Since(config.DeletionTimestamp.Time))
+		// Verification only reports, so a failed pass does not hold up deletion
+		if config.Spec.CleanupConfig.VerifyCleanup {
+			done, err := r.verifyCleanup(ctx, config)
+			if err != nil {
+				log.Error(err, "Failed to verify cleanup")
+			} else if !done {
 				return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
 			}
 		}
 	}
+	delete(r.clusterStatuses, config.Name)
+	r.forgetDataProviders(config.Name)
+	// A config recreated under the same name must write its first status straight away
+	delete(r.lastStatusWrite, config.Name)
+	// The summary is not load, so it does not hold up deletion; the orphan sweeper removes leftovers
+	if err := r.deleteSummary(ctx, config); err != nil {
+		log.Error(err, "Failed to delete summary during deletion")
+	}
+
+	// Wait for cleanup delay if configured
+	if config.Spec.CleanupConfig.Enabled && !isNamespaceScoped(config) && config.Spec.CleanupConfig.CleanupDelaySeconds > 0 {
+		delay := time.Duration(config.Spec.CleanupConfig.CleanupDelaySeconds) * time.Second
+		if time.Since(config.DeletionTimestamp.Time) < delay {
+			log.Info("Waiting for cleanup delay", "remaining", delay-time.Since(config.DeletionTimestamp.Time))
+			return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+		}
+	}
 
-	// Remove finalizer
+	// Remove finalizer from the latest config, since cleanup progress was written to its status
+	latestConfig := &scalev1.ScaleLoadConfig{}
+	if err := r.Get(ctx, types.NamespacedName{Name: config.Name, Namespace: config.Namespace}, latestConfig); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	config = latestConfig
 	controllerutil.RemoveFinalizer(config, "scale.openshift.io/cleanup")
 	if err := r.Update(ctx, config); err != nil {
 		return ctrl.Result{}, err
 	}
 
 	log.Info("ScaleLoadConfig deletion completed")
+	r.notify(ctx, config, scalev1.NotificationCompleted, "ConfigDeleted", "The config was deleted")
 	return ctrl.Result{}, nil
 }
 
+// removeGeneratedLoad deletes everything a config generated, in target clusters first and then
+// locally, so the cluster is left as it was before the config was created
+func (r *ScaleLoadConfigReconciler) removeGeneratedLoad(ctx context.Context, config *scalev1.ScaleLoadConfig) error {
+	if len(config.Spec.TargetClusters) > 0 {
+		if err := r.cleanupTargetClusters(ctx, config); err != nil {
+			return fmt.Errorf("failed to cleanup target clusters: %w", err)
+		}
+	}
+
+	// Leases live outside the generated namespaces
+	if _, err := r.cleanupControllerLeases(ctx, config); err != nil {
+		return fmt.Errorf("failed to cleanup controller leases: %w", err)
+	}
+
+	// Namespaces are not owned by the operator in Namespaced mode, only the objects inside them
+	if isNamespaceScoped(config) {
+		return r.cleanupScopedResources(ctx, config)
+	}
+
+	if err := r.cleanupACMObjects(ctx, config); err != nil {
+		return fmt.Errorf("failed to cleanup ACM objects: %w", err)
+	}
+	if err := r.removeManagedNamespaces(ctx, config); err != nil {
+		return fmt.Errorf("failed to cleanup managed namespaces: %w", err)
+	}
+	if _, err := r.cleanupMirrorPods(ctx, config); err != nil {
+		return fmt.Errorf("failed to cleanup mirror pods: %w", err)
+	}
+	if err := r.clearZoneOutage(ctx, config); err != nil {
+		return fmt.Errorf("failed to recover zone outage nodes: %w", err)
+	}
+	shared, err := r.sharedNodeSelectors(ctx, config, nil)
+	if err != nil {
+		return err
+	}
+	if err := r.removeNodeChurnArtifacts(ctx, config, shared); err != nil {
+		return fmt.Errorf("failed to remove churn annotations from nodes: %w", err)
+	}
+	if err := r.cleanupManagedNodes(ctx, config); err != nil {
+		return fmt.Errorf("failed to cleanup managed KWOK nodes: %w", err)
+	}
+	return nil
+}
+
 // cleanupManagedNamespaces removes all namespaces managed by a specific config
-func (r *ScaleLoadConfigReconciler) cleanupManagedNamespaces(ctx context.Context, configName string) error {
+func (r *ScaleLoadConfigReconciler) cleanupManagedNamespaces(ctx context.Context, configName string, opts ...client.DeleteOption) error {
 	log := r.Log.WithName("namespace-cleanup")
 
 	namespaceList := &corev1.NamespaceList{}
@@ -371,7 +672,7 @@ func (r *ScaleLoadConfigReconciler) cleanupManagedNamespaces(ctx context.Context
 	}
 
 	for _, ns := range namespaceList.Items {
-		if err := r.Delete(ctx, &ns); err != nil {
+		if err := r.Delete(ctx, &ns, opts...); err != nil {
 			log.Error(err, "Failed to delete managed namespace", "namespace", ns.Name)
 			continue
 		}
@@ -392,3 +693,201 @@ func getTotalResourceCount(resourceCounts map[string]int) int {
 	}
 	return total
 }
+
+// targetedNamespaceCount counts the generated indices 0..namespaces-1 selected by an interval and
+// an index range. Selectors match labels only known once namespaces exist, so they are not counted
+func targetedNamespaceCount(namespaces int, interval int32, targeting *scalev1.NamespaceTargeting) int {
+	step := max(int(interval), 1)
+	first, last := 0, namespaces-1
+	if targeting != nil && targeting.IndexRange != nil {
+		first = max(first, int(targeting.IndexRange.From))
+		last = min(last, int(targeting.IndexRange.To))
+	}
+	if last < first {
+		return 0
+	}
+	// Multiples of step within [first, last]
+	return last/step - (first+step-1)/step + 1
+}
+
+// indexTargeted reports whether the generated namespace at index is selected by an interval and an index range
+func indexTargeted(index int, interval int32, targeting *scalev1.NamespaceTargeting) bool {
+	if interval > 1 && index%int(interval) != 0 {
+		return false
+	}
+	if targeting != nil && targeting.IndexRange != nil {
+		return index >= int(targeting.IndexRange.From) && index <= int(targeting.IndexRange.To)
+	}
+	return true
+}
+
+// calculateLoadTargets computes what the spec asks for at the current node count so status can
+// show how far the achieved counts and API rate are from it
+func (r *ScaleLoadConfigReconciler) calculateLoadTargets(config *scalev1.ScaleLoadConfig, kwokNodeCount int,
+	namespaceCount int, resourceCounts map[string]int, metrics scalev1.LoadGenerationMetrics) scalev1.LoadTargets {
+
+	targetNamespaces := r.calculateTargetNamespaces(config, kwokNodeCount)
+	if isNamespaceScoped(config) {
+		// Namespaced mode loads the selected namespaces instead of creating new ones
+		targetNamespaces = namespaceCount
+	}
+	namespaceChurn := config.Spec.ResourceChurn.Namespaces
+	if len(config.Spec.Pools) == 0 && namespaceChurn.Enabled && namespaceChurn.Maximum > 0 && targetNamespaces > int(namespaceChurn.Maximum) {
+		targetNamespaces = int(namespaceChurn.Maximum)
+	}
+	if kwokNodeCount == 0 {
+		targetNamespaces = 0
+	}
+
+	var resources scalev1.ResourceCounts
+	if len(config.Spec.Pools) == 0 {
+		resources = namespaceResourceTargets(config, targetNamespaces)
+	} else if targetNamespaces > 0 {
+		// Each pool indexes its namespaces from 0 and fills them with its own churn
+		for i := range config.Spec.Pools {
+			pool := &config.Spec.Pools[i]
+			addResourceCounts(&resources, namespaceResourceTargets(config.WithPoolChurn(pool), int(pool.Namespaces)))
+		}
+	}
+	resources.Namespaces = int32(targetNamespaces)
+	if config.Spec.ControllerLeases.Enabled {
+		// Controller Leases do not scale with namespaces
+		resources.ControllerLeases = config.Spec.ControllerLeases.Controllers
+	}
+	if config.Spec.MirrorPods.Enabled && !isNamespaceScoped(config) {
+		// Mirror pods scale with nodes rather than namespaces
+		resources.MirrorPods = int32(kwokNodeCount * len(mirrorPodComponents(config)))
+	}
+
+	effectiveRate, _ := r.getEffectiveAPIRate(config, kwokNodeCount)
+	targets := scalev1.LoadTargets{
+		Namespaces:        int32(targetNamespaces),
+		Resources:         resources,
+		APICallsPerMinute: effectiveRate,
+	}
+
+	// Overshooting one type does not make up for falling behind on another
+	achieved := resourceCountsFromMap(resourceCounts, namespaceCount)
+	var wanted, reached int32
+	for _, pair := range targetPairs(resources, achieved) {
+		wanted += pair[0]
+		if pair[1] < pair[0] {
+			reached += pair[1]
+		} else {
+			reached += pair[0]
+		}
+	}
+	if wanted > 0 {
+		targets.AchievedPercent = strconv.FormatFloat(float64(reached)*100/float64(wanted), 'f', 1, 64)
+	}
+	if rate, err := strconv.ParseFloat(metrics.APICallsPerMinute, 64); err == nil && effectiveRate > 0 {
+		targets.APIRateAchievedPercent = strconv.FormatFloat(rate*100/float64(effectiveRate), 'f', 1, 64)
+	}
+	return targets
+}
+
+// namespaceResourceTargets computes the target count of each resource type generated inside
+// targetNamespaces namespaces of the config
+func namespaceResourceTargets(config *scalev1.ScaleLoadConfig, targetNamespaces int) scalev1.ResourceCounts {
+	// Each archetype's churn settings, for placing counts on the generated indices
+	archetypeChurn := make(map[string]scalev1.ResourceChurnConfig)
+	for i := range config.Spec.NamespaceArchetypes {
+		churn := config.Spec.ResourceChurn
+		config.Spec.NamespaceArchetypes[i].ApplyTo(&churn)
+		archetypeChurn[config.Spec.NamespaceArchetypes[i].Name] = churn
+	}
+
+	// Generated namespaces use indices 0..n-1, so every interval-th one carries a resource type.
+	// With archetypes, each selected index contributes its own archetype's count
+	type countOf func(churn scalev1.ResourceChurnConfig) int32
+	perType := func(enabled bool, maximum, interval int32, targeting *scalev1.NamespaceTargeting, count countOf) int32 {
+		if !enabled || targetNamespaces == 0 {
+			return 0
+		}
+		var total int32
+		switch {
+		case isNamespaceScoped(config):
+			total = int32(targetNamespaces) * count(config.Spec.ResourceChurn)
+		case len(archetypeChurn) == 0:
+			total = int32(targetedNamespaceCount(targetNamespaces, interval, targeting)) * count(config.Spec.ResourceChurn)
+		default:
+			for index := 0; index < targetNamespaces; index++ {
+				if !indexTargeted(index, interval, targeting) {
+					continue
+				}
+				churn, ok := archetypeChurn[archetypeForIndex(config, index)]
+				if !ok {
+					churn = config.Spec.ResourceChurn
+				}
+				total += count(churn)
+			}
+		}
+		if maximum > 0 && total > maximum {
+			total = maximum
+		}
+		return total
+	}
+
+	churn := config.Spec.ResourceChurn
+	etcd := config.Spec.EtcdPressure
+	return scalev1.ResourceCounts{
+		ConfigMaps: perType(churn.ConfigMaps.Enabled, churn.ConfigMaps.Maximum, churn.ConfigMaps.NamespaceInterval, churn.ConfigMaps.NamespaceTargeting,
+			func(c scalev1.ResourceChurnConfig) int32 { return c.ConfigMaps.Count }),
+		Secrets: perType(churn.Secrets.Enabled, churn.Secrets.Maximum, churn.Secrets.NamespaceInterval, churn.Secrets.NamespaceTargeting,
+			func(c scalev1.ResourceChurnConfig) int32 { return c.Secrets.Count }),
+		Routes: perType(churn.Routes.Enabled, churn.Routes.Maximum, churn.Routes.NamespaceInterval, churn.Routes.NamespaceTargeting,
+			func(c scalev1.ResourceChurnConfig) int32 { return c.Routes.Count }),
+		ImageStreams: perType(churn.ImageStreams.Enabled, churn.ImageStreams.Maximum, churn.ImageStreams.NamespaceInterval, churn.ImageStreams.NamespaceTargeting,
+			func(c scalev1.ResourceChurnConfig) int32 { return c.ImageStreams.Count }),
+		BuildConfigs: perType(churn.BuildConfigs.Enabled, churn.BuildConfigs.Maximum, churn.BuildConfigs.NamespaceInterval, churn.BuildConfigs.NamespaceTargeting,
+			func(c scalev1.ResourceChurnConfig) int32 { return c.BuildConfigs.Count }),
+		Pods: perType(churn.Pods.Enabled, churn.Pods.Maximum, churn.Pods.NamespaceInterval, churn.Pods.NamespaceTargeting,
+			func(c scalev1.ResourceChurnConfig) int32 { return c.Pods.Count }),
+		AppBundles: perType(churn.AppBundles.Enabled, 0, churn.AppBundles.NamespaceInterval, churn.AppBundles.NamespaceTargeting,
+			func(c scalev1.ResourceChurnConfig) int32 { return c.AppBundles.Count }),
+		DaemonSets: perType(churn.DaemonSets.Enabled, 0, churn.DaemonSets.NamespaceInterval, churn.DaemonSets.NamespaceTargeting,
+			func(c scalev1.ResourceChurnConfig) int32 { return c.DaemonSets.Count }),
+		CompletedPods: perType(churn.CompletedPods.Enabled, 0, churn.CompletedPods.NamespaceInterval, churn.CompletedPods.NamespaceTargeting,
+			func(c scalev1.ResourceChurnConfig) int32 { return c.CompletedPods.Count }),
+		// Every selected namespace gets the default-deny and allow-same-namespace pair
+		NetworkPolicies: perType(churn.NetworkPolicies.Enabled, 0, churn.NetworkPolicies.NamespaceInterval, churn.NetworkPolicies.NamespaceTargeting,
+			func(scalev1.ResourceChurnConfig) int32 { return 2 }),
+		EtcdPressure: perType(etcd.Enabled, 0, etcd.NamespaceInterval, nil,
+			func(scalev1.ResourceChurnConfig) int32 { return etcd.ObjectsPerNamespace }),
+	}
+}
+
+// addResourceCounts adds the counts of the types generated inside namespaces to total
+func addResourceCounts(total *scalev1.ResourceCounts, counts scalev1.ResourceCounts) {
+	total.ConfigMaps += counts.ConfigMaps
+	total.Secrets += counts.Secrets
+	total.Routes += counts.Routes
+	total.ImageStreams += counts.ImageStreams
+	total.BuildConfigs += counts.BuildConfigs
+	total.Pods += counts.Pods
+	total.AppBundles += counts.AppBundles
+	total.DaemonSets += counts.DaemonSets
+	total.CompletedPods += counts.CompletedPods
+	total.NetworkPolicies += counts.NetworkPolicies
+	total.EtcdPressure += counts.EtcdPressure
+}
+
+// targetPairs pairs each targeted count with its achieved count
+func targetPairs(resources, achieved scalev1.ResourceCounts) [][2]int32 {
+	return [][2]int32{
+		{resources.Namespaces, achieved.Namespaces},
+		{resources.ConfigMaps, achieved.ConfigMaps},
+		{resources.Secrets, achieved.Secrets},
+		{resources.Routes, achieved.Routes},
+		{resources.ImageStreams, achieved.ImageStreams},
+		{resources.BuildConfigs, achieved.BuildConfigs},
+		{resources.Pods, achieved.Pods},
+		{resources.AppBundles, achieved.AppBundles},
+		{resources.DaemonSets, achieved.DaemonSets},
+		{resources.CompletedPods, achieved.CompletedPods},
+		{resources.MirrorPods, achieved.MirrorPods},
+		{resources.NetworkPolicies, achieved.NetworkPolicies},
+		{resources.EtcdPressure, achieved.EtcdPressure},
+		{resources.ControllerLeases, achieved.ControllerLeases},
+	}
+}
@@ -0,0 +1,167 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Subsystems that are not resource churners; each churner is its own subsystem, see churnerSubsystem
+const (
+	subsystemAnnotationChurn = "annotation-churn"
+	subsystemEventGen        = "event-gen"
+)
+
+type subsystemKey struct{}
+
+// withSubsystem tags ctx with the subsystem whose identity its writes are sent under
+func withSubsystem(ctx context.Context, subsystem string) context.Context {
+	return context.WithValue(ctx, subsystemKey{}, subsystem)
+}
+
+// subsystemFrom returns the subsystem ctx was tagged with, or "" for the operator's own traffic
+func subsystemFrom(ctx context.Context) string {
+	subsystem, _ := ctx.Value(subsystemKey{}).(string)
+	return subsystem
+}
+
+// churnerSubsystem names a churner's subsystem after the singular of its type, e.g. configmap-churn
+func churnerSubsystem(churner ResourceChurner) string {
+	name := strings.ToLower(churner.Name())
+	if strings.HasSuffix(name, "ies") {
+		name = strings.TrimSuffix(name, "ies") + "y"
+	} else {
+		name = strings.TrimSuffix(name, "s")
+	}
+	return name + "-churn"
+}
+
+// Subsystems lists the simulated sources that get their own identity: annotation churn, event
+// generation and every registered resource churner
+func Subsystems() []string {
+	subsystems := []string{subsystemAnnotationChurn, subsystemEventGen}
+	for _, churner := range resourceChurners {
+		subsystems = append(subsystems, churnerSubsystem(churner))
+	}
+	return subsystems
+}
+
+// NewSubsystemClients builds a client per subsystem sending the user agent <prefix>/<subsystem> and
+// writing as the field manager <prefix>-<subsystem>, so audit logs and apiserver metrics of a run can
+// be broken down by simulated source. The clients are only used for writes; reads keep using the cache
+func NewSubsystemClients(mgr ctrl.Manager, prefix string) (map[string]client.Client, error) {
+	clients := make(map[string]client.Client)
+	for _, subsystem := range Subsystems() {
+		config := rest.CopyConfig(mgr.GetConfig())
+		config.UserAgent = fmt.Sprintf("%s/%s", prefix, subsystem)
+		c, err := client.New(config, client.Options{Scheme: mgr.GetScheme(), Mapper: mgr.GetRESTMapper()})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create client for subsystem %s: %w", subsystem, err)
+		}
+		clients[subsystem] = &fieldOwnerClient{Client: c, owner: fmt.Sprintf("%s-%s", prefix, subsystem)}
+	}
+	return clients, nil
+}
+
+// fieldOwnerClient writes as a fixed field manager. Callers passing their own FieldOwner, like the
+// server-side apply and managed fields simulations, still win since their options are applied last
+type fieldOwnerClient struct {
+	client.Client
+	owner string
+}
+
+func (c *fieldOwnerClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	return c.Client.Create(ctx, obj, append([]client.CreateOption{client.FieldOwner(c.owner)}, opts...)...)
+}
+
+func (c *fieldOwnerClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	return c.Client.Update(ctx, obj, append([]client.UpdateOption{client.FieldOwner(c.owner)}, opts...)...)
+}
+
+func (c *fieldOwnerClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	return c.Client.Patch(ctx, obj, patch, append([]client.PatchOption{client.FieldOwner(c.owner)}, opts...)...)
+}
+
+func (c *fieldOwnerClient) Status() client.SubResourceWriter {
+	return &fieldOwnerStatusWriter{SubResourceWriter: c.Client.Status(), owner: c.owner}
+}
+
+type fieldOwnerStatusWriter struct {
+	client.SubResourceWriter
+	owner string
+}
+
+func (w *fieldOwnerStatusWriter) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	return w.SubResourceWriter.Update(ctx, obj, append([]client.SubResourceUpdateOption{client.FieldOwner(w.owner)}, opts...)...)
+}
+
+func (w *fieldOwnerStatusWriter) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+	return w.SubResourceWriter.Patch(ctx, obj, patch, append([]client.SubResourcePatchOption{client.FieldOwner(w.owner)}, opts...)...)
+}
+
+// subsystemClient sends writes made under a tagged context through that subsystem's client.
+// Reads and untagged writes go through the wrapped client
+type subsystemClient struct {
+	client.Client
+	subsystems map[string]client.Client
+}
+
+// withSubsystemIdentities wraps a client so tagged writes use the identity of their subsystem
+func withSubsystemIdentities(c client.Client, subsystems map[string]client.Client) client.Client {
+	return &subsystemClient{Client: c, subsystems: subsystems}
+}
+
+// writer returns the client for the subsystem ctx is tagged with
+func (c *subsystemClient) writer(ctx context.Context) client.Client {
+	if w, ok := c.subsystems[subsystemFrom(ctx)]; ok {
+		return w
+	}
+	return c.Client
+}
+
+func (c *subsystemClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	return c.writer(ctx).Create(ctx, obj, opts...)
+}
+
+func (c *subsystemClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	return c.writer(ctx).Update(ctx, obj, opts...)
+}
+
+func (c *subsystemClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	return c.writer(ctx).Patch(ctx, obj, patch, opts...)
+}
+
+func (c *subsystemClient) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	return c.writer(ctx).Delete(ctx, obj, opts...)
+}
+
+func (c *subsystemClient) DeleteAllOf(ctx context.Context, obj client.Object, opts ...client.DeleteAllOfOption) error {
+	return c.writer(ctx).DeleteAllOf(ctx, obj, opts...)
+}
+
+func (c *subsystemClient) Status() client.SubResourceWriter {
+	return &subsystemStatusWriter{client: c}
+}
+
+// subsystemStatusWriter picks the status writer per call, since Status takes no context
+type subsystemStatusWriter struct {
+	client *subsystemClient
+}
+
+func (w *subsystemStatusWriter) Create(ctx context.Context, obj client.Object, subResource client.Object,
+	opts ...client.SubResourceCreateOption) error {
+	return w.client.writer(ctx).Status().Create(ctx, obj, subResource, opts...)
+}
+
+func (w *subsystemStatusWriter) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	return w.client.writer(ctx).Status().Update(ctx, obj, opts...)
+}
+
+func (w *subsystemStatusWriter) Patch(ctx context.Context, obj client.Object, patch client.Patch,
+	opts ...client.SubResourcePatchOption) error {
+	return w.client.writer(ctx).Status().Patch(ctx, obj, patch, opts...)
+}
@@ -0,0 +1,234 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+const (
+	// serviceAccountNamespacePath holds the operator's namespace when it runs in a pod
+	serviceAccountNamespacePath = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+
+	// summaryResourceType labels the summary ConfigMaps, which are not generated load
+	summaryResourceType = "summary"
+)
+
+// loadSummary is the JSON form of a config's summary
+type loadSummary struct {
+	Config     string                        `json:"config"`
+	Generation int64                         `json:"generation"`
+	UpdatedAt  time.Time                     `json:"updatedAt"`
+	KwokNodes  int32                         `json:"kwokNodes"`
+	Achieved   scalev1.ResourceCounts        `json:"achieved"`
+	Targets    scalev1.LoadTargets           `json:"targets"`
+	Metrics    scalev1.LoadGenerationMetrics `json:"metrics"`
+	Events     *scalev1.EventRateStatus      `json:"events,omitempty"`
+	Conditions []summaryCondition            `json:"conditions"`
+}
+
+// summaryCondition is a status condition without its timestamps
+type summaryCondition struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason"`
+	Message string `json:"message,omitempty"`
+}
+
+// summaryConfigMapName returns the name of a config's summary ConfigMap
+func summaryConfigMapName(configName string) string {
+	return "sim-summary-" + configName
+}
+
+// summaryNamespace returns the namespace for summary ConfigMaps, falling back to the one the operator runs in
+func (r *ScaleLoadConfigReconciler) summaryNamespace() string {
+	if r.SummaryNamespace != "" {
+		return r.SummaryNamespace
+	}
+	return r.operatorNamespace()
+}
+
+// operatorNamespace returns the namespace the operator runs in, or "" when it cannot be determined
+func (r *ScaleLoadConfigReconciler) operatorNamespace() string {
+	if r.OperatorNamespace != "" {
+		return r.OperatorNamespace
+	}
+	if namespace, err := os.ReadFile(serviceAccountNamespacePath); err == nil {
+		return strings.TrimSpace(string(namespace))
+	}
+	return ""
+}
+
+// publishSummary writes the config's current status to its summary ConfigMap as markdown and JSON,
+// at most once per summary interval
+func (r *ScaleLoadConfigReconciler) publishSummary(ctx context.Context, config *scalev1.ScaleLoadConfig) error {
+	if !config.Spec.Summary.Enabled {
+		return nil
+	}
+	namespace := r.summaryNamespace()
+	if namespace == "" {
+		return fmt.Errorf("summary namespace is unknown outside a pod, set --summary-namespace")
+	}
+
+	interval := time.Duration(config.Spec.Summary.IntervalSeconds) * time.Second
+	if interval <= 0 {
+		interval = 60 * time.Second
+	}
+	now := time.Now()
+	if last, ok := r.lastSummaryWrite[config.Name]; ok && now.Sub(last) < interval {
+		return nil
+	}
+
+	summary := newLoadSummary(config, now)
+	summaryJSON, err := json.MarshalIndent(summary, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal summary: %w", err)
+	}
+	data := map[string]string{
+		"summary.md":   summary.markdown(),
+		"summary.json": string(summaryJSON),
+	}
+
+	configMap := &corev1.ConfigMap{}
+	err = r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: summaryConfigMapName(config.Name)}, configMap)
+	r.recordAPICall(config, 1)
+	switch {
+	case errors.IsNotFound(err):
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      summaryConfigMapName(config.Name),
+				Namespace: namespace,
+				Labels: map[string]string{
+					"scale.openshift.io/managed-by":    config.Name,
+					"scale.openshift.io/resource-type": summaryResourceType,
+					"scale.openshift.io/created-by":    "sim-operator",
+				},
+			},
+			Data: data,
+		}
+		if err := r.createOrAdopt(ctx, config, configMap); err != nil {
+			return fmt.Errorf("failed to create summary ConfigMap: %w", err)
+		}
+	case err != nil:
+		return fmt.Errorf("failed to get summary ConfigMap: %w", err)
+	default:
+		configMap.Data = data
+		if err := r.Update(ctx, configMap); err != nil {
+			return fmt.Errorf("failed to update summary ConfigMap: %w", err)
+		}
+	}
+	r.recordAPICall(config, 1)
+
+	if r.lastSummaryWrite == nil {
+		r.lastSummaryWrite = make(map[string]time.Time)
+	}
+	r.lastSummaryWrite[config.Name] = now
+	return nil
+}
+
+// deleteSummary removes the config's summary ConfigMap
+func (r *ScaleLoadConfigReconciler) deleteSummary(ctx context.Context, config *scalev1.ScaleLoadConfig) error {
+	delete(r.lastSummaryWrite, config.Name)
+	namespace := r.summaryNamespace()
+	if namespace == "" {
+		return nil
+	}
+
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: summaryConfigMapName(config.Name), Namespace: namespace}}
+	if err := r.Delete(ctx, configMap); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete summary ConfigMap: %w", err)
+	}
+	r.recordAPICall(config, 1)
+	return nil
+}
+
+// newLoadSummary collects the parts of the status worth reading at a glance
+func newLoadSummary(config *scalev1.ScaleLoadConfig, now time.Time) loadSummary {
+	status := config.Status
+	summary := loadSummary{
+		Config:     config.Name,
+		Generation: config.Generation,
+		UpdatedAt:  now.UTC().Truncate(time.Second),
+		KwokNodes:  status.KwokNodeCount,
+		Achieved:   status.TotalResources,
+		Targets:    status.Targets,
+		Metrics:    status.Metrics,
+		Events:     status.Events,
+		Conditions: make([]summaryCondition, 0, len(status.Conditions)),
+	}
+	for _, condition := range status.Conditions {
+		summary.Conditions = append(summary.Conditions, summaryCondition{
+			Type:    condition.Type,
+			Status:  string(condition.Status),
+			Reason:  condition.Reason,
+			Message: condition.Message,
+		})
+	}
+	return summary
+}
+
+// markdown renders the summary for chat and the console, leaving out resource types with nothing to do
+func (s loadSummary) markdown() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# sim-operator: %s\n\n", s.Config)
+	fmt.Fprintf(&b, "Updated %s, generation %d, %d KWOK nodes\n\n", s.UpdatedAt.Format(time.RFC3339), s.Generation, s.KwokNodes)
+
+	b.WriteString("| Resource | Achieved | Target |\n|---|---|---|\n")
+	rows := []struct {
+		name             string
+		achieved, target int32
+	}{
+		{"Namespaces", s.Achieved.Namespaces, s.Targets.Resources.Namespaces},
+		{"ConfigMaps", s.Achieved.ConfigMaps, s.Targets.Resources.ConfigMaps},
+		{"Secrets", s.Achieved.Secrets, s.Targets.Resources.Secrets},
+		{"Routes", s.Achieved.Routes, s.Targets.Resources.Routes},
+		{"ImageStreams", s.Achieved.ImageStreams, s.Targets.Resources.ImageStreams},
+		{"BuildConfigs", s.Achieved.BuildConfigs, s.Targets.Resources.BuildConfigs},
+		{"Pods", s.Achieved.Pods, s.Targets.Resources.Pods},
+		{"App bundles", s.Achieved.AppBundles, s.Targets.Resources.AppBundles},
+		{"DaemonSets", s.Achieved.DaemonSets, s.Targets.Resources.DaemonSets},
+		{"Completed pods", s.Achieved.CompletedPods, s.Targets.Resources.CompletedPods},
+		{"NetworkPolicies", s.Achieved.NetworkPolicies, s.Targets.Resources.NetworkPolicies},
+		{"Mirror pods", s.Achieved.MirrorPods, s.Targets.Resources.MirrorPods},
+		{"etcd pressure objects", s.Achieved.EtcdPressure, s.Targets.Resources.EtcdPressure},
+		{"Controller leases", s.Achieved.ControllerLeases, s.Targets.Resources.ControllerLeases},
+	}
+	for _, row := range rows {
+		if row.achieved == 0 && row.target == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "| %s | %d | %d |\n", row.name, row.achieved, row.target)
+	}
+	if s.Targets.AchievedPercent != "" {
+		fmt.Fprintf(&b, "\nAchieved %s%% of the targeted resources\n", s.Targets.AchievedPercent)
+	}
+
+	b.WriteString("\n## Rates\n\n")
+	fmt.Fprintf(&b, "- API calls/min: %s (target %d)\n", s.Metrics.APICallsPerMinute, s.Targets.APICallsPerMinute)
+	fmt.Fprintf(&b, "- Created/updated/deleted per min: %s / %s / %s\n",
+		s.Metrics.ResourceCreationRate, s.Metrics.ResourceUpdateRate, s.Metrics.ResourceDeletionRate)
+	fmt.Fprintf(&b, "- Error rate: %s%%\n", s.Metrics.ErrorRate)
+	if s.Events != nil {
+		fmt.Fprintf(&b, "- Events/hour: %d of %d requested\n", s.Events.AchievedPerHour, s.Events.RequestedPerHour)
+	}
+
+	b.WriteString("\n## Conditions\n\n")
+	for _, condition := range s.Conditions {
+		fmt.Fprintf(&b, "- %s: %s (%s)", condition.Type, condition.Status, condition.Reason)
+		if condition.Message != "" {
+			fmt.Fprintf(&b, " - %s", condition.Message)
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
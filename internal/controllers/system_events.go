@@ -0,0 +1,127 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"math"
+	mathrand "math/rand"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+// maxSystemEventsPerReconcile caps system events the same way namespace events are capped per namespace
+const maxSystemEventsPerReconcile = 100
+
+// systemEventType is a node event and the node agent that reports it
+type systemEventType struct {
+	eventType string
+	reason    string
+	message   string
+	component string
+	weight    int
+}
+
+// systemEventTypes mix kubelet node status events with node-problem-detector findings
+var systemEventTypes = []systemEventType{
+	{corev1.EventTypeNormal, "NodeHasSufficientMemory", "Node %s status is now: NodeHasSufficientMemory", "kubelet", 20},
+	{corev1.EventTypeNormal, "NodeHasNoDiskPressure", "Node %s status is now: NodeHasNoDiskPressure", "kubelet", 20},
+	{corev1.EventTypeNormal, "NodeHasSufficientPID", "Node %s status is now: NodeHasSufficientPID", "kubelet", 15},
+	{corev1.EventTypeNormal, "NodeReady", "Node %s status is now: NodeReady", "kubelet", 10},
+	{corev1.EventTypeNormal, "NodeAllocatableEnforced", "Updated Node Allocatable limit across pods on %s", "kubelet", 5},
+	{corev1.EventTypeWarning, "ImageGCFailed", "failed to garbage collect required amount of images on %s", "kubelet", 5},
+	{corev1.EventTypeWarning, "KernelOops", "kernel: BUG: unable to handle kernel NULL pointer dereference on %s", "kernel-monitor", 5},
+	{corev1.EventTypeWarning, "TaskHung", "kernel: INFO: task runc:[2:INIT] blocked for more than 120 seconds on %s", "kernel-monitor", 5},
+	{corev1.EventTypeWarning, "OOMKilling", "Memory cgroup out of memory: Killed process on %s", "kernel-monitor", 10},
+	{corev1.EventTypeWarning, "ReadonlyFilesystem", "Node %s condition ReadonlyFilesystem is now: True", "kernel-monitor", 5},
+}
+
+// manageSystemEvents writes node events to the shared system namespace so that, together with the
+// namespace events written this reconcile, they make up the configured fraction of all events
+func (r *ScaleLoadConfigReconciler) manageSystemEvents(ctx context.Context, config *scalev1.ScaleLoadConfig,
+	kwokNodes []corev1.Node, namespaceEvents int) (int, error) {
+	log := r.Log.WithName("system-event-manager")
+	ctx = withSubsystem(ctx, subsystemEventGen)
+	systemEvents := config.Spec.ResourceChurn.Events.SystemEvents
+	if len(kwokNodes) == 0 || namespaceEvents == 0 {
+		return 0, nil
+	}
+
+	fraction, err := parseFloat(systemEvents.Fraction)
+	if err != nil || fraction <= 0 || fraction >= 1 {
+		return 0, nil
+	}
+	// namespaceEvents make up the remaining 1-fraction of the volume
+	requested := int(math.Round(float64(namespaceEvents) * fraction / (1 - fraction)))
+	count := min(requested, maxSystemEventsPerReconcile)
+
+	created := 0
+	for i := 0; i < count; i++ {
+		node := kwokNodes[mathrand.Intn(len(kwokNodes))]
+		if err := r.createOrAdopt(ctx, config, r.generateSystemEvent(config, node)); err != nil {
+			log.V(2).Info("System event creation failed", "node", node.Name, "error", err.Error())
+			continue
+		}
+		r.recordAPICall(config, 1)
+		created++
+	}
+	r.recordEventPass(config, requested, created, map[string]int{eventDropSystemCap: requested - count, eventDropCreateFailed: count - created})
+	if created < count {
+		return created, fmt.Errorf("created %d of %d system events in %s", created, count, systemEvents.Namespace)
+	}
+	log.V(1).Info("Generated system events", "namespace", systemEvents.Namespace, "count", created)
+	return created, nil
+}
+
+// generateSystemEvent builds a node event reported by the node's kubelet or node-problem-detector
+func (r *ScaleLoadConfigReconciler) generateSystemEvent(config *scalev1.ScaleLoadConfig, node corev1.Node) *corev1.Event {
+	totalWeight := 0
+	for _, eventType := range systemEventTypes {
+		totalWeight += eventType.weight
+	}
+	selected := systemEventTypes[0]
+	slot := mathrand.Intn(totalWeight)
+	for _, eventType := range systemEventTypes {
+		if slot < eventType.weight {
+			selected = eventType
+			break
+		}
+		slot -= eventType.weight
+	}
+
+	namespace := config.Spec.ResourceChurn.Events.SystemEvents.Namespace
+	if namespace == "" {
+		namespace = "default"
+	}
+	firstTimestamp, lastTimestamp, count := eventOccurrences(config.Spec.ResourceChurn.Events, time.Now())
+	return &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			// Node events are named after the node, like the ones the kubelet records
+			Name:      fmt.Sprintf("%s.%x", node.Name, time.Now().UnixNano()+mathrand.Int63n(1000)),
+			Namespace: namespace,
+			Labels: map[string]string{
+				"scale.openshift.io/managed-by":    config.Name,
+				"scale.openshift.io/resource-type": "event",
+				"scale.openshift.io/created-by":    "sim-operator",
+			},
+		},
+		InvolvedObject: corev1.ObjectReference{
+			Kind:       "Node",
+			Name:       node.Name,
+			UID:        node.UID,
+			APIVersion: "v1",
+		},
+		Reason:              selected.reason,
+		Message:             fmt.Sprintf(selected.message, node.Name),
+		Type:                selected.eventType,
+		Source:              corev1.EventSource{Component: selected.component, Host: node.Name},
+		ReportingController: selected.component,
+		ReportingInstance:   node.Name,
+		FirstTimestamp:      metav1.NewTime(firstTimestamp),
+		LastTimestamp:       metav1.NewTime(lastTimestamp),
+		Count:               count,
+	}
+}
@@ -0,0 +1,285 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+// managedResourceType is a namespaced kind the operator creates, with the resource-type label it carries
+type managedResourceType struct {
+	resourceType string
+	newList      func() client.ObjectList
+}
+
+// disabledResourceTypes returns the namespaced kinds outside the churner registry whose feature
+// is turned off in the spec; disabled churners clean up through their own Cleanup
+func disabledResourceTypes(config *scalev1.ScaleLoadConfig) []managedResourceType {
+	var disabled []managedResourceType
+
+	if !config.Spec.AlertSimulation.Enabled {
+		disabled = append(disabled, managedResourceType{"prometheusrule", func() client.ObjectList {
+			list := &unstructured.UnstructuredList{}
+			list.SetGroupVersionKind(prometheusRuleGVK.GroupVersion().WithKind("PrometheusRuleList"))
+			return list
+		}})
+	}
+	if !config.Spec.ArgoCDSimulation.Enabled {
+		disabled = append(disabled, managedResourceType{"application", func() client.ObjectList {
+			list := &unstructured.UnstructuredList{}
+			list.SetGroupVersionKind(applicationGVK.GroupVersion().WithKind("ApplicationList"))
+			return list
+		}})
+	}
+	return disabled
+}
+
+// convergeSpecChange removes objects left behind by features the latest spec turned off.
+// Enabling features, switching profiles or changing counts converge through the normal reconcile,
+// so only disabled features need a teardown; it runs once per spec generation
+func (r *ScaleLoadConfigReconciler) convergeSpecChange(ctx context.Context, config *scalev1.ScaleLoadConfig) error {
+	if config.Generation == config.Status.ObservedGeneration {
+		return nil
+	}
+	log := r.Log.WithName("teardown-manager")
+
+	// Background workers still hold the previous spec; the reconcile restarts them with the new one
+	r.stopChurnWorkers(config.Name)
+
+	var removed int
+	for _, churner := range resourceChurners {
+		if churner.Enabled(config) {
+			continue
+		}
+		count, err := churner.Cleanup(ctx, r, config, "")
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			log.Info("Removed objects of disabled resource type", "resourceType", churner.Name(), "count", count)
+		}
+		removed += count
+	}
+	for _, resource := range disabledResourceTypes(config) {
+		count, err := r.deleteResourceType(ctx, config, resource)
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			log.Info("Removed objects of disabled resource type", "resourceType", resource.resourceType, "count", count)
+		}
+		removed += count
+	}
+
+	// Stop the renewals first so deleted Leases are not recreated
+	if !config.Spec.ControllerLeases.Enabled {
+		count, err := r.cleanupControllerLeases(ctx, config)
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			log.Info("Removed objects of disabled resource type", "resourceType", controllerLeaseResourceType, "count", count)
+		}
+		removed += count
+	}
+
+	drifted, err := r.removeDriftedNamespaces(ctx, config)
+	if err != nil {
+		return err
+	}
+	if drifted > 0 {
+		log.Info("Removed namespaces that lack the namespace prefix", "count", drifted)
+	}
+	removed += drifted
+
+	if !config.Spec.Summary.Enabled {
+		// The summary is not load, so failing to remove it does not hold up the teardown
+		if err := r.deleteSummary(ctx, config); err != nil {
+			log.Error(err, "Failed to delete summary")
+		}
+	}
+
+	// Cluster-scoped features only run outside Namespaced mode
+	if !isNamespaceScoped(config) {
+		if !config.Spec.ACMSimulation.Enabled {
+			if err := r.cleanupACMObjects(ctx, config); err != nil {
+				return fmt.Errorf("failed to remove ACM objects after disabling ACM simulation: %w", err)
+			}
+		}
+		if !config.Spec.MirrorPods.Enabled {
+			count, err := r.cleanupMirrorPods(ctx, config)
+			if err != nil {
+				return fmt.Errorf("failed to remove mirror pods after disabling mirror pods: %w", err)
+			}
+			removed += count
+		}
+		if !config.Spec.NodeManagement.Enabled {
+			if err := r.cleanupManagedNodes(ctx, config); err != nil {
+				return fmt.Errorf("failed to remove KWOK nodes after disabling node management: %w", err)
+			}
+		}
+	}
+
+	if removed > 0 {
+		log.Info("Converged spec change", "generation", config.Generation, "removed", removed)
+	}
+	return nil
+}
+
+// deleteResourceType deletes every object of one resource type created for a config
+func (r *ScaleLoadConfigReconciler) deleteResourceType(ctx context.Context, config *scalev1.ScaleLoadConfig,
+	resource managedResourceType, opts ...client.ListOption) (int, error) {
+
+	list := resource.newList()
+	opts = append(opts, client.MatchingLabels{
+		"scale.openshift.io/managed-by":    config.Name,
+		"scale.openshift.io/resource-type": resource.resourceType,
+	})
+	if err := r.List(ctx, list, opts...); err != nil {
+		// Nothing to remove when the API is not served or the operator was never allowed to create the kind
+		if meta.IsNoMatchError(err) || errors.IsForbidden(err) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to list %s objects for teardown: %w", resource.resourceType, err)
+	}
+	r.recordAPICall(config, 1)
+
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return 0, fmt.Errorf("failed to extract %s objects for teardown: %w", resource.resourceType, err)
+	}
+
+	var deleted int
+	for _, item := range items {
+		obj, ok := item.(client.Object)
+		if !ok {
+			continue
+		}
+		if err := r.Delete(ctx, obj); err != nil && !errors.IsNotFound(err) {
+			return deleted, fmt.Errorf("failed to delete %s %s/%s: %w", resource.resourceType, obj.GetNamespace(), obj.GetName(), err)
+		}
+		r.recordAPICall(config, 1)
+		deleted++
+	}
+	return deleted, nil
+}
+
+// removeDriftedNamespaces deletes managed namespaces whose names lack the config's namespace prefix.
+// The prefix is immutable, but a config edited under a CRD without that rule would otherwise churn its
+// old namespaces next to new ones; the reconcile recreates the deleted load under the new prefix.
+// Namespaced mode only selects namespaces with the prefix, so it never drifts
+func (r *ScaleLoadConfigReconciler) removeDriftedNamespaces(ctx context.Context, config *scalev1.ScaleLoadConfig) (int, error) {
+	if isNamespaceScoped(config) {
+		return 0, nil
+	}
+	prefix := config.Spec.NamespaceConfig.NamespacePrefix
+	if prefix == "" {
+		prefix = "openshift-fake-"
+	}
+
+	namespaces, err := r.getManagedNamespaces(ctx, config)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list namespaces for prefix drift: %w", err)
+	}
+	var deleted int
+	for i := range namespaces {
+		ns := &namespaces[i]
+		if strings.HasPrefix(ns.Name, prefix) || namespaceTerminating(*ns) {
+			continue
+		}
+		if err := r.deleteManagedNamespace(ctx, config, ns); err != nil && !errors.IsNotFound(err) {
+			return deleted, fmt.Errorf("failed to delete namespace %s without prefix %q: %w", ns.Name, prefix, err)
+		}
+		r.recordAPICall(config, 1)
+		deleted++
+	}
+	return deleted, nil
+}
+
+// drainNamespace deletes the config's objects inside a namespace one at a time through the paced
+// client, so the deletes follow the configured rate and are counted like any other write, instead of
+// being left to the namespace controller's unpaced mass deletion. It returns how many it deleted
+func (r *ScaleLoadConfigReconciler) drainNamespace(ctx context.Context, config *scalev1.ScaleLoadConfig, namespace string) (int, error) {
+	var drained int
+	for _, list := range scopedResourceLists() {
+		if err := r.List(ctx, list, client.InNamespace(namespace),
+			client.MatchingLabels{"scale.openshift.io/managed-by": config.Name}); err != nil {
+			if meta.IsNoMatchError(err) || errors.IsForbidden(err) {
+				continue
+			}
+			return drained, fmt.Errorf("failed to list resources to drain from %s: %w", namespace, err)
+		}
+		r.recordAPICall(config, 1)
+
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return drained, fmt.Errorf("failed to extract resources to drain from %s: %w", namespace, err)
+		}
+		for _, item := range items {
+			obj, ok := item.(client.Object)
+			if !ok || !obj.GetDeletionTimestamp().IsZero() {
+				continue
+			}
+			if err := r.Delete(ctx, obj); err != nil && !errors.IsNotFound(err) {
+				return drained, fmt.Errorf("failed to drain %s/%s: %w", namespace, obj.GetName(), err)
+			}
+			r.recordAPICall(config, 1)
+			drained++
+		}
+	}
+	return drained, nil
+}
+
+// deleteManagedNamespace deletes one of the config's namespaces, first draining it when
+// cleanupConfig.drainNamespaces is set
+func (r *ScaleLoadConfigReconciler) deleteManagedNamespace(ctx context.Context, config *scalev1.ScaleLoadConfig, ns *corev1.Namespace) error {
+	if config.Spec.CleanupConfig.DrainNamespaces && !namespaceTerminating(*ns) {
+		startTime := time.Now()
+		drained, err := r.drainNamespace(ctx, config, ns.Name)
+		if err != nil {
+			return err
+		}
+		r.Log.WithName("teardown-manager").V(1).Info("Drained namespace before deleting it",
+			"namespace", ns.Name, "deleted", drained, "duration", time.Since(startTime).String())
+	}
+	return r.Delete(ctx, ns, namespaceDeleteOptions(config))
+}
+
+// removeManagedNamespaces deletes all of the config's namespaces on deletion or disable, draining
+// each first when cleanupConfig.drainNamespaces is set. Unless cleanupConfig.fastTeardown is set, the
+// deletes are paced and a pass stops after cleanupPassBudget with errCleanupInProgress, so the caller
+// reports progress and requeues instead of running into the reconcile timeout
+func (r *ScaleLoadConfigReconciler) removeManagedNamespaces(ctx context.Context, config *scalev1.ScaleLoadConfig) error {
+	namespaces, err := r.getManagedNamespaces(ctx, config)
+	if err != nil {
+		return fmt.Errorf("failed to list managed namespaces: %w", err)
+	}
+
+	fast := config.Spec.CleanupConfig.FastTeardown
+	startTime := time.Now()
+	for i := range namespaces {
+		ns := &namespaces[i]
+		if namespaceTerminating(*ns) {
+			continue
+		}
+		if !fast && time.Since(startTime) >= cleanupPassBudget {
+			return errCleanupInProgress
+		}
+		if err := r.deleteManagedNamespace(ctx, config, ns); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete namespace %s: %w", ns.Name, err)
+		}
+		r.recordAPICall(config, 1)
+		delete(r.resourceManagers, ns.Name)
+		r.forgetResourceTiming(ns.Name)
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+const namespaceZoneLabel = "scale.openshift.io/zone"
+
+// topologyZones returns the configured zones, or three equally weighted zones when none are set
+func topologyZones(config *scalev1.ScaleLoadConfig) []scalev1.ZoneConfig {
+	if len(config.Spec.Topology.Zones) > 0 {
+		return config.Spec.Topology.Zones
+	}
+	return []scalev1.ZoneConfig{
+		{Name: "sim-zone-a", Weight: 1},
+		{Name: "sim-zone-b", Weight: 1},
+		{Name: "sim-zone-c", Weight: 1},
+	}
+}
+
+// topologyRegion returns the configured region label value
+func topologyRegion(config *scalev1.ScaleLoadConfig) string {
+	if config.Spec.Topology.Region != "" {
+		return config.Spec.Topology.Region
+	}
+	return "sim-region-1"
+}
+
+// zoneForPosition maps a position onto the zones by weight, so consecutive positions follow the
+// configured skew exactly; it returns an empty string when every weight is zero
+func zoneForPosition(zones []scalev1.ZoneConfig, position uint64) string {
+	totalWeight := uint64(0)
+	for _, zone := range zones {
+		if zone.Weight > 0 {
+			totalWeight += uint64(zone.Weight)
+		}
+	}
+	if totalWeight == 0 {
+		return ""
+	}
+
+	slot := position % totalWeight
+	for _, zone := range zones {
+		if zone.Weight <= 0 {
+			continue
+		}
+		if slot < uint64(zone.Weight) {
+			return zone.Name
+		}
+		slot -= uint64(zone.Weight)
+	}
+	return ""
+}
+
+// zoneForNode picks a node's zone from its index when the operator created it, or from a hash of its name
+func zoneForNode(config *scalev1.ScaleLoadConfig, node corev1.Node) string {
+	if index := nodeIndex(node); index >= 0 {
+		return zoneForPosition(topologyZones(config), uint64(index))
+	}
+	hash := fnv.New64a()
+	hash.Write([]byte(node.Name))
+	return zoneForPosition(topologyZones(config), hash.Sum64())
+}
+
+// applyNodeTopology labels KWOK nodes that are missing their synthetic zone or region
+func (r *ScaleLoadConfigReconciler) applyNodeTopology(ctx context.Context, config *scalev1.ScaleLoadConfig, nodes []corev1.Node) error {
+	zones := make(map[string]bool)
+	for _, zone := range topologyZones(config) {
+		zones[zone.Name] = true
+	}
+	region := topologyRegion(config)
+
+	for i := range nodes {
+		node := &nodes[i]
+		if zones[node.Labels[corev1.LabelTopologyZone]] && node.Labels[corev1.LabelTopologyRegion] == region {
+			continue
+		}
+		zone := zoneForNode(config, *node)
+		if zone == "" {
+			continue
+		}
+
+		patch := client.MergeFrom(node.DeepCopy())
+		if node.Labels == nil {
+			node.Labels = make(map[string]string)
+		}
+		node.Labels[corev1.LabelTopologyZone] = zone
+		node.Labels[corev1.LabelTopologyRegion] = region
+		if err := r.Patch(ctx, node, patch); err != nil {
+			return fmt.Errorf("failed to label node %s with zone %s: %w", node.Name, zone, err)
+		}
+		r.recordAPICall(config, 1)
+	}
+	return nil
+}
+
+// selectNodeInZone returns a random node from the given zone, or from all nodes when the zone has none
+func selectNodeInZone(nodes []corev1.Node, zone string) string {
+	var candidates []corev1.Node
+	for _, node := range nodes {
+		if node.Labels[corev1.LabelTopologyZone] == zone {
+			candidates = append(candidates, node)
+		}
+	}
+	if len(candidates) == 0 {
+		candidates = nodes
+	}
+	if len(candidates) == 0 {
+		return ""
+	}
+	return candidates[rand.Intn(len(candidates))].Name
+}
+
+// namespaceZones maps each namespace with a zone label to its zone
+func namespaceZones(namespaces []corev1.Namespace) map[string]string {
+	zones := make(map[string]string, len(namespaces))
+	for _, ns := range namespaces {
+		if zone := ns.Labels[namespaceZoneLabel]; zone != "" {
+			zones[ns.Name] = zone
+		}
+	}
+	return zones
+}
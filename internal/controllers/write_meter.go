@@ -0,0 +1,128 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+// writeMeter estimates the bytes written to etcd by adding up the serialized size of every
+// successful create, update and patch of an operator-managed object
+type writeMeter struct {
+	mu sync.Mutex
+	// pending holds bytes per config and resource type not yet reported in status
+	pending map[string]map[string]int64
+	// bytesWritten exports the same bytes as a Prometheus counter; nil skips it
+	bytesWritten *prometheus.CounterVec
+}
+
+func newWriteMeter(bytesWritten *prometheus.CounterVec) *writeMeter {
+	return &writeMeter{pending: make(map[string]map[string]int64), bytesWritten: bytesWritten}
+}
+
+// record adds the bytes of one write; objects that no config owns, such as access reviews, are skipped
+func (m *writeMeter) record(obj client.Object, resourceType string, bytes int) {
+	_, configName := orphanOwner(obj)
+	if configName == "" || bytes <= 0 {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.pending[configName] == nil {
+		m.pending[configName] = make(map[string]int64)
+	}
+	m.pending[configName][resourceType] += int64(bytes)
+	if m.bytesWritten != nil {
+		m.bytesWritten.WithLabelValues(resourceType).Add(float64(bytes))
+	}
+}
+
+// drain returns the bytes per resource type written for a config since the last drain
+func (m *writeMeter) drain(configName string) map[string]int64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	written := m.pending[configName]
+	delete(m.pending, configName)
+	return written
+}
+
+// applyWriteVolume adds bytes drained from the meter to the write volume already in status
+func applyWriteVolume(config *scalev1.ScaleLoadConfig, written map[string]int64) {
+	volume := config.Status.WriteVolume
+	volume.LastCycleBytes = 0
+	for resourceType, bytes := range written {
+		if volume.ByResourceType == nil {
+			volume.ByResourceType = make(map[string]int64)
+		}
+		volume.ByResourceType[resourceType] += bytes
+		volume.TotalBytes += bytes
+		volume.LastCycleBytes += bytes
+	}
+	config.Status.WriteVolume = volume
+}
+
+// meteredClient records the serialized size of every successful write with a writeMeter
+type meteredClient struct {
+	client.Client
+	meter *writeMeter
+}
+
+// withWriteMeter wraps a client so the size of its writes is recorded with meter
+func withWriteMeter(c client.Client, meter *writeMeter) client.Client {
+	return &meteredClient{Client: c, meter: meter}
+}
+
+func (c *meteredClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	size := serializedSize(obj)
+	if err := c.Client.Create(ctx, obj, opts...); err != nil {
+		return err
+	}
+	c.meter.record(obj, c.resourceType(obj), size)
+	return nil
+}
+
+func (c *meteredClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	size := serializedSize(obj)
+	if err := c.Client.Update(ctx, obj, opts...); err != nil {
+		return err
+	}
+	c.meter.record(obj, c.resourceType(obj), size)
+	return nil
+}
+
+// Patch records the size of the stored object after the patch, since etcd rewrites the whole object
+func (c *meteredClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	if err := c.Client.Patch(ctx, obj, patch, opts...); err != nil {
+		return err
+	}
+	c.meter.record(obj, c.resourceType(obj), serializedSize(obj))
+	return nil
+}
+
+// resourceType returns the object's resource-type label, falling back to its lowercased kind
+func (c *meteredClient) resourceType(obj client.Object) string {
+	if resourceType := obj.GetLabels()["scale.openshift.io/resource-type"]; resourceType != "" {
+		return resourceType
+	}
+	gvk, err := c.GroupVersionKindFor(obj)
+	if err != nil {
+		return "unknown"
+	}
+	return strings.ToLower(gvk.Kind)
+}
+
+// serializedSize approximates the bytes etcd stores for an object by its JSON encoding
+func serializedSize(obj client.Object) int {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return 0
+	}
+	return len(data)
+}
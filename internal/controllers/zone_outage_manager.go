@@ -0,0 +1,171 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+// zoneOutageAnnotation marks nodes taken down by a zone outage, holding the owning config name
+const zoneOutageAnnotation = "scale.openshift.io/zone-outage"
+
+// zoneOutageTaints are the taints the node lifecycle controller adds to unreachable nodes
+var zoneOutageTaints = []corev1.Taint{
+	{Key: corev1.TaintNodeUnreachable, Effect: corev1.TaintEffectNoSchedule},
+	{Key: corev1.TaintNodeUnreachable, Effect: corev1.TaintEffectNoExecute},
+}
+
+// activeZoneOutage returns the zone that is down at the given time, or an empty string outside an outage
+func activeZoneOutage(config *scalev1.ScaleLoadConfig, now time.Time) string {
+	outage := config.Spec.Topology.ZoneOutage
+	if !config.Spec.Topology.Enabled || !outage.Enabled || outage.IntervalSeconds <= 0 {
+		return ""
+	}
+
+	elapsed := int64(now.Sub(config.CreationTimestamp.Time) / time.Second)
+	interval := int64(outage.IntervalSeconds)
+	if elapsed < interval || elapsed%interval >= int64(outage.DurationSeconds) {
+		return ""
+	}
+
+	if outage.Zone != "" {
+		return outage.Zone
+	}
+	return topologyZones(config)[0].Name
+}
+
+// manageZoneOutage takes every KWOK node in the outage zone down while an outage is active and
+// brings them back afterwards, returning the zone currently down
+func (r *ScaleLoadConfigReconciler) manageZoneOutage(ctx context.Context, config *scalev1.ScaleLoadConfig, nodes []corev1.Node) (string, error) {
+	log := r.Log.WithName("zone-outage")
+	zone := activeZoneOutage(config, time.Now())
+
+	var down, recovered int
+	for i := range nodes {
+		node := &nodes[i]
+		inOutage := zone != "" && node.Labels[corev1.LabelTopologyZone] == zone
+		marked := node.Annotations[zoneOutageAnnotation] == config.Name
+
+		switch {
+		case inOutage && !marked:
+			if err := r.setNodeOutage(ctx, config, node, true); err != nil {
+				return zone, err
+			}
+			down++
+		case !inOutage && marked:
+			if err := r.setNodeOutage(ctx, config, node, false); err != nil {
+				return zone, err
+			}
+			recovered++
+		}
+	}
+
+	if down > 0 {
+		log.Info("Zone outage started", "zone", zone, "nodes", down)
+	}
+	if recovered > 0 {
+		log.Info("Zone outage recovered", "nodes", recovered)
+	}
+	return zone, nil
+}
+
+// clearZoneOutage recovers every node still marked down by a config
+func (r *ScaleLoadConfigReconciler) clearZoneOutage(ctx context.Context, config *scalev1.ScaleLoadConfig) error {
+	nodeList := &corev1.NodeList{}
+	if err := r.List(ctx, nodeList); err != nil {
+		return fmt.Errorf("failed to list nodes for zone outage recovery: %w", err)
+	}
+	for i := range nodeList.Items {
+		node := &nodeList.Items[i]
+		if node.Annotations[zoneOutageAnnotation] != config.Name {
+			continue
+		}
+		if err := r.setNodeOutage(ctx, config, node, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setNodeOutage adds or removes the unreachable taints and flips the Ready condition of a node
+func (r *ScaleLoadConfigReconciler) setNodeOutage(ctx context.Context, config *scalev1.ScaleLoadConfig, node *corev1.Node, down bool) error {
+	patch := client.MergeFrom(node.DeepCopy())
+
+	var taints []corev1.Taint
+	for _, taint := range node.Spec.Taints {
+		if taint.Key != corev1.TaintNodeUnreachable {
+			taints = append(taints, taint)
+		}
+	}
+	if node.Annotations == nil {
+		node.Annotations = make(map[string]string)
+	}
+	if down {
+		now := metav1.Now()
+		for _, taint := range zoneOutageTaints {
+			taint.TimeAdded = &now
+			taints = append(taints, taint)
+		}
+		node.Annotations[zoneOutageAnnotation] = config.Name
+	} else {
+		delete(node.Annotations, zoneOutageAnnotation)
+	}
+	node.Spec.Taints = taints
+
+	if err := r.Patch(ctx, node, patch); err != nil {
+		return fmt.Errorf("failed to update taints on node %s: %w", node.Name, err)
+	}
+	r.recordAPICall(config, 1)
+
+	// KWOK may report the node Ready again on its own; the taints keep the outage effective either way
+	statusPatch := client.MergeFrom(node.DeepCopy())
+	setNodeReadyCondition(node, !down)
+	if err := r.Status().Patch(ctx, node, statusPatch); err != nil {
+		return fmt.Errorf("failed to update Ready condition on node %s: %w", node.Name, err)
+	}
+	r.recordAPICall(config, 1)
+	return nil
+}
+
+// setNodeReadyCondition sets the node's Ready condition the way the node lifecycle controller does
+func setNodeReadyCondition(node *corev1.Node, ready bool) {
+	condition := corev1.NodeCondition{
+		Type:               corev1.NodeReady,
+		Status:             corev1.ConditionTrue,
+		Reason:             "KubeletReady",
+		Message:            "kubelet is posting ready status",
+		LastHeartbeatTime:  metav1.Now(),
+		LastTransitionTime: metav1.Now(),
+	}
+	if !ready {
+		condition.Status = corev1.ConditionUnknown
+		condition.Reason = "NodeStatusUnknown"
+		condition.Message = "Kubelet stopped posting node status (simulated zone outage)"
+	}
+
+	for i := range node.Status.Conditions {
+		if node.Status.Conditions[i].Type == corev1.NodeReady {
+			node.Status.Conditions[i] = condition
+			return
+		}
+	}
+	node.Status.Conditions = append(node.Status.Conditions, condition)
+}
+
+// setActiveZoneOutage records the zone currently down for a config, for status reporting
+func (r *ScaleLoadConfigReconciler) setActiveZoneOutage(configName, zone string) {
+	if zone == "" {
+		delete(r.activeZoneOutages, configName)
+		return
+	}
+	if r.activeZoneOutages == nil {
+		r.activeZoneOutages = make(map[string]string)
+	}
+	r.activeZoneOutages[configName] = zone
+}
@@ -3,8 +3,12 @@ package main
 import (
 	"crypto/tls"
 	"flag"
+	"fmt"
+	"net/http"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
@@ -14,6 +18,7 @@ import (
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
@@ -56,6 +61,37 @@ func gracefulErrorHandler(err error) {
 	setupLog.Error(err, "Controller runtime error handled gracefully")
 }
 
+// splitNamespaces parses a comma-separated namespace list, dropping empty entries
+func splitNamespaces(list string) []string {
+	var namespaces []string
+	for _, ns := range strings.Split(list, ",") {
+		if ns = strings.TrimSpace(ns); ns != "" {
+			namespaces = append(namespaces, ns)
+		}
+	}
+	return namespaces
+}
+
+// parseBuckets parses a comma-separated list of histogram bucket bounds, which must increase; an empty
+// list keeps the default buckets
+func parseBuckets(list string) ([]float64, error) {
+	var buckets []float64
+	for _, field := range strings.Split(list, ",") {
+		if field = strings.TrimSpace(field); field == "" {
+			continue
+		}
+		bound, err := strconv.ParseFloat(field, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid bucket bound %q: %w", field, err)
+		}
+		if n := len(buckets); n > 0 && bound <= buckets[n-1] {
+			return nil, fmt.Errorf("bucket bounds must increase, %v follows %v", bound, buckets[n-1])
+		}
+		buckets = append(buckets, bound)
+	}
+	return buckets, nil
+}
+
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(scalev1.AddToScheme(scheme))
@@ -73,6 +109,18 @@ func main() {
 	var probeAddr string
 	var secureMetrics bool
 	var enableHTTP2 bool
+	var watchNamespaces string
+	var summaryNamespace string
+	var orphanSweep bool
+	var orphanSweepInterval time.Duration
+	var orphanAdoptInto string
+	var importNamespaces string
+	var kubeconfigNamespaces string
+	var subsystemIdentity string
+	var reconcileBuckets string
+	var apiCallBuckets string
+	var stallIntervals int
+	var exitOnStall bool
 
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
@@ -83,6 +131,39 @@ func main() {
 		"If set the metrics endpoint is served securely")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
+	flag.StringVar(&watchNamespaces, "watch-namespaces", "",
+		"Comma-separated list of namespaces to cache namespaced resources from. "+
+			"Required for ScaleLoadConfigs using the Namespaced scope with Role-based RBAC; "+
+			"empty caches all namespaces.")
+	flag.StringVar(&summaryNamespace, "summary-namespace", os.Getenv("POD_NAMESPACE"),
+		"Namespace for the summary ConfigMaps of configs with spec.summary enabled. "+
+			"Defaults to the namespace the operator runs in.")
+	flag.BoolVar(&orphanSweep, "orphan-sweep", false,
+		"Delete operator-created objects whose ScaleLoadConfig no longer exists, e.g. after a rename.")
+	flag.DurationVar(&orphanSweepInterval, "orphan-sweep-interval", 0,
+		"Repeat the orphan sweep at this interval; 0 sweeps only at startup.")
+	flag.StringVar(&orphanAdoptInto, "orphan-adopt-into", "",
+		"Relabel orphaned objects to this ScaleLoadConfig instead of deleting them.")
+	flag.StringVar(&importNamespaces, "import-namespaces", "",
+		"Comma-separated list of namespaces besides the operator's that spec.imports may read ConfigMaps and Secrets from.")
+	flag.StringVar(&kubeconfigNamespaces, "kubeconfig-namespaces", "",
+		"Comma-separated list of namespaces besides the operator's that spec.targetClusters may read kubeconfig secrets from.")
+	flag.StringVar(&subsystemIdentity, "subsystem-identity", "",
+		"Send each simulated subsystem's writes with the user agent <value>/<subsystem> and field manager "+
+			"<value>-<subsystem>, e.g. sim-operator, so audit logs can be broken down by source. Empty disables it.")
+
+	flag.StringVar(&reconcileBuckets, "reconcile-duration-buckets", "",
+		"Comma-separated upper bounds, in seconds, of the kwok_load_generator_reconcile_duration_seconds buckets, "+
+			"e.g. 0.5,1,2,5,10,30,60,120. Empty uses the Prometheus defaults.")
+	flag.StringVar(&apiCallBuckets, "api-call-duration-buckets", "",
+		"Comma-separated upper bounds of the kwok_load_generator_api_calls_duration_seconds buckets. "+
+			"Empty uses the Prometheus defaults.")
+
+	flag.IntVar(&stallIntervals, "stall-intervals", 5,
+		"Report a config as stalled when no reconcile completed for this many reconcile intervals, "+
+			"and for at least the 5 minute reconcile timeout. 0 disables the stall watchdog.")
+	flag.BoolVar(&exitOnStall, "exit-on-stall", false,
+		"Exit when a stall is detected so the pod is restarted.")
 
 	opts := zap.Options{
 		Development: true,
@@ -94,6 +175,17 @@ func main() {
 
 	utilruntime.ErrorHandlers = append(utilruntime.ErrorHandlers, gracefulErrorHandler)
 
+	reconcileDurationBuckets, err := parseBuckets(reconcileBuckets)
+	if err != nil {
+		setupLog.Error(err, "invalid --reconcile-duration-buckets")
+		os.Exit(1)
+	}
+	apiCallDurationBuckets, err := parseBuckets(apiCallBuckets)
+	if err != nil {
+		setupLog.Error(err, "invalid --api-call-duration-buckets")
+		os.Exit(1)
+	}
+
 	// if the enable-http2 flag is false (the default), http/2 should be disabled
 	// due to its vulnerabilities. More specifically, disabling http/2 will
 	// prevent from being vulnerable to the HTTP/2 Stream Cancellation and
@@ -120,27 +212,42 @@ func main() {
 	// Set QPS to 200 to allow target rate with burst capacity for parallel operations
 	config.QPS = 200.0 // 200 queries per second - allows reaching target rate
 	config.Burst = 400 // 400 burst capacity - sufficient for parallel operations without excess
+	// Tag each request with the run it belongs to in its user agent
+	controllers.WrapRunIDTransport(config)
 
 	setupLog.Info("Configured controlled high-throughput client rate limits",
 		"QPS", config.QPS,
 		"Burst", config.Burst,
 		"targetAPICallsPerMin", 50000)
 
+	// Restrict the cache to the given namespaces so only Role-based permissions are needed there
+	cacheOpts := cache.Options{}
+	if watchNamespaces != "" {
+		cacheOpts.DefaultNamespaces = make(map[string]cache.Config)
+		for _, ns := range splitNamespaces(watchNamespaces) {
+			cacheOpts.DefaultNamespaces[ns] = cache.Config{}
+		}
+		setupLog.Info("Restricting cache to namespaces", "namespaces", watchNamespaces)
+	}
+
+	// The per-run summary is served beside the controller metrics
+	runMetrics := controllers.NewRunMetrics()
 	mgr, err := ctrl.NewManager(config, ctrl.Options{
 		Scheme: scheme,
 		Metrics: server.Options{
 			BindAddress:   metricsAddr,
 			SecureServing: secureMetrics,
 			TLSOpts:       tlsOpts,
+			ExtraHandlers: map[string]http.Handler{controllers.RunMetricsPath: runMetrics.Handler()},
 		},
 		WebhookServer:          webhookServer,
 		HealthProbeBindAddress: probeAddr,
 		LeaderElection:         enableLeaderElection,
 		LeaderElectionID:       "sim-operator.scale.openshift.io",
 
-		// Configure cache with default settings
+		// Configure cache, optionally restricted to --watch-namespaces
 		// Watch failures for OpenShift resources will be handled gracefully by error handler
-		Cache: cache.Options{},
+		Cache: cacheOpts,
 
 		// LeaderElectionReleaseOnCancel defines if the leader should step down voluntarily
 		// when the Manager ends. This requires the binary to immediately end when the
@@ -159,24 +266,80 @@ func main() {
 		os.Exit(1)
 	}
 
-	if err = (&controllers.ScaleLoadConfigReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
-		Log:    ctrl.Log.WithName("controllers").WithName("ScaleLoadConfig"),
-	}).SetupWithManager(mgr); err != nil {
+	var subsystemClients map[string]client.Client
+	if subsystemIdentity != "" {
+		if subsystemClients, err = controllers.NewSubsystemClients(mgr, subsystemIdentity); err != nil {
+			setupLog.Error(err, "unable to create subsystem clients")
+			os.Exit(1)
+		}
+	}
+
+	reconciler := &controllers.ScaleLoadConfigReconciler{
+		Client:            mgr.GetClient(),
+		Scheme:            mgr.GetScheme(),
+		Log:               ctrl.Log.WithName("controllers").WithName("ScaleLoadConfig"),
+		SummaryNamespace:  summaryNamespace,
+		OperatorNamespace: os.Getenv("POD_NAMESPACE"),
+		ImportNamespaces:  splitNamespaces(importNamespaces),
+		APIReader:         mgr.GetAPIReader(),
+		SubsystemClients:  subsystemClients,
+		RunMetrics:        runMetrics,
+
+		KubeconfigNamespaces:     splitNamespaces(kubeconfigNamespaces),
+		ReconcileDurationBuckets: reconcileDurationBuckets,
+		APICallDurationBuckets:   apiCallDurationBuckets,
+		StallIntervals:           stallIntervals,
+		ExitOnStall:              exitOnStall,
+	}
+	if err = reconciler.SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ScaleLoadConfig")
 		os.Exit(1)
 	}
+	// Local runs without serving certificates set ENABLE_WEBHOOKS=false; the reconciler still
+	// enforces safety limits and protected namespaces without the webhook
+	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
+		if err = (&scalev1.ScaleLoadConfigWebhook{Reader: mgr.GetAPIReader()}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "ScaleLoadConfig")
+			os.Exit(1)
+		}
+	}
 	//+kubebuilder:scaffold:builder
 
+	// The sweep lists cluster-wide, which Role-based namespaced deployments cannot do
+	if orphanSweep && watchNamespaces == "" {
+		if err := mgr.Add(&controllers.OrphanSweeper{
+			Client:    mgr.GetClient(),
+			Reader:    mgr.GetAPIReader(),
+			Log:       ctrl.Log.WithName("controllers").WithName("OrphanSweeper"),
+			Interval:  orphanSweepInterval,
+			AdoptInto: orphanAdoptInto,
+		}); err != nil {
+			setupLog.Error(err, "unable to set up orphan sweeper")
+			os.Exit(1)
+		}
+	}
+
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
 		os.Exit(1)
 	}
+	// A hung reconcile or request pacer fails liveness so the pod is restarted
+	if err := mgr.AddHealthzCheck("reconciler", reconciler.CheckReconciler); err != nil {
+		setupLog.Error(err, "unable to set up reconciler health check")
+		os.Exit(1)
+	}
+	if err := mgr.AddHealthzCheck("pacer", reconciler.CheckPacer); err != nil {
+		setupLog.Error(err, "unable to set up pacer health check")
+		os.Exit(1)
+	}
 	if err := mgr.AddReadyzCheck("readyz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up ready check")
 		os.Exit(1)
 	}
+	if err := mgr.AddReadyzCheck("nodes", reconciler.CheckNodeAccess); err != nil {
+		setupLog.Error(err, "unable to set up node access ready check")
+		os.Exit(1)
+	}
 
 	setupLog.Info("starting manager")
 	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
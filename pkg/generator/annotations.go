@@ -0,0 +1,215 @@
+package generator
+
+import (
+	"fmt"
+	"math/rand"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+// AnnotationGenerators maps annotation keys to functions returning a fresh value, so callers can
+// decide per key when a value changes
+type AnnotationGenerators map[string]func() string
+
+// Apply writes a fresh value for every key into annotations
+func (g AnnotationGenerators) Apply(annotations map[string]string) {
+	for key, value := range g {
+		annotations[key] = value()
+	}
+}
+
+// OVNAddressAnnotations returns the OVN-Kubernetes annotations derived from a node's addressing.
+// Values for the same network agree with each other, as they do on a real node
+func OVNAddressAnnotations(nodeName, stack string, network NodeNetwork) AnnotationGenerators {
+	return AnnotationGenerators{
+		"k8s.ovn.org/host-cidrs": func() string {
+			return StackList(stack, network.PrimaryIPv4, network.PrimaryIPv6)
+		},
+		"k8s.ovn.org/l3-gateway-config": func() string {
+			return L3GatewayConfig(nodeName, stack, network)
+		},
+		"k8s.ovn.org/node-encap-ips": func() string {
+			// Geneve tunnels use a single family, IPv4 unless the cluster is IPv6 only
+			if stack == scalev1.NetworkStackIPv6 {
+				return StackList(stack, "", AddressOnly(network.PrimaryIPv6))
+			}
+			return StackList(scalev1.NetworkStackIPv4, AddressOnly(network.PrimaryIPv4), "")
+		},
+		"k8s.ovn.org/node-primary-ifaddr": func() string {
+			return StackIfAddr(stack, network.PrimaryIPv4, network.PrimaryIPv6)
+		},
+		"k8s.ovn.org/node-subnets": func() string {
+			return fmt.Sprintf("{\"default\":%s}", StackList(stack, network.SubnetIPv4, network.SubnetIPv6))
+		},
+		"k8s.ovn.org/node-transit-switch-port-ifaddr": func() string {
+			return StackIfAddr(stack, network.TransitIPv4, network.TransitIPv6)
+		},
+	}
+}
+
+// OVNNodeAnnotations returns the OVN-Kubernetes annotations that do not depend on addressing
+func OVNNodeAnnotations(nodeName string) AnnotationGenerators {
+	return AnnotationGenerators{
+		"k8s.ovn.org/node-chassis-id": func() string {
+			return UUID()
+		},
+		"k8s.ovn.org/zone-name": func() string {
+			return nodeName
+		},
+		"k8s.ovn.org/remote-zone-migrated": func() string {
+			return nodeName
+		},
+		"k8s.ovn.org/layer2-topology-version": func() string {
+			versions := []string{"2.0", "2.1", "2.2"}
+			return versions[rand.Intn(len(versions))]
+		},
+	}
+}
+
+// MachineConfigAnnotations returns the annotations the machine-config-daemon keeps on a worker node
+func MachineConfigAnnotations() AnnotationGenerators {
+	return AnnotationGenerators{
+		"machineconfiguration.openshift.io/currentConfig": func() string {
+			return fmt.Sprintf("rendered-worker-%s", Hash())
+		},
+		"machineconfiguration.openshift.io/desiredConfig": func() string {
+			return fmt.Sprintf("rendered-worker-%s", Hash())
+		},
+		"machineconfiguration.openshift.io/desiredDrain": func() string {
+			return fmt.Sprintf("uncordon-rendered-worker-%s", Hash())
+		},
+		"machineconfiguration.openshift.io/lastAppliedDrain": func() string {
+			return fmt.Sprintf("uncordon-rendered-worker-%s", Hash())
+		},
+		"machineconfiguration.openshift.io/state": func() string {
+			states := []string{"Done", "Working", "Degraded"}
+			return states[rand.Intn(len(states))]
+		},
+		"machineconfiguration.openshift.io/reason": func() string {
+			if rand.Float64() < 0.8 {
+				return "" // Usually empty
+			}
+			reasons := []string{"Updating", "Rebooting", "ConfigChange"}
+			return reasons[rand.Intn(len(reasons))]
+		},
+		"machineconfiguration.openshift.io/lastSyncedControllerConfigResourceVersion": func() string {
+			return fmt.Sprintf("%d", 2900000+rand.Intn(100000))
+		},
+		"machineconfiguration.openshift.io/controlPlaneTopology": func() string {
+			return "HighlyAvailable"
+		},
+		"machineconfiguration.openshift.io/lastObservedServerCAAnnotation": func() string {
+			return "false"
+		},
+		"machineconfiguration.openshift.io/post-config-action": func() string {
+			return ""
+		},
+	}
+}
+
+// L3GatewayConfig returns the k8s.ovn.org/l3-gateway-config of a node in shared gateway mode
+func L3GatewayConfig(nodeName, stack string, network NodeNetwork) string {
+	ipv4, ipv6 := network.PrimaryIPv4, network.PrimaryIPv6
+	nextHopIPv4, nextHopIPv6 := network.GatewayIPv4, network.GatewayIPv6
+
+	return fmt.Sprintf(`{"default":{"mode":"shared","bridge-id":"br-ex","interface-id":"br-ex_%s","mac-address":"%s","ip-addresses":%s,"ip-address":"%s","next-hops":%s,"next-hop":"%s","node-port-enable":"true","vlan-id":"0"}}`,
+		nodeName, MAC(), StackList(stack, ipv4, ipv6), StackValues(stack, ipv4, ipv6)[0],
+		StackList(stack, nextHopIPv4, nextHopIPv6), StackValues(stack, nextHopIPv4, nextHopIPv6)[0])
+}
+
+// EgressIPConfig returns the egress IP capacity of the node's primary interface as the
+// platform reports it, or "" on bare metal where the cloud network config controller does not run
+func EgressIPConfig(platform, stack, nodeName string, network NodeNetwork) string {
+	ifAddr := StackIfAddr(stack, network.MachineIPv4, network.MachineIPv6)
+
+	switch platform {
+	case scalev1.NodePlatformBareMetal:
+		return ""
+	case scalev1.NodePlatformAzure:
+		return fmt.Sprintf(`[{"interface":"%s-nic","ifaddr":%s,"capacity":{"ip":%d}}]`,
+			nodeName, ifAddr, 200+rand.Intn(56))
+	case scalev1.NodePlatformGCP:
+		return fmt.Sprintf(`[{"interface":"nic0","ifaddr":%s,"capacity":{"ip":%d}}]`,
+			ifAddr, 5+rand.Intn(6))
+	}
+
+	eni := fmt.Sprintf("eni-%012x", rand.Uint64()&0xFFFFFFFFFFFF)
+	return fmt.Sprintf(`[{"interface":"%s","ifaddr":%s,"capacity":{"ipv4":%d,"ipv6":%d}}]`,
+		eni, ifAddr, 10+rand.Intn(20), 10+rand.Intn(20))
+}
+
+// CSINodeID returns the node IDs of the platform's default CSI drivers, or "" on bare metal
+// where no CSI driver is installed by default
+func CSINodeID(platform, nodeName string) string {
+	switch platform {
+	case scalev1.NodePlatformBareMetal:
+		return ""
+	case scalev1.NodePlatformAzure:
+		return fmt.Sprintf(`{"disk.csi.azure.com":"%s","file.csi.azure.com":"%s"}`, nodeName, nodeName)
+	case scalev1.NodePlatformGCP:
+		return fmt.Sprintf(`{"pd.csi.storage.gke.io":"projects/sim-project/zones/us-central1-a/instances/%s"}`, nodeName)
+	}
+	return fmt.Sprintf(`{"ebs.csi.aws.com":"i-%016x"}`, rand.Uint64())
+}
+
+// MachineReference returns the Machine backing the node, named the way the platform's
+// MachineSets name them
+func MachineReference(platform, nodeName string) string {
+	// Extract some identifier from node name for consistency
+	suffix := nodeName
+	if len(nodeName) > 6 {
+		suffix = nodeName[len(nodeName)-6:]
+	}
+
+	pool := "us-west-2a"
+	switch platform {
+	case scalev1.NodePlatformAzure:
+		pool = "eastus1"
+	case scalev1.NodePlatformGCP:
+		pool = "a"
+	case scalev1.NodePlatformBareMetal:
+		pool = "0"
+	}
+	return fmt.Sprintf("openshift-machine-api/ci-op-%s-worker-%s-%s",
+		RandomString(6), pool, suffix)
+}
+
+// SCCAnnotations sets the UID, MCS and supplemental group ranges cluster-policy-controller allocates to a project
+func SCCAnnotations(annotations map[string]string) {
+	block := 1000000000 + rand.Intn(1000)*10000
+	annotations["openshift.io/sa.scc.uid-range"] = fmt.Sprintf("%d/10000", block)
+	annotations["openshift.io/sa.scc.supplemental-groups"] = fmt.Sprintf("%d/10000", block)
+	annotations["openshift.io/sa.scc.mcs"] = fmt.Sprintf("s0:c%d,c%d", 1+rand.Intn(30), 1+rand.Intn(30))
+}
+
+// AllocatedSCCAnnotations sets the ranges cluster-policy-controller allocates to the slot-th project.
+// Like the allocator, it hands out consecutive 10000-ID blocks of the default 1000000000-1999999999
+// range and MCS category pairs in order, so different slots never share a range
+func AllocatedSCCAnnotations(annotations map[string]string, slot int) {
+	const blockSize, blocks = 10000, 100000
+	block := 1000000000 + (slot%blocks)*blockSize
+	annotations["openshift.io/sa.scc.uid-range"] = fmt.Sprintf("%d/%d", block, blockSize)
+	annotations["openshift.io/sa.scc.supplemental-groups"] = fmt.Sprintf("%d/%d", block, blockSize)
+	annotations["openshift.io/sa.scc.mcs"] = MCSLabel(slot)
+}
+
+// MCSLabel returns the slot-th MCS label of the allocator: pairs of the categories c0-c1023, larger
+// first, in the order s0:c1,c0, s0:c2,c0, s0:c2,c1, s0:c3,c0, ...
+func MCSLabel(slot int) string {
+	slot %= 1024 * 1023 / 2
+	high := 1
+	for high*(high+1)/2 <= slot {
+		high++
+	}
+	return fmt.Sprintf("s0:c%d,c%d", high, slot-high*(high-1)/2)
+}
+
+// SchedulerAnnotations sets a project node selector and default toleration hints
+func SchedulerAnnotations(annotations map[string]string) {
+	pools := []string{"", "node-role.kubernetes.io/worker=", "node-role.kubernetes.io/infra="}
+	annotations["openshift.io/node-selector"] = pools[rand.Intn(len(pools))]
+
+	effects := []string{"NoSchedule", "PreferNoSchedule"}
+	annotations["scheduler.alpha.kubernetes.io/defaultTolerations"] = fmt.Sprintf(
+		`[{"key":"sim-pool-%d","operator":"Exists","effect":"%s"}]`, rand.Intn(5), effects[rand.Intn(len(effects))])
+}
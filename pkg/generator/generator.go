@@ -0,0 +1,75 @@
+// Package generator synthesizes the realistic object content the operator writes: ConfigMap and
+// Secret payloads, credentials, and the node and namespace annotations OpenShift components set.
+// Payloads come from a DataProvider, selectable per resource type; RegisterProvider adds more
+// It has no dependency on the reconciler, so other tools can build the same objects without
+// running the operator:
+//
+//	cm := generator.ConfigMap(generator.Meta{Name: "app-config", Namespace: "load-1"}, 0)
+//	annotations := map[string]string{}
+//	generator.SCCAnnotations(annotations)
+//
+// Values come from math/rand's global source, except key material, which comes from crypto/rand
+package generator
+
+import (
+	"fmt"
+	"maps"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Meta names a generated object. Labels are copied onto it, next to the labels the generator adds
+type Meta struct {
+	Name      string
+	Namespace string
+	Labels    map[string]string
+}
+
+// objectMeta builds the object's metadata, adding the app.kubernetes.io labels of an application component
+func (m Meta) objectMeta(index int32, component string) metav1.ObjectMeta {
+	labels := map[string]string{
+		"app.kubernetes.io/name":      fmt.Sprintf("sim-app-%d", index),
+		"app.kubernetes.io/component": component,
+	}
+	maps.Copy(labels, m.Labels)
+	return metav1.ObjectMeta{Name: m.Name, Namespace: m.Namespace, Labels: labels}
+}
+
+// ConfigMap returns the configuration of application index: a properties file, a YAML config and
+// JSON settings, the mix seen in application namespaces
+func ConfigMap(meta Meta, index int32) *corev1.ConfigMap {
+	configMap, _ := ConfigMapFrom(Synthetic, meta, index)
+	return configMap
+}
+
+// ConfigMapFrom returns the ConfigMap of application index with its data from provider
+func ConfigMapFrom(provider DataProvider, meta Meta, index int32) (*corev1.ConfigMap, error) {
+	data, err := provider.ConfigMapData(index)
+	if err != nil {
+		return nil, err
+	}
+	return &corev1.ConfigMap{
+		ObjectMeta: meta.objectMeta(index, "configuration"),
+		Data:       data,
+	}, nil
+}
+
+// Secret returns the credentials of application index: a user and password, an API key and a YAML config
+func Secret(meta Meta, index int32) *corev1.Secret {
+	secret, _ := SecretFrom(Synthetic, meta, index)
+	return secret
+}
+
+// SecretFrom returns the Secret of application index with its data from provider
+func SecretFrom(provider DataProvider, meta Meta, index int32) (*corev1.Secret, error) {
+	data, err := provider.SecretData(index)
+	if err != nil {
+		return nil, err
+	}
+	return &corev1.Secret{
+		ObjectMeta: meta.objectMeta(index, "credentials"),
+		Type:       corev1.SecretTypeOpaque,
+		Data:       data,
+	}, nil
+}
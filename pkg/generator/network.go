@@ -0,0 +1,106 @@
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+// NodeNetwork is the addressing of one node. Addresses carry their prefix length
+type NodeNetwork struct {
+	PrimaryIPv4, PrimaryIPv6 string
+	SubnetIPv4, SubnetIPv6   string
+	MachineIPv4, MachineIPv6 string
+	GatewayIPv4, GatewayIPv6 string
+	TransitIPv4, TransitIPv6 string
+}
+
+// RandomNodeNetwork returns independent random addresses, for nodes that are not part of a modelled cluster network
+func RandomNodeNetwork() NodeNetwork {
+	return NodeNetwork{
+		PrimaryIPv4: IPv4() + "/19",
+		PrimaryIPv6: IPv6() + "/64",
+		SubnetIPv4:  SubnetIPv4() + "/23",
+		SubnetIPv6:  SubnetIPv6() + "/64",
+		MachineIPv4: IPv4() + "/19",
+		MachineIPv6: IPv6() + "/64",
+		GatewayIPv4: "10.0.0.1",
+		GatewayIPv6: "fd00:10::1",
+		TransitIPv4: TransitIPv4() + "/16",
+		TransitIPv6: TransitIPv6() + "/64",
+	}
+}
+
+// IPv4 returns a random address in 10.0.0.0/16
+func IPv4() string {
+	return fmt.Sprintf("10.0.%d.%d", rand.Intn(256), rand.Intn(256))
+}
+
+// SubnetIPv4 returns the network address of a random /23 host subnet in the default cluster network
+func SubnetIPv4() string {
+	return fmt.Sprintf("10.%d.%d.0", 128+rand.Intn(128), rand.Intn(256)&0xFE)
+}
+
+// TransitIPv4 returns a random address in the OVN-Kubernetes transit switch subnet
+func TransitIPv4() string {
+	return fmt.Sprintf("100.88.0.%d", rand.Intn(256))
+}
+
+// IPv6 returns a random address in the ULA prefix used for IPv6 node addresses
+func IPv6() string {
+	return fmt.Sprintf("fd00:10::%x:%x", rand.Intn(0x10000), rand.Intn(0x10000))
+}
+
+// SubnetIPv6 returns the network address of a random /64 host subnet
+func SubnetIPv6() string {
+	return fmt.Sprintf("fd01:0:0:%x::", rand.Intn(0x10000))
+}
+
+// TransitIPv6 returns a random address in the IPv6 transit switch subnet
+func TransitIPv6() string {
+	return fmt.Sprintf("fd97::%x", rand.Intn(0x10000))
+}
+
+// AddressOnly strips the prefix length from an address, e.g. 10.0.0.5/16 becomes 10.0.0.5
+func AddressOnly(cidr string) string {
+	if ip, _, err := net.ParseCIDR(cidr); err == nil {
+		return ip.String()
+	}
+	return cidr
+}
+
+// StackValues returns the values of the IP families in the stack, IPv4 first as OVN-Kubernetes orders them
+func StackValues(stack, ipv4, ipv6 string) []string {
+	switch stack {
+	case scalev1.NetworkStackIPv6:
+		return []string{ipv6}
+	case scalev1.NetworkStackDualStack:
+		return []string{ipv4, ipv6}
+	}
+	return []string{ipv4}
+}
+
+// StackList renders the stack's values as a JSON list, e.g. ["10.0.1.5/19","fd00:10::5/64"]
+func StackList(stack, ipv4, ipv6 string) string {
+	list, _ := json.Marshal(StackValues(stack, ipv4, ipv6))
+	return string(list)
+}
+
+// StackIfAddr renders the stack's values as an interface address object, e.g. {"ipv4":"10.0.1.5/19"}
+func StackIfAddr(stack, ipv4, ipv6 string) string {
+	ifAddr := map[string]string{}
+	switch stack {
+	case scalev1.NetworkStackIPv6:
+		ifAddr["ipv6"] = ipv6
+	case scalev1.NetworkStackDualStack:
+		ifAddr["ipv4"] = ipv4
+		ifAddr["ipv6"] = ipv6
+	default:
+		ifAddr["ipv4"] = ipv4
+	}
+	rendered, _ := json.Marshal(ifAddr)
+	return string(rendered)
+}
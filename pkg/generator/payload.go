@@ -0,0 +1,75 @@
+package generator
+
+import (
+	"fmt"
+	mathrand "math/rand"
+)
+
+// AppProperties returns a Java-style application.properties file with randomized tuning values
+func AppProperties() string {
+	return fmt.Sprintf(`# Application Configuration
+app.name=sim-generator-app
+app.version=1.0.%d
+app.debug=false
+app.port=8080
+app.threads=%d
+app.memory.max=512m
+database.url=jdbc:postgresql://db:5432/app
+database.pool.size=%d`,
+		mathrand.Intn(100), mathrand.Intn(10)+1, mathrand.Intn(20)+5)
+}
+
+// ConfigYAML returns a small YAML application config with randomized resources and instance ID
+func ConfigYAML() string {
+	return fmt.Sprintf(`apiVersion: v1
+kind: Config
+metadata:
+  name: app-config
+spec:
+  replicas: %d
+  resources:
+    requests:
+      cpu: %dm
+      memory: %dMi
+  environment:
+    - name: LOG_LEVEL
+      value: INFO
+    - name: INSTANCE_ID
+      value: "%s"`,
+		mathrand.Intn(5)+1, mathrand.Intn(500)+100, mathrand.Intn(512)+128, RandomString(8))
+}
+
+// SettingsJSON returns a JSON settings document with randomized feature flags and timeouts
+func SettingsJSON() string {
+	return fmt.Sprintf(`{
+  "app": {
+    "name": "sim-generator",
+    "version": "1.0.%d",
+    "environment": "production"
+  },
+  "features": {
+    "enableMetrics": true,
+    "enableTracing": %t,
+    "cacheSize": %d
+  },
+  "networking": {
+    "timeout": %d,
+    "retries": %d
+  }
+}`, mathrand.Intn(100), mathrand.Intn(2) == 1, mathrand.Intn(1000)+100, mathrand.Intn(30)+5, mathrand.Intn(5)+1)
+}
+
+// SecretConfig returns a YAML document holding random database credentials and an API key
+func SecretConfig() string {
+	return fmt.Sprintf(`apiVersion: v1
+secret:
+  database:
+    username: user_%s
+    password: %s
+    host: db.internal
+    port: 5432
+  api:
+    key: %s
+    endpoint: https://api.example.com`,
+		RandomString(6), Password(16), APIKey())
+}
@@ -0,0 +1,91 @@
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+// DataProvider synthesizes the payload of generated objects. Background churn workers create objects
+// in parallel, so implementations must be safe for concurrent use
+type DataProvider interface {
+	// ConfigMapData returns the data of the index-th ConfigMap of a namespace
+	ConfigMapData(index int32) (map[string]string, error)
+
+	// SecretData returns the data of the index-th Secret of a namespace
+	SecretData(index int32) (map[string][]byte, error)
+}
+
+// ProviderFactory builds a provider from the options of a resource type's dataProvider
+type ProviderFactory func(options map[string]string) (DataProvider, error)
+
+// Synthetic is the default provider: application configs and credentials from the generators in this package
+var Synthetic DataProvider = syntheticProvider{}
+
+var (
+	providersMutex sync.RWMutex
+	providers      = map[string]ProviderFactory{
+		scalev1.DataProviderSynthetic: func(map[string]string) (DataProvider, error) {
+			return Synthetic, nil
+		},
+		scalev1.DataProviderSampleFile: NewSampleFileProvider,
+	}
+)
+
+// RegisterProvider makes a provider selectable by name in a resource type's dataProvider. Call it
+// before starting the manager. It panics if the name is taken, so two programs registering the same
+// name fail at startup instead of one silently replacing the other
+func RegisterProvider(name string, factory ProviderFactory) {
+	providersMutex.Lock()
+	defer providersMutex.Unlock()
+	if _, exists := providers[name]; exists {
+		panic(fmt.Sprintf("generator: data provider %q is already registered", name))
+	}
+	providers[name] = factory
+}
+
+// NewProvider builds the named provider with the given options
+func NewProvider(name string, options map[string]string) (DataProvider, error) {
+	providersMutex.RLock()
+	factory, exists := providers[name]
+	providersMutex.RUnlock()
+	if !exists {
+		return nil, fmt.Errorf("unknown data provider %q, registered providers are %v", name, Providers())
+	}
+	return factory(options)
+}
+
+// Providers returns the names of the registered providers, sorted
+func Providers() []string {
+	providersMutex.RLock()
+	defer providersMutex.RUnlock()
+	names := make([]string, 0, len(providers))
+	for name := range providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// syntheticProvider generates a properties file, a YAML config and JSON settings for ConfigMaps, and
+// a user, password, API key and YAML config for Secrets
+type syntheticProvider struct{}
+
+func (syntheticProvider) ConfigMapData(int32) (map[string]string, error) {
+	return map[string]string{
+		"app.properties": AppProperties(),
+		"config.yaml":    ConfigYAML(),
+		"settings.json":  SettingsJSON(),
+	}, nil
+}
+
+func (syntheticProvider) SecretData(index int32) (map[string][]byte, error) {
+	return map[string][]byte{
+		"username":    []byte(fmt.Sprintf("user-%d", index)),
+		"password":    []byte(Password(32)),
+		"api-key":     []byte(APIKey()),
+		"config.yaml": []byte(SecretConfig()),
+	}, nil
+}
@@ -0,0 +1,61 @@
+package generator
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	mathrand "math/rand"
+)
+
+// RandomString returns a random string of lowercase letters and digits, valid in DNS labels
+func RandomString(length int) string {
+	const charset = "abcdefghijklmnopqrstuvwxyz0123456789"
+	result := make([]byte, length)
+	for i := range result {
+		result[i] = charset[mathrand.Intn(len(charset))]
+	}
+	return string(result)
+}
+
+// Password returns a random URL-safe password of the given length
+func Password(length int) string {
+	return base64.URLEncoding.EncodeToString(randomBytes(length))[:length]
+}
+
+// APIKey returns a random 256-bit key, base64 encoded as API tokens usually are
+func APIKey() string {
+	return base64.URLEncoding.EncodeToString(randomBytes(32))
+}
+
+// randomBytes reads from crypto/rand, falling back to math/rand if it fails
+func randomBytes(length int) []byte {
+	bytes := make([]byte, length)
+	if _, err := rand.Read(bytes); err != nil {
+		for i := range bytes {
+			bytes[i] = byte(mathrand.Intn(256))
+		}
+	}
+	return bytes
+}
+
+// Hash returns 32 random characters, the length of a rendered MachineConfig hash
+func Hash() string {
+	return RandomString(32)
+}
+
+// UUID returns a random UUID in its canonical form
+func UUID() string {
+	return fmt.Sprintf("%08x-%04x-%04x-%04x-%012x",
+		mathrand.Uint32(),
+		mathrand.Uint32()&0xFFFF,
+		mathrand.Uint32()&0xFFFF,
+		mathrand.Uint32()&0xFFFF,
+		mathrand.Uint64()&0xFFFFFFFFFFFF)
+}
+
+// MAC returns a random MAC address
+func MAC() string {
+	return fmt.Sprintf("%02x:%02x:%02x:%02x:%02x:%02x",
+		mathrand.Intn(256), mathrand.Intn(256), mathrand.Intn(256),
+		mathrand.Intn(256), mathrand.Intn(256), mathrand.Intn(256))
+}
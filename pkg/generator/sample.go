@@ -0,0 +1,85 @@
+package generator
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"strconv"
+
+	"sigs.k8s.io/yaml"
+)
+
+// sampleFile is the format the sample-file provider reads: payloads captured from a real workload
+// with anything sensitive redacted. Secret values are plain strings, as in a Secret's stringData
+//
+//	configMaps:
+//	- application.yaml: |
+//	    server:
+//	      port: 8080
+//	secrets:
+//	- username: app
+//	  password: REDACTED
+type sampleFile struct {
+	ConfigMaps []map[string]string `json:"configMaps,omitempty"`
+	Secrets    []map[string]string `json:"secrets,omitempty"`
+}
+
+// sampleFileProvider replays the payloads of a sample file in order, the index-th object getting
+// the index-th sample modulo the number of samples
+type sampleFileProvider struct {
+	path             string
+	samples          sampleFile
+	keepSecretValues bool
+}
+
+// NewSampleFileProvider reads the YAML or JSON sample file at options["path"]. Secret values are
+// replaced with random values of the same length unless options["keepSecretValues"] is "true", so
+// a sample that was not fully redacted does not spread credentials across the cluster
+func NewSampleFileProvider(options map[string]string) (DataProvider, error) {
+	path := options["path"]
+	if path == "" {
+		return nil, fmt.Errorf("sample-file data provider requires the path option")
+	}
+	provider := &sampleFileProvider{path: path}
+	if keep, ok := options["keepSecretValues"]; ok {
+		var err error
+		if provider.keepSecretValues, err = strconv.ParseBool(keep); err != nil {
+			return nil, fmt.Errorf("sample-file option keepSecretValues %q is not a boolean", keep)
+		}
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read sample file: %w", err)
+	}
+	if err := yaml.UnmarshalStrict(content, &provider.samples); err != nil {
+		return nil, fmt.Errorf("failed to parse sample file %s: %w", path, err)
+	}
+	if len(provider.samples.ConfigMaps) == 0 && len(provider.samples.Secrets) == 0 {
+		return nil, fmt.Errorf("sample file %s has no configMaps or secrets", path)
+	}
+	return provider, nil
+}
+
+func (p *sampleFileProvider) ConfigMapData(index int32) (map[string]string, error) {
+	if len(p.samples.ConfigMaps) == 0 {
+		return nil, fmt.Errorf("sample file %s has no configMaps", p.path)
+	}
+	return maps.Clone(p.samples.ConfigMaps[int(index)%len(p.samples.ConfigMaps)]), nil
+}
+
+func (p *sampleFileProvider) SecretData(index int32) (map[string][]byte, error) {
+	if len(p.samples.Secrets) == 0 {
+		return nil, fmt.Errorf("sample file %s has no secrets", p.path)
+	}
+	sample := p.samples.Secrets[int(index)%len(p.samples.Secrets)]
+	data := make(map[string][]byte, len(sample))
+	for key, value := range sample {
+		if p.keepSecretValues {
+			data[key] = []byte(value)
+		} else {
+			data[key] = []byte(Password(len(value)))
+		}
+	}
+	return data, nil
+}
@@ -0,0 +1,74 @@
+package simtest
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/defaulting"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+)
+
+// scaleLoadConfigCRDFile is the file controller-gen writes the ScaleLoadConfig CRD to
+const scaleLoadConfigCRDFile = "scale.openshift.io_scaleloadconfigs.yaml"
+
+// ApplyCRDDefaults fills in the fields the ScaleLoadConfig CRD defaults, as the API server does on
+// create. Fields left at their zero value count as unset, just as they are dropped from the request
+// body by omitempty. The CRD is read from the first of crdPaths that holds it
+func ApplyCRDDefaults(config *scalev1.ScaleLoadConfig, crdPaths ...string) error {
+	schema, err := loadScaleLoadConfigSchema(crdPaths)
+	if err != nil {
+		return err
+	}
+
+	object, err := k8sruntime.DefaultUnstructuredConverter.ToUnstructured(config)
+	if err != nil {
+		return fmt.Errorf("failed to convert ScaleLoadConfig %s: %w", config.Name, err)
+	}
+	defaulting.Default(object, schema)
+	if err := k8sruntime.DefaultUnstructuredConverter.FromUnstructured(object, config); err != nil {
+		return fmt.Errorf("failed to convert defaulted ScaleLoadConfig %s: %w", config.Name, err)
+	}
+	return nil
+}
+
+// loadScaleLoadConfigSchema reads the v1 schema of the ScaleLoadConfig CRD as a structural schema
+func loadScaleLoadConfigSchema(crdPaths []string) (*structuralschema.Structural, error) {
+	for _, dir := range crdPaths {
+		data, err := os.ReadFile(filepath.Join(dir, scaleLoadConfigCRDFile))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read ScaleLoadConfig CRD: %w", err)
+		}
+
+		crd := &apiextensionsv1.CustomResourceDefinition{}
+		if err := yaml.Unmarshal(data, crd); err != nil {
+			return nil, fmt.Errorf("failed to parse ScaleLoadConfig CRD: %w", err)
+		}
+		for _, version := range crd.Spec.Versions {
+			if version.Name != scalev1.GroupVersion.Version || version.Schema == nil {
+				continue
+			}
+			internal := &apiextensions.JSONSchemaProps{}
+			if err := apiextensionsv1.Convert_v1_JSONSchemaProps_To_apiextensions_JSONSchemaProps(
+				version.Schema.OpenAPIV3Schema, internal, nil); err != nil {
+				return nil, fmt.Errorf("failed to convert ScaleLoadConfig schema: %w", err)
+			}
+			schema, err := structuralschema.NewStructural(internal)
+			if err != nil {
+				return nil, fmt.Errorf("ScaleLoadConfig schema is not structural: %w", err)
+			}
+			return schema, nil
+		}
+		return nil, fmt.Errorf("ScaleLoadConfig CRD in %s has no %s schema", dir, scalev1.GroupVersion.Version)
+	}
+	return nil, fmt.Errorf("ScaleLoadConfig CRD %s not found in %v", scaleLoadConfigCRDFile, crdPaths)
+}
@@ -0,0 +1,296 @@
+// Package simtest runs the ScaleLoadConfig reconciler inside Go tests, against a fake client or an
+// envtest API server, and lists the objects it generated so tests can assert on them.
+//
+// A test builds a harness, applies a config and reconciles it a few times:
+//
+//	h := simtest.NewFake(t, simtest.Options{}, simtest.KwokNodes(3)...)
+//	rate := int32(1000) // Writes are paced at the config's API rate
+//	config := &scalev1.ScaleLoadConfig{ObjectMeta: metav1.ObjectMeta{Name: "soak"}}
+//	config.Spec.Enabled = true
+//	config.Spec.LoadProfile.APICallRatePerNode = &rate
+//	if err := h.Apply(ctx, config); err != nil {
+//		t.Fatal(err)
+//	}
+//	if err := h.ReconcilePasses(ctx, "soak", 3); err != nil {
+//		t.Fatal(err)
+//	}
+//	set, err := h.Generated(ctx, "soak")
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	simtest.AssertCounts(t, set, map[string]int{"namespace": 2})
+//
+// Neither backend runs the controllers of a real cluster: nothing schedules pods, garbage collects
+// owned objects or finishes deleting namespaces, so tests should assert on what the operator wrote
+package simtest
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/go-logr/logr"
+	buildv1 "github.com/openshift/api/build/v1"
+	imagev1 "github.com/openshift/api/image/v1"
+	routev1 "github.com/openshift/api/route/v1"
+	"github.com/prometheus/client_golang/prometheus"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+	"github.com/jtaleric/sim-operator/internal/controllers"
+)
+
+// Options configures a harness. The zero value works inside this repository
+type Options struct {
+	// Log receives the reconciler's logs; the zero value discards them
+	Log logr.Logger
+
+	// OperatorNamespace is the namespace the reconciler believes it runs in, where summary
+	// ConfigMaps are written
+	OperatorNamespace string
+
+	// CRDDirectoryPaths are searched for the operator's CRDs. envtest installs them, and the fake
+	// client applies the ScaleLoadConfig CRD's defaults on Apply. Defaults to this module's config/crd/bases
+	CRDDirectoryPaths []string
+}
+
+// Harness drives one reconciler against one cluster
+type Harness struct {
+	// Client reads and writes the cluster directly, without the reconciler's pacing and metering
+	Client client.Client
+
+	// Reconciler is the reconciler under test, prepared as SetupWithManager would prepare it
+	Reconciler *controllers.ScaleLoadConfigReconciler
+
+	// Registry holds the reconciler's metrics, separately from the global registry so several
+	// harnesses can exist in one test binary
+	Registry *prometheus.Registry
+
+	crdPaths []string
+	// defaultCRDs applies CRD defaults on Apply, which the fake client does not do itself
+	defaultCRDs bool
+	applied     map[string]bool
+}
+
+// NewScheme returns a scheme with every type the operator reads or writes
+func NewScheme() *k8sruntime.Scheme {
+	scheme := k8sruntime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(scalev1.AddToScheme(scheme))
+	utilruntime.Must(routev1.AddToScheme(scheme))
+	utilruntime.Must(buildv1.AddToScheme(scheme))
+	utilruntime.Must(imagev1.AddToScheme(scheme))
+	return scheme
+}
+
+// NewFake returns a harness backed by controller-runtime's fake client, seeded with objects.
+// SelfSubjectAccessReviews are answered as allowed, so the permission self-check keeps every feature on
+func NewFake(t testing.TB, opts Options, objects ...client.Object) *Harness {
+	t.Helper()
+
+	c := fake.NewClientBuilder().
+		WithScheme(NewScheme()).
+		WithObjects(objects...).
+		WithStatusSubresource(&scalev1.ScaleLoadConfig{}, &corev1.Pod{}, &corev1.Node{}).
+		WithInterceptorFuncs(interceptor.Funcs{Create: allowAccessReviews}).
+		Build()
+	return newHarness(t, opts, c, true)
+}
+
+// NewEnvtest starts an envtest API server with the operator's CRDs, creates objects in it and
+// returns a harness backed by a direct client. The server is stopped when the test ends. The test
+// is skipped when the envtest binaries are not installed (see make envtest)
+func NewEnvtest(t testing.TB, opts Options, objects ...client.Object) *Harness {
+	t.Helper()
+
+	if os.Getenv("KUBEBUILDER_ASSETS") == "" {
+		t.Skip("KUBEBUILDER_ASSETS is not set; install the envtest binaries to run this test")
+	}
+	env := &envtest.Environment{
+		CRDDirectoryPaths:     crdPaths(opts),
+		ErrorIfCRDPathMissing: true,
+	}
+	cfg, err := env.Start()
+	if err != nil {
+		t.Fatalf("failed to start envtest: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := env.Stop(); err != nil {
+			t.Logf("failed to stop envtest: %v", err)
+		}
+	})
+
+	c, err := client.New(cfg, client.Options{Scheme: NewScheme()})
+	if err != nil {
+		t.Fatalf("failed to create envtest client: %v", err)
+	}
+	for _, obj := range objects {
+		if err := c.Create(context.Background(), obj); err != nil {
+			t.Fatalf("failed to create %s: %v", obj.GetName(), err)
+		}
+	}
+	return newHarness(t, opts, c, false)
+}
+
+// newHarness builds and prepares the reconciler, and stops its background work when the test ends
+func newHarness(t testing.TB, opts Options, c client.Client, defaultCRDs bool) *Harness {
+	operatorNamespace := opts.OperatorNamespace
+	if operatorNamespace == "" {
+		operatorNamespace = "sim-operator-system"
+	}
+
+	h := &Harness{
+		Client:      c,
+		Registry:    prometheus.NewRegistry(),
+		crdPaths:    crdPaths(opts),
+		defaultCRDs: defaultCRDs,
+		applied:     make(map[string]bool),
+	}
+	h.Reconciler = &controllers.ScaleLoadConfigReconciler{
+		Client:            c,
+		Scheme:            c.Scheme(),
+		Log:               opts.Log,
+		SummaryNamespace:  operatorNamespace,
+		OperatorNamespace: operatorNamespace,
+		APIReader:         c,
+	}
+	h.Reconciler.Prepare(h.Registry)
+
+	t.Cleanup(func() {
+		for name := range h.applied {
+			h.Reconciler.StopBackgroundWork(name)
+		}
+	})
+	return h
+}
+
+// crdPaths returns the configured CRD directories, or this module's config/crd/bases
+func crdPaths(opts Options) []string {
+	if len(opts.CRDDirectoryPaths) > 0 {
+		return opts.CRDDirectoryPaths
+	}
+	_, file, _, _ := runtime.Caller(0)
+	return []string{filepath.Join(filepath.Dir(file), "..", "..", "config", "crd", "bases")}
+}
+
+// allowAccessReviews answers SelfSubjectAccessReviews, which the fake client cannot store, as allowed
+func allowAccessReviews(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+	if review, ok := obj.(*authorizationv1.SelfSubjectAccessReview); ok {
+		review.Status.Allowed = true
+		return nil
+	}
+	return c.Create(ctx, obj, opts...)
+}
+
+// Apply creates the config, or updates the spec of an existing config with the same name. On the
+// fake client the ScaleLoadConfig CRD's defaults are applied first, as the API server would
+func (h *Harness) Apply(ctx context.Context, config *scalev1.ScaleLoadConfig) error {
+	if h.defaultCRDs {
+		if err := ApplyCRDDefaults(config, h.crdPaths...); err != nil {
+			return err
+		}
+	}
+	h.applied[config.Name] = true
+
+	existing := &scalev1.ScaleLoadConfig{}
+	err := h.Client.Get(ctx, types.NamespacedName{Name: config.Name}, existing)
+	if client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed to get ScaleLoadConfig %s: %w", config.Name, err)
+	}
+	if err != nil {
+		if err := h.Client.Create(ctx, config); err != nil {
+			return fmt.Errorf("failed to create ScaleLoadConfig %s: %w", config.Name, err)
+		}
+		return nil
+	}
+	existing.Spec = config.Spec
+	if err := h.Client.Update(ctx, existing); err != nil {
+		return fmt.Errorf("failed to update ScaleLoadConfig %s: %w", config.Name, err)
+	}
+	return nil
+}
+
+// Reconcile runs one reconcile of the named config
+func (h *Harness) Reconcile(ctx context.Context, name string) (ctrl.Result, error) {
+	return h.Reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: name}})
+}
+
+// ReconcilePasses runs the given number of reconciles of the named config, stopping at the first
+// error. The first pass only adds the finalizer, and churners create a bounded number of objects
+// per pass, so the load converges over several passes
+func (h *Harness) ReconcilePasses(ctx context.Context, name string, passes int) error {
+	for pass := 0; pass < passes; pass++ {
+		if _, err := h.Reconcile(ctx, name); err != nil {
+			return fmt.Errorf("reconcile pass %d of %s failed: %w", pass+1, name, err)
+		}
+	}
+	return nil
+}
+
+// Delete deletes the named config and reconciles it once, so its finalizer starts removing the
+// generated load. Cleanup waits out cleanupDelaySeconds and may take several passes, so tests that
+// need the config gone reconcile it until it is
+func (h *Harness) Delete(ctx context.Context, name string) error {
+	config := &scalev1.ScaleLoadConfig{}
+	if err := h.Client.Get(ctx, types.NamespacedName{Name: name}, config); err != nil {
+		return fmt.Errorf("failed to get ScaleLoadConfig %s: %w", name, err)
+	}
+	if err := h.Client.Delete(ctx, config); err != nil {
+		return fmt.Errorf("failed to delete ScaleLoadConfig %s: %w", name, err)
+	}
+	if _, err := h.Reconcile(ctx, name); err != nil {
+		return fmt.Errorf("failed to reconcile deletion of %s: %w", name, err)
+	}
+	return nil
+}
+
+// KwokNodes returns count Ready KWOK nodes named kwok-node-<n>, labelled type=kwok and tainted
+// like the nodes KWOK manages, with the allocatable resources of the README example
+func KwokNodes(count int) []client.Object {
+	nodes := make([]client.Object, 0, count)
+	for i := 0; i < count; i++ {
+		name := fmt.Sprintf("kwok-node-%d", i)
+		nodes = append(nodes, &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+				Labels: map[string]string{
+					"type":                   "kwok",
+					"kubernetes.io/hostname": name,
+				},
+				Annotations: map[string]string{"kwok.x-k8s.io/node": "fake"},
+			},
+			Spec: corev1.NodeSpec{
+				ProviderID: "kwok://" + name,
+				Taints: []corev1.Taint{{
+					Key:    "kwok.x-k8s.io/node",
+					Value:  "fake",
+					Effect: corev1.TaintEffectNoSchedule,
+				}},
+			},
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+				Allocatable: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("8"),
+					corev1.ResourceMemory: resource.MustParse("32Gi"),
+					corev1.ResourcePods:   resource.MustParse("250"),
+				},
+			},
+		})
+	}
+	return nodes
+}
@@ -0,0 +1,64 @@
+package simtest_test
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	scalev1 "github.com/jtaleric/sim-operator/api/v1"
+	"github.com/jtaleric/sim-operator/pkg/simtest"
+)
+
+// TestHarness_Fake runs the example of the package documentation
+func TestHarness_Fake(t *testing.T) {
+	ctx := context.Background()
+	h := simtest.NewFake(t, simtest.Options{}, simtest.KwokNodes(3)...)
+
+	rate := int32(1000)
+	config := &scalev1.ScaleLoadConfig{ObjectMeta: metav1.ObjectMeta{Name: "soak"}}
+	config.Spec.Enabled = true
+	config.Spec.LoadProfile.APICallRatePerNode = &rate
+	if err := h.Apply(ctx, config); err != nil {
+		t.Fatal(err)
+	}
+	if err := h.ReconcilePasses(ctx, "soak", 3); err != nil {
+		t.Fatal(err)
+	}
+
+	set, err := h.Generated(ctx, "soak")
+	if err != nil {
+		t.Fatal(err)
+	}
+	simtest.AssertCounts(t, set, map[string]int{"namespace": 2})
+	namespaces := set.Names("namespace")
+	simtest.AssertWithinNamespaces(t, set, namespaces...)
+}
+
+func TestHarness_Apply(t *testing.T) {
+	ctx := context.Background()
+	h := simtest.NewFake(t, simtest.Options{}, simtest.KwokNodes(1)...)
+
+	config := &scalev1.ScaleLoadConfig{ObjectMeta: metav1.ObjectMeta{Name: "defaults"}}
+	config.Spec.NamespaceConfig.Labels = map[string]string{"team": "perf"}
+	if err := h.Apply(ctx, config); err != nil {
+		t.Fatal(err)
+	}
+	if got := config.Spec.NamespaceConfig.NamespacePrefix; got != "openshift-fake-" {
+		t.Errorf("Apply defaulted namespacePrefix to %q, want openshift-fake-", got)
+	}
+
+	// Applying the config again updates the spec of the stored one
+	config.Spec.Enabled = true
+	if err := h.Apply(ctx, config); err != nil {
+		t.Fatal(err)
+	}
+	stored := &scalev1.ScaleLoadConfig{}
+	if err := h.Client.Get(ctx, client.ObjectKey{Name: "defaults"}, stored); err != nil {
+		t.Fatal(err)
+	}
+	if !stored.Spec.Enabled {
+		t.Error("Apply did not update the spec of the existing config")
+	}
+}
@@ -0,0 +1,160 @@
+package simtest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	buildv1 "github.com/openshift/api/build/v1"
+	imagev1 "github.com/openshift/api/image/v1"
+	routev1 "github.com/openshift/api/route/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// generatedKinds are the kinds Generated lists, with the key used for objects without a resource-type label
+var generatedKinds = []struct {
+	key     string
+	newList func() client.ObjectList
+}{
+	{"namespace", func() client.ObjectList { return &corev1.NamespaceList{} }},
+	{"node", func() client.ObjectList { return &corev1.NodeList{} }},
+	{"configmap", func() client.ObjectList { return &corev1.ConfigMapList{} }},
+	{"secret", func() client.ObjectList { return &corev1.SecretList{} }},
+	{"service", func() client.ObjectList { return &corev1.ServiceList{} }},
+	{"serviceaccount", func() client.ObjectList { return &corev1.ServiceAccountList{} }},
+	{"pod", func() client.ObjectList { return &corev1.PodList{} }},
+	{"event", func() client.ObjectList { return &corev1.EventList{} }},
+	{"deployment", func() client.ObjectList { return &appsv1.DeploymentList{} }},
+	{"daemonset", func() client.ObjectList { return &appsv1.DaemonSetList{} }},
+	{"lease", func() client.ObjectList { return &coordinationv1.LeaseList{} }},
+	{"ingress", func() client.ObjectList { return &networkingv1.IngressList{} }},
+	{"route", func() client.ObjectList { return &routev1.RouteList{} }},
+	{"imagestream", func() client.ObjectList { return &imagev1.ImageStreamList{} }},
+	{"buildconfig", func() client.ObjectList { return &buildv1.BuildConfigList{} }},
+}
+
+// ObjectSet holds the objects a config generated, keyed by their scale.openshift.io/resource-type
+// label. Objects without one, such as namespaces and nodes, are keyed by their lowercase kind
+type ObjectSet map[string][]client.Object
+
+// Count returns how many objects of the resource type the set holds
+func (s ObjectSet) Count(resourceType string) int {
+	return len(s[resourceType])
+}
+
+// Counts returns the number of objects of every resource type in the set
+func (s ObjectSet) Counts() map[string]int {
+	counts := make(map[string]int, len(s))
+	for resourceType, objects := range s {
+		counts[resourceType] = len(objects)
+	}
+	return counts
+}
+
+// Names returns the sorted namespace/name of every object of the resource type; cluster-scoped
+// objects are listed by name alone
+func (s ObjectSet) Names(resourceType string) []string {
+	names := make([]string, 0, len(s[resourceType]))
+	for _, obj := range s[resourceType] {
+		if obj.GetNamespace() == "" {
+			names = append(names, obj.GetName())
+			continue
+		}
+		names = append(names, client.ObjectKeyFromObject(obj).String())
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Namespaces returns the sorted namespaces holding objects of any resource type
+func (s ObjectSet) Namespaces() []string {
+	seen := make(map[string]bool)
+	for _, objects := range s {
+		for _, obj := range objects {
+			if obj.GetNamespace() != "" {
+				seen[obj.GetNamespace()] = true
+			}
+		}
+	}
+	namespaces := make([]string, 0, len(seen))
+	for namespace := range seen {
+		namespaces = append(namespaces, namespace)
+	}
+	sort.Strings(namespaces)
+	return namespaces
+}
+
+// Generated lists every object labelled as managed by the named config. Kinds the cluster does not
+// serve, such as Routes outside OpenShift on envtest, are skipped
+func (h *Harness) Generated(ctx context.Context, configName string) (ObjectSet, error) {
+	set := make(ObjectSet)
+	for _, kind := range generatedKinds {
+		list := kind.newList()
+		if err := h.Client.List(ctx, list, client.MatchingLabels{"scale.openshift.io/managed-by": configName}); err != nil {
+			if meta.IsNoMatchError(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to list generated %s objects: %w", kind.key, err)
+		}
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read generated %s objects: %w", kind.key, err)
+		}
+		for _, item := range items {
+			obj, ok := item.(client.Object)
+			if !ok {
+				continue
+			}
+			key := obj.GetLabels()["scale.openshift.io/resource-type"]
+			if key == "" {
+				key = kind.key
+			}
+			set[key] = append(set[key], obj)
+		}
+	}
+	return set, nil
+}
+
+// AssertCounts fails the test for every resource type in want whose count in the set differs.
+// Resource types missing from want are not checked; a want of 0 asserts none were generated
+func AssertCounts(t testing.TB, set ObjectSet, want map[string]int) {
+	t.Helper()
+
+	resourceTypes := make([]string, 0, len(want))
+	for resourceType := range want {
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+	sort.Strings(resourceTypes)
+
+	var mismatches []string
+	for _, resourceType := range resourceTypes {
+		if got := set.Count(resourceType); got != want[resourceType] {
+			mismatches = append(mismatches, fmt.Sprintf("%s: got %d, want %d", resourceType, got, want[resourceType]))
+		}
+	}
+	if len(mismatches) > 0 {
+		t.Errorf("generated object counts differ:\n  %s", strings.Join(mismatches, "\n  "))
+	}
+}
+
+// AssertWithinNamespaces fails the test for every generated namespaced object outside the given namespaces
+func AssertWithinNamespaces(t testing.TB, set ObjectSet, namespaces ...string) {
+	t.Helper()
+
+	allowed := make(map[string]bool, len(namespaces))
+	for _, namespace := range namespaces {
+		allowed[namespace] = true
+	}
+	for _, namespace := range set.Namespaces() {
+		if !allowed[namespace] {
+			t.Errorf("generated objects found in namespace %s, outside %v", namespace, namespaces)
+		}
+	}
+}